@@ -0,0 +1,76 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 익명성 검사
+// 게시 직전에 작성자 본인의 ID가 메시지에 남아있지 않은지 확인한다.
+// 1) 본문에 실수로 들어간 작성자 멘션(<@U...>)은 지우고, 멘션 선택 목록에서 작성자 본인은 뺀다
+// 2) 최종 블록을 직렬화해 작성자 ID가 한 글자라도 남아있으면 게시를 막는다
+//    (ANONYMITY_AUDIT_MODE=warn 이면 로그만 남기고 게시)
+
+const (
+	AnonymityAuditWarn = "warn"
+
+	anonymityErrorMessage = "작성자 정보가 포함되어 게시할 수 없습니다. 닉네임이나 메시지에 본인 ID가 들어있는지 확인해주세요."
+)
+
+// 본문/멘션 목록에서 작성자 본인 멘션 제거
+func scrubSubmitter(submitterID, message string, mentions []string) (string, []string) {
+	if submitterID == "" {
+		return message, mentions
+	}
+
+	mentionRegex := regexp.MustCompile(`<@` + regexp.QuoteMeta(submitterID) + `(\|[^>]*)?>`)
+	if mentionRegex.MatchString(message) {
+		log.Println("[경고] 본문에 작성자 본인 멘션이 포함되어 제거")
+		message = strings.TrimSpace(mentionRegex.ReplaceAllString(message, ""))
+	}
+
+	var kept []string
+	for _, m := range mentions {
+		if m == submitterID {
+			log.Println("[경고] 멘션 목록에 작성자 본인이 포함되어 제거")
+			continue
+		}
+		kept = append(kept, m)
+	}
+	return message, kept
+}
+
+// 게시할 블록에 작성자 ID가 남아있는지 검사
+func auditBlocks(submitterID string, blocks []slack.Block) error {
+	if submitterID == "" {
+		return nil
+	}
+	raw, err := json.Marshal(blocks)
+	if err != nil {
+		return fmt.Errorf("블록 직렬화 실패: %w", err)
+	}
+	if strings.Contains(string(raw), submitterID) {
+		return fmt.Errorf("게시할 메시지에 작성자 ID가 포함되어 있습니다")
+	}
+	return nil
+}
+
+// 검사 실패 시 설정에 따라 게시를 막을지 결정 (로그에는 작성자 ID를 남기지 않는다)
+func (app *App) checkAnonymity(submitterID string, blocks []slack.Block) error {
+	err := auditBlocks(submitterID, blocks)
+	if err == nil {
+		return nil
+	}
+	if app.cfg.AnonymityAuditMode == AnonymityAuditWarn {
+		log.Printf("[경고] 익명성 검사 실패, warn 모드라 게시 진행: %v", err)
+		return nil
+	}
+	log.Printf("[에러] 익명성 검사 실패, 게시 중단: %v", err)
+	return err
+}
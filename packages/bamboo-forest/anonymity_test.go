@@ -0,0 +1,109 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScrubSubmitter(t *testing.T) {
+	tests := []struct {
+		name         string
+		message      string
+		mentions     []string
+		wantMessage  string
+		wantMentions []string
+	}{
+		{
+			name:         "self_mention_in_text",
+			message:      "<@USELF> 저 같은 사람도 있어요",
+			mentions:     []string{"UOTHER"},
+			wantMessage:  "저 같은 사람도 있어요",
+			wantMentions: []string{"UOTHER"},
+		},
+		{
+			name:        "labeled_self_mention",
+			message:     "안녕 <@USELF|me>",
+			wantMessage: "안녕",
+		},
+		{
+			name:         "self_in_mention_picker",
+			message:      "확인 부탁드려요",
+			mentions:     []string{"USELF", "UOTHER"},
+			wantMessage:  "확인 부탁드려요",
+			wantMentions: []string{"UOTHER"},
+		},
+		{
+			name:         "other_mentions_untouched",
+			message:      "<@UOTHER> 감사합니다",
+			mentions:     []string{"UOTHER"},
+			wantMessage:  "<@UOTHER> 감사합니다",
+			wantMentions: []string{"UOTHER"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, mentions := scrubSubmitter("USELF", tt.message, tt.mentions)
+			if msg != tt.wantMessage {
+				t.Errorf("message = %q, want %q", msg, tt.wantMessage)
+			}
+			if strings.Join(mentions, ",") != strings.Join(tt.wantMentions, ",") {
+				t.Errorf("mentions = %v, want %v", mentions, tt.wantMentions)
+			}
+		})
+	}
+}
+
+func TestAuditBlocksCatchesLeak(t *testing.T) {
+	clean := buildNewPostBlocks("익명 글입니다", "", []string{"UOTHER"}, "praise", "normal", "")
+	if err := auditBlocks("USELF", clean); err != nil {
+		t.Errorf("깨끗한 블록인데 에러: %v", err)
+	}
+
+	// 닉네임처럼 스크럽 대상이 아닌 필드로 새어나간 경우도 잡아야 한다
+	leaked := buildNewPostBlocks("익명 글입니다", "USELF", nil, "praise", "normal", "")
+	if err := auditBlocks("USELF", leaked); err == nil {
+		t.Error("닉네임에 작성자 ID가 들어갔는데 검사를 통과함")
+	}
+}
+
+func TestPostNewMessageBlocksLeak(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{}, slack: client}
+
+	resp, _ := app.postNewMessage("USELF", "익명 글입니다", "USELF", nil, "praise", "normal", "")
+	errs := responseErrors(t, resp.Body)
+	if errs[BlockIDMessage] != anonymityErrorMessage {
+		t.Errorf("errors = %v", errs)
+	}
+	if n := len(fs.callsTo("chat.postMessage")); n != 0 {
+		t.Errorf("작성자 ID가 포함된 메시지가 게시됨 (%d회)", n)
+	}
+}
+
+func TestPostNewMessageWarnModePosts(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{AnonymityAuditMode: AnonymityAuditWarn}, slack: client}
+
+	app.postNewMessage("USELF", "익명 글입니다", "USELF", nil, "praise", "normal", "")
+	if n := len(fs.callsTo("chat.postMessage")); n != 1 {
+		t.Errorf("warn 모드 게시 수 = %d, want 1", n)
+	}
+}
+
+func TestPostThreadReplyScrubsSelfMention(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{}, slack: client}
+
+	resp, _ := app.postThreadReply("USELF", "C1|1.0", "<@USELF> 저도 같은 생각이에요", "", nil, "", "")
+	if resp.Body != "" {
+		t.Fatalf("게시 실패: %s", resp.Body)
+	}
+	posts := fs.callsTo("chat.postMessage")
+	if len(posts) != 1 {
+		t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+	}
+	if blocks := posts[0].Form.Get("blocks"); strings.Contains(blocks, "USELF") {
+		t.Errorf("게시된 블록에 작성자 ID 포함: %s", blocks)
+	}
+}
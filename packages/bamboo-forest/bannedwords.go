@@ -0,0 +1,43 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ─────────────────────────────────────
+// 금칙어 필터 (BANNED_WORDS)
+// 타인을 비방하는 글이 계속 올라와서, 설정한 금칙어가 들어 있는 글은 모달을 닫지 않고 다시 쓰게 한다.
+// 비교 전에 양쪽을 NFKC로 정규화하고 소문자로 바꾼 뒤 공백·문장부호·기호·보이지 않는 문자를 모두 지워,
+// "바 보", "바.보", 전각 문자(ＡＢＣ), 폭 없는 공백을 섞는 정도로는 피해 가지 못한다.
+// 글자 사이를 지우므로 짧은 금칙어는 다른 단어 안에서도 걸릴 수 있다 (목록은 충분히 구체적으로).
+
+const bannedWordMessage = "부적절한 표현이 포함되어 있습니다"
+
+// 비교용 정규화: NFKC + 소문자 + 글자/숫자만 남김
+func normalizeForFilter(s string) string {
+	s = norm.NFKC.String(s)
+	var b strings.Builder
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
+
+// 금칙어가 들어 있으면 true
+func (app *App) containsBannedWord(message string) bool {
+	if len(app.cfg.BannedWords) == 0 {
+		return false
+	}
+	text := normalizeForFilter(message)
+	for _, word := range app.cfg.BannedWords {
+		if w := normalizeForFilter(word); w != "" && strings.Contains(text, w) {
+			return true
+		}
+	}
+	return false
+}
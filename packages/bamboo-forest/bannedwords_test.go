@@ -0,0 +1,50 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+func TestContainsBannedWord(t *testing.T) {
+	app := &App{cfg: &Config{BannedWords: []string{"바보", "Idiot", "  "}}}
+	tests := []struct {
+		name    string
+		message string
+		want    bool
+	}{
+		{"plain", "팀장님 바보", true},
+		{"spaced", "바 보 같은 결정", true},
+		{"punctuation", "바.보", true},
+		{"zero width space", "바\u200b보", true},
+		{"case insensitive", "what an IDIOT", true},
+		{"fullwidth", "ｉｄｉｏｔ", true},
+		{"clean", "회의가 너무 길어요", false},
+		{"partial", "바다 보러 가요", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := app.containsBannedWord(tt.message); got != tt.want {
+				t.Errorf("containsBannedWord(%q) = %v, want %v", tt.message, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBannedWordKeepsModalOpen(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{BannedWords: []string{"바보"}}, slack: client}
+
+	values := newPostValues("question", "normal")
+	values[BlockIDMessage] = map[string]slack.BlockAction{ActionIDMessage: {Value: "다들 바 보 같아요"}}
+	payload := viewSubmission(CallbackNewPost, "", values)
+	payload.User.ID = "U0"
+	resp, _ := app.handleViewSubmission(payload)
+
+	if errs := responseErrors(t, resp.Body); errs[BlockIDMessage] != bannedWordMessage {
+		t.Errorf("메시지 에러 = %q, want %q", errs[BlockIDMessage], bannedWordMessage)
+	}
+	if n := len(fs.callsTo("chat.postMessage")); n != 0 {
+		t.Errorf("금칙어 글이 게시됨 (%d회)", n)
+	}
+}
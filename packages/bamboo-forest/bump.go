@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/slack-go/slack"
+	"google.golang.org/api/sheets/v4"
+)
+
+// ─────────────────────────────────────
+// 반응 없는 글 다시 올리기 (BUMP_AFTER_HOURS)
+// 새 글에 "🔁 다시 올리기" 버튼을 달고, 게시 후 N시간이 지나도록 이모지 반응·답글·처리 완료가 없으면
+// 작성자가 한 번 눌러 원래 글에 스레드 답글을 달면서 채널에도 함께 보내(reply_broadcast) 다시 눈에 띄게 한다.
+// 작성자는 posts 탭 L열의 hash(userID + messageTS)로만 확인하고, 다시 올린 시각은 M열에 남겨 한 번으로 제한한다.
+// 버튼은 모두에게 보이지만 작성자가 아니거나 조건이 맞지 않으면 누른 사람에게만 안내한다.
+
+const (
+	ActionBumpButton = "bamboo_bump_post"
+	bumpMaxAge       = 7 * 24 * time.Hour // 이보다 오래된 글은 다시 올리지 않음
+	bumpMessage      = "🔁 아직 답을 기다리는 글이에요. 한 번 읽어봐 주세요!"
+)
+
+func (app *App) bumpAfter() time.Duration {
+	return time.Duration(app.cfg.BumpAfterHours) * time.Hour
+}
+
+// 작성자 해시: HMAC-SHA256(서명 비밀 값, "bump" + userID + messageTS)
+// posts 탭 L열에 남고 처리 완료 권한 확인에도 쓰이므로 비밀 값 없이는 역추적할 수 없게 한다.
+func (app *App) bumpAuthorHash(userID, messageTS string) string {
+	mac := hmac.New(sha256.New, []byte(app.cfg.SlackSigningSecret))
+	mac.Write([]byte("bump|" + userID + "|" + messageTS))
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
+
+// 글 하단 버튼 줄(답글 버튼이 있는 블록) 끝에 다시 올리기 버튼 추가
+func withBumpButton(blocks []slack.Block) []slack.Block {
+	button := slack.NewButtonBlockElement(
+		ActionBumpButton,
+		"bump",
+		slack.NewTextBlockObject("plain_text", "🔁 다시 올리기", true, false),
+	)
+
+	out := make([]slack.Block, 0, len(blocks))
+	for _, block := range blocks {
+		if b, ok := block.(*slack.ActionBlock); ok && hasReplyButton(b) {
+			elements := append(append([]slack.BlockElement{}, b.Elements.ElementSet...), button)
+			block = slack.NewActionBlock(b.BlockID, elements...)
+		}
+		out = append(out, block)
+	}
+	return out
+}
+
+// 다시 올릴 수 있는지 확인 (가능하면 posts 행, 아니면 누른 사람에게 보여줄 안내 문구)
+func (app *App) checkBumpAllowed(ctx context.Context, userID string, message slack.Message, now time.Time) (postRecord, string) {
+	notAuthor := "이 글은 작성자만 다시 올릴 수 있어요."
+	posts, err := app.loadPosts(ctx)
+	if err != nil {
+		log.Printf("[경고] 게시글 조회 실패: %v", err)
+		return postRecord{}, "글 정보를 확인하지 못했어요. 잠시 후 다시 시도해주세요."
+	}
+
+	hash := app.bumpAuthorHash(userID, message.Timestamp)
+	for _, p := range posts {
+		if p.MessageTS != message.Timestamp {
+			continue
+		}
+		age := now.Sub(p.CreatedAt)
+		switch {
+		case p.BumpAuthor == "" || p.BumpAuthor != hash:
+			return postRecord{}, notAuthor
+		case p.BumpedAt != "":
+			return postRecord{}, "이미 한 번 다시 올린 글이에요."
+		case age < app.bumpAfter():
+			return postRecord{}, fmt.Sprintf("게시 후 %d시간이 지나도 반응이 없을 때 다시 올릴 수 있어요.", app.cfg.BumpAfterHours)
+		case age > bumpMaxAge:
+			return postRecord{}, "너무 오래된 글은 다시 올릴 수 없어요."
+		case p.Status == PostStatusCompleted || message.ReplyCount > 0:
+			return postRecord{}, "이미 답글이나 처리 완료가 있는 글은 다시 올릴 수 없어요."
+		}
+
+		counts, err := app.getEmojiCounts(ctx, message.Timestamp)
+		if err != nil {
+			log.Printf("[경고] 카운트 조회 실패: %v", err)
+			return postRecord{}, "글 정보를 확인하지 못했어요. 잠시 후 다시 시도해주세요."
+		}
+		for _, n := range counts {
+			if n > 0 {
+				return postRecord{}, "이미 반응이 있는 글은 다시 올릴 수 없어요."
+			}
+		}
+		return p, ""
+	}
+	return postRecord{}, notAuthor
+}
+
+func (app *App) setPostBumpedAt(ctx context.Context, row int, value string) error {
+	_, err := app.sheets.Spreadsheets.Values.Update(
+		app.cfg.SheetsID,
+		fmt.Sprintf("posts!M%d", row),
+		&sheets.ValueRange{Values: [][]interface{}{{value}}},
+	).ValueInputOption("RAW").Context(ctx).Do()
+	return err
+}
+
+// 🔁 다시 올리기 버튼 클릭
+func (app *App) handleBumpButton(ctx context.Context, payload slack.InteractionCallback) (events.LambdaFunctionURLResponse, error) {
+	channelID, userID := payload.Channel.ID, payload.User.ID
+	messageTS := payload.Message.Timestamp
+
+	notify := func(text string) (events.LambdaFunctionURLResponse, error) {
+		if _, err := app.slack.PostEphemeral(channelID, userID, slack.MsgOptionText(text, false)); err != nil {
+			log.Printf("[경고] 다시 올리기 안내 전송 실패: %v", err)
+		}
+		return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+	}
+
+	if app.sheets == nil || app.bumpAfter() <= 0 {
+		return notify("다시 올리기 기능이 꺼져 있어요.")
+	}
+	post, notice := app.checkBumpAllowed(ctx, userID, payload.Message, time.Now())
+	if notice != "" {
+		return notify(notice)
+	}
+
+	// 두 번 눌러도 한 번만 올라가도록 먼저 사용 기록
+	if err := app.setPostBumpedAt(ctx, post.Row, time.Now().Format(time.RFC3339)); err != nil {
+		log.Printf("[에러] 다시 올리기 기록 실패: %v", err)
+		return notify("다시 올리지 못했어요. 잠시 후 다시 시도해주세요.")
+	}
+	_, _, err := app.slack.PostMessageContext(ctx, channelID,
+		slack.MsgOptionText(bumpMessage, false),
+		slack.MsgOptionTS(messageTS),
+		slack.MsgOptionBroadcast(),
+	)
+	if err != nil {
+		log.Printf("[에러] 다시 올리기 게시 실패: %v", err)
+		if err := app.setPostBumpedAt(ctx, post.Row, ""); err != nil {
+			log.Printf("[경고] 다시 올리기 기록 되돌리기 실패: %v", err)
+		}
+		return notify("다시 올리지 못했어요. 잠시 후 다시 시도해주세요.")
+	}
+
+	log.Printf("[성공] 반응 없는 글 다시 올리기 (ts=%s)", messageTS)
+	return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+}
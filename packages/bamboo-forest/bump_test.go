@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// 다시 올리기 대상 글 행 (작성자 U0)
+func bumpPostRow(age time.Duration, status, bumpedAt string) []string {
+	created := time.Now().Add(-age).Format(time.RFC3339)
+	return []string{created, "1.0", "question", "normal", "FALSE", "0", status, "", "", "", "", bumpHashFor("U0", "1.0"), bumpedAt}
+}
+
+func bumpClick(userID string) slack.InteractionCallback {
+	payload := emojiClick("C1", "1.0", userID)
+	payload.ActionCallback.BlockActions = []*slack.BlockAction{{ActionID: ActionBumpButton}}
+	return payload
+}
+
+func TestBumpFirstThenBlocked(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	sh.seed("posts", bumpPostRow(25*time.Hour, "", ""))
+	app := &App{cfg: &Config{SheetsID: "sheet", BumpAfterHours: 24}, slack: client, sheets: svc}
+
+	app.handleBlockAction(context.Background(), bumpClick("U0"))
+
+	posts := fs.callsTo("chat.postMessage")
+	if len(posts) != 1 {
+		t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+	}
+	form := posts[0].Form
+	if form.Get("channel") != "C1" || form.Get("thread_ts") != "1.0" || form.Get("reply_broadcast") != "true" {
+		t.Errorf("channel=%q thread_ts=%q reply_broadcast=%q", form.Get("channel"), form.Get("thread_ts"), form.Get("reply_broadcast"))
+	}
+	if rows := sh.rows("posts"); len(rows[0]) < 13 || rows[0][12] == "" {
+		t.Fatalf("다시 올린 시각이 기록되지 않음: %v", rows[0])
+	}
+
+	// 두 번째는 막힌다
+	app.handleBlockAction(context.Background(), bumpClick("U0"))
+	if n := len(fs.callsTo("chat.postMessage")); n != 1 {
+		t.Errorf("두 번째 다시 올리기가 게시됨 (총 %d회)", n)
+	}
+	ephemerals := fs.callsTo("chat.postEphemeral")
+	if len(ephemerals) != 1 || !strings.Contains(ephemerals[0].Form.Get("text"), "이미 한 번") {
+		t.Errorf("안내 = %v", ephemerals)
+	}
+}
+
+func TestBumpRejected(t *testing.T) {
+	tests := []struct {
+		name      string
+		userID    string
+		row       []string
+		reactions [][]string
+		want      string
+	}{
+		{"not author", "U1", bumpPostRow(25*time.Hour, "", ""), nil, "작성자만"},
+		{"too early", "U0", bumpPostRow(time.Hour, "", ""), nil, "24시간"},
+		{"too old", "U0", bumpPostRow(8*24*time.Hour, "", ""), nil, "오래된"},
+		{"completed", "U0", bumpPostRow(25*time.Hour, PostStatusCompleted, ""), nil, "처리 완료"},
+		{"has reactions", "U0", bumpPostRow(25*time.Hour, "", ""), [][]string{{reactionHashFor("U2", "1.0", "hug"), "1.0", "hug", "t"}}, "반응"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			sh, svc := newFakeSheets(t)
+			sh.seed("posts", tt.row)
+			sh.seed("reactions", tt.reactions...)
+			app := &App{cfg: &Config{SheetsID: "sheet", BumpAfterHours: 24}, slack: client, sheets: svc}
+
+			app.handleBlockAction(context.Background(), bumpClick(tt.userID))
+
+			if n := len(fs.callsTo("chat.postMessage")); n != 0 {
+				t.Errorf("다시 올리기가 게시됨 (%d회)", n)
+			}
+			ephemerals := fs.callsTo("chat.postEphemeral")
+			if len(ephemerals) != 1 || !strings.Contains(ephemerals[0].Form.Get("text"), tt.want) {
+				t.Errorf("안내 = %v, want %q 포함", ephemerals, tt.want)
+			}
+		})
+	}
+}
+
+func TestBumpButtonAndAuthorRecorded(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	app := &App{cfg: &Config{SheetsID: "sheet", BumpAfterHours: 24}, slack: client, sheets: svc}
+
+	payload := viewSubmission(CallbackNewPost, "", newPostValues("question", "normal"))
+	payload.User.ID = "U0"
+	app.handleViewSubmission(payload)
+
+	raw := fs.callsTo("chat.postMessage")[0].Form.Get("blocks")
+	if !strings.Contains(raw, ActionBumpButton) {
+		t.Error("다시 올리기 버튼 없음")
+	}
+	rows := sh.rows("posts")
+	if len(rows) != 1 || len(rows[0]) < 12 || rows[0][11] != bumpHashFor("U0", "1700000000.000100") {
+		t.Fatalf("posts 행 = %v, want L열 작성자 해시", rows)
+	}
+	if strings.Contains(strings.Join(rows[0], "|"), "U0") {
+		t.Error("posts 행에 사용자 ID가 기록됨")
+	}
+}
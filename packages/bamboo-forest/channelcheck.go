@@ -0,0 +1,52 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 시작 시 대상 채널 참여 확인 (CHANNEL_CHECK, opt-in)
+// 봇이 대상 채널(BAMBOO_CHANNEL_ID)에 없으면 글을 올리는 순간에야 not_in_channel로 실패하므로 Lambda 초기화 때 미리 확인한다.
+// - warn: 참여하지 않았으면 설정 안내를 로그로 남김
+// - join: 공개 채널이면 conversations.join으로 직접 참여
+// 비공개 채널에 봇이 없으면 어느 모드든 초기화를 중단한다 (초대 없이는 게시할 수 없음).
+
+const (
+	ChannelCheckWarn = "warn"
+	ChannelCheckJoin = "join"
+)
+
+var errPrivateChannelNotMember = errors.New("봇이 비공개 대상 채널의 멤버가 아닙니다. 채널에서 `/invite @봇이름`으로 봇을 초대해주세요")
+
+func (app *App) checkTargetChannel() error {
+	info, err := app.slack.GetConversationInfo(&slack.GetConversationInfoInput{ChannelID: app.targetChannel()})
+	if err != nil {
+		// 비공개 채널은 멤버가 아니면 channel_not_found로 응답한다
+		if strings.Contains(err.Error(), "channel_not_found") {
+			return fmt.Errorf("%w (channel=%s, 채널 ID가 맞는지도 확인해주세요)", errPrivateChannelNotMember, app.targetChannel())
+		}
+		return fmt.Errorf("대상 채널 정보 조회 실패 (channels:read 또는 groups:read 스코프 확인): %w", err)
+	}
+
+	if info.IsMember {
+		log.Printf("[성공] 대상 채널 참여 확인 (channel=%s)", app.targetChannel())
+		return nil
+	}
+	if info.IsPrivate {
+		return fmt.Errorf("%w (channel=%s)", errPrivateChannelNotMember, app.targetChannel())
+	}
+
+	if app.cfg.ChannelCheck == ChannelCheckJoin {
+		if _, _, _, err := app.slack.JoinConversation(app.targetChannel()); err != nil {
+			return fmt.Errorf("대상 채널 참여 실패 (channels:join 스코프 확인): %w", err)
+		}
+		log.Printf("[성공] 대상 채널에 참여 (channel=%s)", app.targetChannel())
+		return nil
+	}
+	return fmt.Errorf("봇이 공개 대상 채널(%s)의 멤버가 아닙니다. 봇을 초대하거나 CHANNEL_CHECK=join으로 자동 참여하도록 설정해주세요", app.targetChannel())
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+func TestCheckTargetChannel(t *testing.T) {
+	tests := []struct {
+		name        string
+		mode        string
+		info        string
+		wantErr     bool
+		wantPrivate bool
+		wantJoin    bool
+	}{
+		{"member", ChannelCheckWarn, `{"ok":true,"channel":{"id":"C1","is_member":true}}`, false, false, false},
+		{"public not member", ChannelCheckWarn, `{"ok":true,"channel":{"id":"C1","is_member":false}}`, true, false, false},
+		{"public join", ChannelCheckJoin, `{"ok":true,"channel":{"id":"C1","is_member":false}}`, false, false, true},
+		{"private not member", ChannelCheckJoin, `{"ok":true,"channel":{"id":"C1","is_private":true,"is_member":false}}`, true, true, false},
+		{"private hidden", ChannelCheckWarn, `{"ok":false,"error":"channel_not_found"}`, true, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			fs.responses["conversations.info"] = tt.info
+			fs.responses["conversations.join"] = `{"ok":true,"channel":{"id":"C1","is_member":true}}`
+			app := &App{cfg: &Config{ChannelCheck: tt.mode}, slack: client}
+
+			err := app.checkTargetChannel()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %t", err, tt.wantErr)
+			}
+			if got := errors.Is(err, errPrivateChannelNotMember); got != tt.wantPrivate {
+				t.Errorf("비공개 채널 에러 = %t, want %t (err=%v)", got, tt.wantPrivate, err)
+			}
+			if got := len(fs.callsTo("conversations.join")) == 1; got != tt.wantJoin {
+				t.Errorf("conversations.join 호출 = %t, want %t", got, tt.wantJoin)
+			}
+		})
+	}
+}
+
+func TestNewAppFailsWhenNotInPrivateChannel(t *testing.T) {
+	fs, _ := newFakeSlack(t)
+	fs.responses["conversations.info"] = `{"ok":true,"channel":{"id":"C1","is_private":true,"is_member":false}}`
+	slackOptions = []slack.Option{slack.OptionAPIURL(fs.url)}
+	t.Cleanup(func() { slackOptions = nil })
+
+	cfg := &Config{SlackBotToken: "xoxb-test", SlackSigningSecret: "secret", ChannelCheck: ChannelCheckWarn}
+	app, err := NewApp(context.Background(), cfg)
+	if !errors.Is(err, errPrivateChannelNotMember) {
+		t.Fatalf("NewApp err = %v, want 비공개 채널 미참여 에러", err)
+	}
+	if app != nil {
+		t.Error("초기화 실패인데 App이 반환됨")
+	}
+}
+
+func TestNewAppSkipsChannelCheckByDefault(t *testing.T) {
+	fs, _ := newFakeSlack(t)
+	slackOptions = []slack.Option{slack.OptionAPIURL(fs.url)}
+	t.Cleanup(func() { slackOptions = nil })
+
+	if _, err := NewApp(context.Background(), &Config{SlackBotToken: "xoxb-test", SlackSigningSecret: "secret"}); err != nil {
+		t.Fatalf("NewApp: %v", err)
+	}
+	if n := len(fs.callsTo("conversations.info")); n != 0 {
+		t.Errorf("CHANNEL_CHECK 미설정인데 채널 확인함 (%d회)", n)
+	}
+}
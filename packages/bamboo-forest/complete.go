@@ -0,0 +1,228 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 처리 완료 권한 (COMPLETE_ADMIN_USER_IDS)
+// 설정하면 "✅ 처리 완료" 버튼은 목록의 관리자와 글 작성자만 누를 수 있다. 비어 있으면 지금처럼 누구나 누를 수 있다.
+// 작성자는 다시 올리기와 같은 posts 탭 L열의 hash(userID + messageTS)로만 확인하므로,
+// 기록되지 않은 글(예약 게시, Sheets 미설정)은 관리자만 처리할 수 있다.
+
+const completeNotAllowedMessage = "권한이 없습니다"
+
+// 쉼표로 구분한 관리자 사용자 ID 목록
+func (app *App) completeAdminIDs() []string {
+	var ids []string
+	for _, id := range strings.Split(app.cfg.CompleteAdminUserIDs, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (app *App) restrictComplete() bool {
+	return len(app.completeAdminIDs()) > 0
+}
+
+func (app *App) isCompleteAdmin(userID string) bool {
+	for _, id := range app.completeAdminIDs() {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// 처리 완료를 누를 수 있는 사용자인지 (관리자 또는 기록된 작성자)
+func (app *App) canComplete(ctx context.Context, userID, messageTS string) bool {
+	if !app.restrictComplete() || app.isCompleteAdmin(userID) {
+		return true
+	}
+	if app.sheets == nil {
+		return false
+	}
+
+	posts, err := app.loadPosts(ctx)
+	if err != nil {
+		log.Printf("[경고] 처리 완료 권한 확인 실패: %v", err)
+		return false
+	}
+	for _, p := range posts {
+		if p.MessageTS == messageTS {
+			return p.BumpAuthor != "" && p.BumpAuthor == app.bumpAuthorHash(userID, messageTS)
+		}
+	}
+	return false
+}
+
+// 권한 없는 처리 완료 클릭은 누른 사람에게만 안내
+func (app *App) rejectComplete(channelID, userID string) {
+	if _, err := app.slack.PostEphemeral(channelID, userID, slack.MsgOptionText(completeNotAllowedMessage, false)); err != nil {
+		log.Printf("[경고] 처리 완료 권한 안내 전송 실패: %v", err)
+	}
+}
+
+// ─────────────────────────────────────
+// 처리 완료 표시/취소
+// 처리한 사람은 헤더 컨텍스트 블록의 block_id(completed_by:<userID>)에 남겨 두고,
+// 취소할 때는 이 값으로 붙였던 " │ ✅ 처리됨 (<@…>)" 문구를 그대로 만들어 헤더에서 떼어낸다.
+// 관리자가 아닌 작성자가 처리하면 익명이 깨지지 않도록 사용자 ID 대신 completed_by:author와 "(작성자)"만 남긴다.
+// 처리완료 버튼은 같은 자리에서 "↩️ 처리 완료 취소" 버튼과 서로 바뀐다.
+
+const completedHeaderBlockPrefix = "completed_by:"
+
+// 작성자가 처리 완료한 글의 처리자 자리
+const authorCompleter = "author"
+
+func completedSuffix(userID string) string {
+	switch userID {
+	case reactionCompleter:
+		return " │ ✅ 처리됨 (반응 기준 도달)"
+	case authorCompleter:
+		return " │ ✅ 처리됨 (작성자)"
+	}
+	return fmt.Sprintf(" │ ✅ 처리됨 (<@%s>)", userID)
+}
+
+// 헤더에 남길 처리자 (권한 확인을 통과한 관리자 목록 밖의 사용자는 작성자)
+func (app *App) completerFor(userID string) string {
+	if app.restrictComplete() && !app.isCompleteAdmin(userID) {
+		return authorCompleter
+	}
+	return userID
+}
+
+func completeButton() *slack.ButtonBlockElement {
+	return slack.NewButtonBlockElement(
+		ActionCompleteButton,
+		"complete",
+		slack.NewTextBlockObject("plain_text", "✅ 처리 완료", false, false),
+	)
+}
+
+func uncompleteButton() *slack.ButtonBlockElement {
+	return slack.NewButtonBlockElement(
+		ActionUncompleteButton,
+		"uncomplete",
+		slack.NewTextBlockObject("plain_text", "↩️ 처리 완료 취소", false, false),
+	)
+}
+
+// 버튼 줄에서 actionID 버튼을 replacement로 바꾼 블록 (emoji_actions 블록은 그대로)
+func swapButton(b *slack.ActionBlock, actionID string, replacement slack.BlockElement) *slack.ActionBlock {
+	elements := make([]slack.BlockElement, 0, len(b.Elements.ElementSet))
+	for _, el := range b.Elements.ElementSet {
+		if button, ok := el.(*slack.ButtonBlockElement); ok && button.ActionID == actionID {
+			el = replacement
+		}
+		elements = append(elements, el)
+	}
+	return slack.NewActionBlock(b.BlockID, elements...)
+}
+
+// 헤더에 처리 완료 표시를 붙이고 처리완료 버튼을 취소 버튼으로 바꾼 블록
+func completedBlocks(blocks []slack.Block, userID string) []slack.Block {
+	var newBlocks []slack.Block
+	headerDone := false
+	for _, block := range blocks {
+		switch b := block.(type) {
+		case *slack.ContextBlock:
+			// emoji_counts 블록은 그대로 유지
+			if b.BlockID == "emoji_counts" || headerDone {
+				newBlocks = append(newBlocks, block)
+				continue
+			}
+			// 헤더(첫 컨텍스트 블록)에 처리완료 표시 추가 (게시 시각 등 뒤쪽 요소는 유지)
+			if len(b.ContextElements.Elements) > 0 {
+				if textObj, ok := b.ContextElements.Elements[0].(*slack.TextBlockObject); ok {
+					elements := append([]slack.MixedElement{
+						slack.NewTextBlockObject("mrkdwn", textObj.Text+completedSuffix(userID), false, false),
+					}, b.ContextElements.Elements[1:]...)
+					newBlocks = append(newBlocks, slack.NewContextBlock(completedHeaderBlockPrefix+userID, elements...))
+					headerDone = true
+					continue
+				}
+			}
+			newBlocks = append(newBlocks, block)
+		case *slack.ActionBlock:
+			// emoji_actions 블록은 그대로 유지
+			if b.BlockID == "emoji_actions" {
+				newBlocks = append(newBlocks, block)
+				continue
+			}
+			newBlocks = append(newBlocks, swapButton(b, ActionCompleteButton, uncompleteButton()))
+		default:
+			newBlocks = append(newBlocks, block)
+		}
+	}
+	return newBlocks
+}
+
+// 처리 완료 표시를 떼고 취소 버튼을 처리완료 버튼으로 되돌린 블록 (처리 완료된 글이 아니면 false)
+func uncompletedBlocks(blocks []slack.Block) ([]slack.Block, bool) {
+	found := false
+	newBlocks := make([]slack.Block, 0, len(blocks))
+	for _, block := range blocks {
+		switch b := block.(type) {
+		case *slack.ContextBlock:
+			userID, ok := strings.CutPrefix(b.BlockID, completedHeaderBlockPrefix)
+			if !ok || len(b.ContextElements.Elements) == 0 {
+				break
+			}
+			textObj, ok := b.ContextElements.Elements[0].(*slack.TextBlockObject)
+			if !ok {
+				break
+			}
+			found = true
+			elements := append([]slack.MixedElement{
+				slack.NewTextBlockObject("mrkdwn", strings.Replace(textObj.Text, completedSuffix(userID), "", 1), false, false),
+			}, b.ContextElements.Elements[1:]...)
+			block = slack.NewContextBlock("", elements...)
+		case *slack.ActionBlock:
+			if b.BlockID != "emoji_actions" {
+				block = swapButton(b, ActionUncompleteButton, completeButton())
+			}
+		}
+		newBlocks = append(newBlocks, block)
+	}
+	return newBlocks, found
+}
+
+// ↩️ 처리 완료 취소 버튼 클릭 (처리 완료와 같은 권한 확인)
+func (app *App) handleUncompleteButton(ctx context.Context, payload slack.InteractionCallback) (events.LambdaFunctionURLResponse, error) {
+	channelID, userID := payload.Channel.ID, payload.User.ID
+	messageTS := payload.Message.Timestamp
+
+	if !app.canComplete(ctx, userID, messageTS) {
+		log.Printf("[스킵] 처리완료 취소 권한 없음 (ts=%s)", messageTS)
+		app.rejectComplete(channelID, userID)
+		return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+	}
+
+	newBlocks, ok := uncompletedBlocks(payload.Message.Blocks.BlockSet)
+	if !ok {
+		log.Printf("[스킵] 처리 완료된 글이 아님 (ts=%s)", messageTS)
+		return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+	}
+	if _, _, _, err := app.slack.UpdateMessage(channelID, messageTS, slack.MsgOptionBlocks(newBlocks...)); err != nil {
+		log.Printf("[에러] 처리완료 취소 업데이트 실패: %v", err)
+		return respondWithSlackError("처리완료 취소에 실패했습니다. 잠시 후 다시 시도해주세요.")
+	}
+	log.Printf("[성공] 처리완료 취소 (channel=%s, ts=%s, by=%s)", channelID, messageTS, userID)
+
+	if app.sheets != nil {
+		if err := app.markPostReopened(ctx, messageTS); err != nil {
+			log.Printf("[경고] 게시글 처리완료 취소 기록 실패: %v", err)
+		}
+	}
+	return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+}
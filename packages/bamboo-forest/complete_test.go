@@ -0,0 +1,224 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+func completeClick(userID string) slack.InteractionCallback {
+	payload := emojiClick("C1", "1.0", userID, buildNewPostBlocks("처리해 주세요", "", nil, "suggestion", "normal", "")...)
+	payload.ActionCallback.BlockActions = []*slack.BlockAction{{ActionID: ActionCompleteButton}}
+	return payload
+}
+
+func TestCompletePermission(t *testing.T) {
+	tests := []struct {
+		name    string
+		admins  string
+		sheets  bool
+		user    string
+		allowed bool
+	}{
+		{"제한 없음", "", false, "U9", true},
+		{"관리자", "UADMIN, UOTHER", false, "UOTHER", true},
+		{"작성자", "UADMIN", true, "U0", true},
+		{"다른 사용자", "UADMIN", true, "U9", false},
+		{"Sheets 없으면 관리자만", "UADMIN", false, "U0", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: &Config{SheetsID: "sheet", CompleteAdminUserIDs: tt.admins}, slack: client}
+			if tt.sheets {
+				sh, svc := newFakeSheets(t)
+				sh.seed("posts", bumpPostRow(time.Hour, "", ""))
+				app.sheets = svc
+			}
+
+			app.handleBlockAction(context.Background(), completeClick(tt.user))
+
+			updates := len(fs.callsTo("chat.update"))
+			ephemerals := fs.callsTo("chat.postEphemeral")
+			if tt.allowed {
+				if updates != 1 || len(ephemerals) != 0 {
+					t.Errorf("chat.update = %d, 안내 = %d, want 처리 완료", updates, len(ephemerals))
+				}
+				return
+			}
+			if updates != 0 {
+				t.Errorf("권한 없는 클릭으로 글이 수정됨 (%d회)", updates)
+			}
+			if len(ephemerals) != 1 || !strings.Contains(ephemerals[0].Form.Get("text"), completeNotAllowedMessage) {
+				t.Errorf("안내 = %v, want %q", ephemerals, completeNotAllowedMessage)
+			}
+		})
+	}
+}
+
+func TestCompleteByAuthorStaysAnonymous(t *testing.T) {
+	tests := []struct {
+		name       string
+		user       string
+		wantSuffix string
+		wantBlock  string
+	}{
+		{"작성자", "U0", "✅ 처리됨 (작성자)", completedHeaderBlockPrefix + authorCompleter},
+		{"관리자", "UADMIN", "✅ 처리됨 (<@UADMIN>)", completedHeaderBlockPrefix + "UADMIN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			sh, svc := newFakeSheets(t)
+			sh.seed("posts", bumpPostRow(time.Hour, "", ""))
+			app := &App{cfg: &Config{SheetsID: "sheet", CompleteAdminUserIDs: "UADMIN"}, slack: client, sheets: svc}
+
+			app.handleBlockAction(context.Background(), completeClick(tt.user))
+
+			updates := fs.callsTo("chat.update")
+			if len(updates) != 1 {
+				t.Fatalf("chat.update = %d회, want 1", len(updates))
+			}
+			raw := updates[0].Form.Get("blocks")
+			if !strings.Contains(raw, tt.wantBlock) {
+				t.Errorf("blocks = %s, want block_id %q", raw, tt.wantBlock)
+			}
+			if tt.user == "U0" && strings.Contains(raw, "U0") {
+				t.Errorf("작성자 ID가 글에 남음: %s", raw)
+			}
+
+			var done slack.Blocks
+			if err := done.UnmarshalJSON([]byte(raw)); err != nil {
+				t.Fatal(err)
+			}
+			if header, _ := headerAndButtons(done.BlockSet); !strings.HasSuffix(header, tt.wantSuffix) {
+				t.Errorf("헤더 = %q, want %q", header, tt.wantSuffix)
+			}
+			reopened, ok := uncompletedBlocks(done.BlockSet)
+			header, _ := headerAndButtons(reopened)
+			if !ok || strings.Contains(header, "처리됨") {
+				t.Errorf("취소 후 헤더 = %q, want 처리 표시 없음", header)
+			}
+		})
+	}
+}
+
+func TestCompleteRestrictionRecordsAuthor(t *testing.T) {
+	_, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	app := &App{cfg: &Config{SheetsID: "sheet", CompleteAdminUserIDs: "UADMIN"}, slack: client, sheets: svc}
+
+	payload := viewSubmission(CallbackNewPost, "", newPostValues("question", "normal"))
+	payload.User.ID = "U0"
+	app.handleViewSubmission(payload)
+
+	rows := sh.rows("posts")
+	if len(rows) != 1 || len(rows[0]) < 12 || rows[0][11] != bumpHashFor("U0", "1700000000.000100") {
+		t.Fatalf("posts 행 = %v, want L열 작성자 해시", rows)
+	}
+}
+
+// 글 블록의 헤더 텍스트와 버튼 줄 action_id 목록
+func headerAndButtons(blocks []slack.Block) (string, []string) {
+	header := ""
+	var buttons []string
+	for _, block := range blocks {
+		switch b := block.(type) {
+		case *slack.ContextBlock:
+			if header == "" && b.BlockID != "emoji_counts" {
+				header = b.ContextElements.Elements[0].(*slack.TextBlockObject).Text
+			}
+		case *slack.ActionBlock:
+			if b.BlockID == "emoji_actions" {
+				continue
+			}
+			for _, el := range b.Elements.ElementSet {
+				buttons = append(buttons, el.(*slack.ButtonBlockElement).ActionID)
+			}
+		}
+	}
+	return header, buttons
+}
+
+func TestUncompleteRestoresPost(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	sh.seed("posts", bumpPostRow(time.Hour, "", ""))
+	app := &App{cfg: &Config{SheetsID: "sheet"}, slack: client, sheets: svc}
+
+	original := buildNewPostBlocks("처리해 주세요", "", nil, "suggestion", "normal", "")
+	wantHeader, wantButtons := headerAndButtons(original)
+
+	// 처리 완료: 헤더 표시 + 취소 버튼
+	app.handleBlockAction(context.Background(), completeClick("UMOD"))
+	updates := fs.callsTo("chat.update")
+	if len(updates) != 1 {
+		t.Fatalf("chat.update 호출 수 = %d, want 1", len(updates))
+	}
+	completed := postedBlocks(t, updates[0].Form.Get("blocks"))
+	header, buttons := headerAndButtons(completed)
+	if header != wantHeader+" │ ✅ 처리됨 (<@UMOD>)" {
+		t.Errorf("처리 완료 헤더 = %q", header)
+	}
+	if strings.Join(buttons, ",") != ActionReplyButton+","+ActionUncompleteButton {
+		t.Errorf("처리 완료 후 버튼 = %v", buttons)
+	}
+	if rows := sh.rows("posts"); rows[0][6] != PostStatusCompleted {
+		t.Fatalf("posts 상태 = %q, want completed", rows[0][6])
+	}
+
+	// 처리 완료 뒤 글이 수정되어 헤더 끝에 다른 표시가 붙어도 처리 완료 표시만 떼어냄
+	markEdited(completed)
+
+	payload := emojiClick("C1", "1.0", "U9", completed...)
+	payload.ActionCallback.BlockActions = []*slack.BlockAction{{ActionID: ActionUncompleteButton}}
+	app.handleBlockAction(context.Background(), payload)
+
+	updates = fs.callsTo("chat.update")
+	if len(updates) != 2 {
+		t.Fatalf("chat.update 호출 수 = %d, want 2", len(updates))
+	}
+	header, buttons = headerAndButtons(postedBlocks(t, updates[1].Form.Get("blocks")))
+	if header != wantHeader+editedMark {
+		t.Errorf("취소 후 헤더 = %q, want %q", header, wantHeader+editedMark)
+	}
+	if strings.Join(buttons, ",") != strings.Join(wantButtons, ",") {
+		t.Errorf("취소 후 버튼 = %v, want %v", buttons, wantButtons)
+	}
+	if rows := sh.rows("posts"); rows[0][6] != "" {
+		t.Errorf("취소 후 posts 상태 = %q, want 빈 값", rows[0][6])
+	}
+}
+
+func TestUncompleteIgnoresOpenPost(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{}, slack: client}
+
+	payload := emojiClick("C1", "1.0", "U9", buildNewPostBlocks("처리해 주세요", "", nil, "suggestion", "normal", "")...)
+	payload.ActionCallback.BlockActions = []*slack.BlockAction{{ActionID: ActionUncompleteButton}}
+	app.handleBlockAction(context.Background(), payload)
+
+	if n := len(fs.callsTo("chat.update")); n != 0 {
+		t.Errorf("처리 완료되지 않은 글이 수정됨 (%d회)", n)
+	}
+}
+
+func TestUncompleteRequiresPermission(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{CompleteAdminUserIDs: "UADMIN"}, slack: client}
+
+	blocks := completedBlocks(buildNewPostBlocks("처리해 주세요", "", nil, "suggestion", "normal", ""), "UADMIN")
+	payload := emojiClick("C1", "1.0", "U9", blocks...)
+	payload.ActionCallback.BlockActions = []*slack.BlockAction{{ActionID: ActionUncompleteButton}}
+	app.handleBlockAction(context.Background(), payload)
+
+	if n := len(fs.callsTo("chat.update")); n != 0 {
+		t.Errorf("권한 없는 취소로 글이 수정됨 (%d회)", n)
+	}
+	if n := len(fs.callsTo("chat.postEphemeral")); n != 1 {
+		t.Errorf("권한 안내 호출 수 = %d, want 1", n)
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+// ─────────────────────────────────────
+// 이모지 연타 방지 (REACTION_COOLDOWN_SECONDS)
+// 같은 사람이 같은 글의 같은 이모지를 짧은 시간 안에 다시 누르면 Sheets 조회/기록과 메시지 갱신 없이 무시한다.
+// 마지막으로 누른 시각은 Lambda 인스턴스 메모리에만 두며, 키는 반응 기록과 같은 해시라 사용자 ID를 남기지 않는다.
+// 인스턴스가 바뀌면 기록이 사라지지만 연타 대부분은 같은 인스턴스로 들어오므로 충분하다.
+
+var reactionClicks = struct {
+	sync.Mutex
+	last map[string]time.Time
+}{last: map[string]time.Time{}}
+
+func (app *App) reactionCooldown() time.Duration {
+	return time.Duration(app.cfg.ReactionCooldownSeconds) * time.Second
+}
+
+// 쿨다운 중이면 true, 아니면 이번 클릭 시각을 기록하고 false
+func (app *App) inReactionCooldown(userID, messageTS, emoji string, now time.Time) bool {
+	cooldown := app.reactionCooldown()
+	if cooldown <= 0 {
+		return false
+	}
+	key := app.generateReactionHash(userID, messageTS, emoji)
+
+	reactionClicks.Lock()
+	defer reactionClicks.Unlock()
+	// 지난 기록은 정리 (메모리가 계속 늘지 않도록)
+	for k, t := range reactionClicks.last {
+		if now.Sub(t) >= cooldown {
+			delete(reactionClicks.last, k)
+		}
+	}
+	if _, ok := reactionClicks.last[key]; ok {
+		log.Printf("[스킵] 이모지 연타 무시 (emoji=%s, ts=%s)", emoji, messageTS)
+		return true
+	}
+	reactionClicks.last[key] = now
+	return false
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRapidReactionClicks(t *testing.T) {
+	tests := []struct {
+		name     string
+		cooldown int
+		ts       string
+		wantRows int // 두 번 연달아 누른 뒤 남은 반응 수
+	}{
+		{"cooldown ignores second click", 2, "2.0", 1},
+		{"no cooldown toggles off", 0, "3.0", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			sh, svc := newFakeSheets(t)
+			app := &App{cfg: &Config{SheetsID: "sheet", ReactionCooldownSeconds: tt.cooldown}, slack: client, sheets: svc}
+
+			for range 2 {
+				app.handleEmojiReaction(context.Background(), emojiClick("C1", tt.ts, "U1"), ActionEmojiThumbsUp, "thumbsup")
+			}
+
+			if got := len(sh.rows("reactions")); got != tt.wantRows {
+				t.Errorf("reactions 행 수 = %d, want %d", got, tt.wantRows)
+			}
+			if tt.cooldown > 0 {
+				if n := len(fs.callsTo("chat.update")); n != 1 {
+					t.Errorf("chat.update 호출 수 = %d, want 1", n)
+				}
+			}
+		})
+	}
+}
+
+func TestReactionCooldownExpires(t *testing.T) {
+	app := &App{cfg: &Config{ReactionCooldownSeconds: 2}}
+	start := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+	steps := []struct {
+		name    string
+		user    string
+		emoji   string
+		after   time.Duration
+		ignored bool
+	}{
+		{"first click", "U1", "hug", 0, false},
+		{"same click within cooldown", "U1", "hug", time.Second, true},
+		{"other emoji", "U1", "muscle", time.Second, false},
+		{"other user", "U2", "hug", time.Second, false},
+		{"same click after cooldown", "U1", "hug", 2 * time.Second, false},
+	}
+	for _, s := range steps {
+		if got := app.inReactionCooldown(s.user, "4.0", s.emoji, start.Add(s.after)); got != s.ignored {
+			t.Errorf("%s: inReactionCooldown = %v, want %v", s.name, got, s.ignored)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 게시 전 숨 고르기 (cooling-off)
+// COOLING_OFF_CATEGORIES에 포함된 카테고리/긴급도(예: "concern", "urgent")의 글은 바로 게시하지 않고
+// 미리보기 화면을 한 번 더 띄운다. 미리보기가 뜬 뒤 COOLING_OFF_SECONDS가 지나야 게시할 수 있다.
+// 작성 내용은 미리보기 뷰의 private_metadata로 전달되므로, 3000자를 넘는 글은 확인 단계를 건너뛰지 않고 줄여 달라고 안내한다.
+
+const (
+	CallbackCoolingOff = "bamboo_cooling_off"
+	BlockIDCoolingOff  = "cooling_off_block"
+	ActionIDCoolingOff = "cooling_off_checkbox"
+	defaultCoolingOff  = 5 * time.Second
+	maxPrivateMetadata = 3000
+	coolingOffTooSoon  = "조금만 더 읽어봐 주세요. %d초 후에 게시할 수 있어요."
+	coolingOffTooLong  = "게시 전 확인 단계에 담기에는 글이 너무 길어요. 조금 줄여서 다시 제출해주세요."
+)
+
+type coolingOffDraft struct {
+	Message  string   `json:"message"`
+	Nickname string   `json:"nickname,omitempty"`
+	Mentions []string `json:"mentions,omitempty"`
+	Category string   `json:"category"`
+	Urgency  string   `json:"urgency"`
+	Mood     string   `json:"mood,omitempty"`
+	ShownAt  int64    `json:"shown_at"` // 미리보기를 띄운 시각 (unix)
+}
+
+func (app *App) coolingOffDelay() time.Duration {
+	if app.cfg.CoolingOffSeconds > 0 {
+		return time.Duration(app.cfg.CoolingOffSeconds) * time.Second
+	}
+	return defaultCoolingOff
+}
+
+func (app *App) needsCoolingOff(category, urgency string) bool {
+	for _, c := range app.cfg.CoolingOffCategories {
+		if c == category || c == urgency {
+			return true
+		}
+	}
+	return false
+}
+
+func buildCoolingOffModal(draft coolingOffDraft, metadata string, delay time.Duration) slack.ModalViewRequest {
+	preview := buildNewPostBlocks(draft.Message, draft.Nickname, draft.Mentions, draft.Category, draft.Urgency, draft.Mood)[:2]
+
+	blocks := []slack.Block{
+		slack.NewSectionBlock(
+			slack.NewTextBlockObject("mrkdwn", fmt.Sprintf("⏳ *게시하기 전에 한 번 더 읽어봐 주세요.*\n%d초 후에 게시할 수 있어요. 마음이 바뀌면 \"돌아가기\"로 수정할 수 있습니다.", int(delay/time.Second)), false, false),
+			nil, nil,
+		),
+		slack.NewDividerBlock(),
+	}
+	blocks = append(blocks, preview...)
+	blocks = append(blocks,
+		slack.NewDividerBlock(),
+		slack.NewInputBlock(
+			BlockIDCoolingOff,
+			slack.NewTextBlockObject("plain_text", "최종 확인", false, false),
+			nil,
+			slack.NewCheckboxGroupsBlockElement(
+				ActionIDCoolingOff,
+				slack.NewOptionBlockObject(
+					"confirmed",
+					slack.NewTextBlockObject("mrkdwn", "*다시 읽어봤고, 이대로 게시할게요*", false, false),
+					nil,
+				),
+			),
+		),
+	)
+
+	return slack.ModalViewRequest{
+		Type:            slack.ViewType("modal"),
+		CallbackID:      CallbackCoolingOff,
+		Title:           slack.NewTextBlockObject("plain_text", "🎋 게시 전 확인", false, false),
+		Submit:          slack.NewTextBlockObject("plain_text", "게시하기", false, false),
+		Close:           slack.NewTextBlockObject("plain_text", "돌아가기", false, false),
+		PrivateMetadata: metadata,
+		Blocks:          slack.Blocks{BlockSet: blocks},
+	}
+}
+
+// 미리보기 뷰를 모달 스택에 올린다 (메타데이터가 너무 길면 글을 줄여 달라는 에러 표시)
+func (app *App) pushCoolingOffView(submitterID, message, nickname string, mentions []string, category, urgency, mood string) (events.LambdaFunctionURLResponse, error) {
+	draft := coolingOffDraft{
+		Message:  message,
+		Nickname: nickname,
+		Mentions: mentions,
+		Category: category,
+		Urgency:  urgency,
+		Mood:     mood,
+		ShownAt:  time.Now().Unix(),
+	}
+	raw, _ := json.Marshal(draft)
+	if utf8.RuneCount(raw) > maxPrivateMetadata {
+		log.Println("[스킵] 메시지가 길어 게시 전 확인 단계를 띄울 수 없음")
+		return respondWithErrors(map[string]string{BlockIDMessage: coolingOffTooLong})
+	}
+
+	response := map[string]interface{}{
+		"response_action": "push",
+		"view":            buildCoolingOffModal(draft, string(raw), app.coolingOffDelay()),
+	}
+	body, _ := json.Marshal(response)
+	log.Printf("[정보] 게시 전 확인 단계 표시 (category=%s, urgency=%s)", category, urgency)
+	return events.LambdaFunctionURLResponse{
+		StatusCode: 200,
+		Headers:    map[string]string{"Content-Type": "application/json"},
+		Body:       string(body),
+	}, nil
+}
+
+// 미리보기 뷰 제출 처리
+func (app *App) handleCoolingOffSubmission(payload slack.InteractionCallback) (events.LambdaFunctionURLResponse, error) {
+	var draft coolingOffDraft
+	if err := json.Unmarshal([]byte(payload.View.PrivateMetadata), &draft); err != nil {
+		log.Printf("[에러] 게시 전 확인 메타데이터 파싱 실패: %v", err)
+		return respondWithErrors(map[string]string{BlockIDCoolingOff: "잘못된 요청입니다"})
+	}
+
+	confirmed := false
+	if block, ok := payload.View.State.Values[BlockIDCoolingOff]; ok {
+		if input, ok := block[ActionIDCoolingOff]; ok {
+			confirmed = len(input.SelectedOptions) > 0
+		}
+	}
+	if !confirmed {
+		return respondWithErrors(map[string]string{BlockIDCoolingOff: "확인 체크박스를 선택해주세요"})
+	}
+
+	if remaining := time.Unix(draft.ShownAt, 0).Add(app.coolingOffDelay()).Sub(time.Now()); remaining > 0 {
+		secs := int((remaining + time.Second - 1) / time.Second)
+		return respondWithErrors(map[string]string{BlockIDCoolingOff: fmt.Sprintf(coolingOffTooSoon, secs)})
+	}
+
+	resp, err := app.postNewMessage(payload.User.ID, draft.Message, draft.Nickname, draft.Mentions, draft.Category, draft.Urgency, draft.Mood)
+	if resp.Body != "" {
+		// 게시 실패 에러는 미리보기 뷰의 확인 블록에 표시
+		var failed struct {
+			Errors map[string]string `json:"errors"`
+		}
+		json.Unmarshal([]byte(resp.Body), &failed)
+		return respondWithErrors(map[string]string{BlockIDCoolingOff: failed.Errors[BlockIDMessage]})
+	}
+	if err != nil {
+		return resp, err
+	}
+
+	// 원래 작성 모달까지 모두 닫기
+	body, _ := json.Marshal(map[string]string{"response_action": "clear"})
+	return events.LambdaFunctionURLResponse{
+		StatusCode: 200,
+		Headers:    map[string]string{"Content-Type": "application/json"},
+		Body:       string(body),
+	}, nil
+}
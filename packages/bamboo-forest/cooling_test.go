@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// 새 글 작성 모달의 정상 입력값
+func newPostValues(category, urgency string) map[string]map[string]slack.BlockAction {
+	return map[string]map[string]slack.BlockAction{
+		BlockIDMessage:  {ActionIDMessage: {Value: "요즘 너무 힘들어요"}},
+		BlockIDCategory: {ActionIDCategory: {SelectedOption: slack.OptionBlockObject{Value: category}}},
+		BlockIDUrgency:  {ActionIDUrgency: {SelectedOption: slack.OptionBlockObject{Value: urgency}}},
+		BlockIDConfirm:  {ActionIDConfirm: {SelectedOptions: []slack.OptionBlockObject{{Value: "confirmed"}}}},
+	}
+}
+
+func coolingOffConfirmed() map[string]map[string]slack.BlockAction {
+	return map[string]map[string]slack.BlockAction{
+		BlockIDCoolingOff: {ActionIDCoolingOff: {SelectedOptions: []slack.OptionBlockObject{{Value: "confirmed"}}}},
+	}
+}
+
+func TestCoolingOffTwoStepFlow(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{CoolingOffCategories: []string{"concern"}, CoolingOffSeconds: 5}, slack: client}
+
+	// 1단계: 민감 카테고리 제출 → 게시하지 않고 미리보기 뷰 push
+	resp, _ := app.handleViewSubmission(viewSubmission(CallbackNewPost, "", newPostValues("concern", "normal")))
+	var pushed struct {
+		ResponseAction string                 `json:"response_action"`
+		View           slack.ModalViewRequest `json:"view"`
+	}
+	if err := json.Unmarshal([]byte(resp.Body), &pushed); err != nil {
+		t.Fatalf("응답 파싱 실패: %v (body=%s)", err, resp.Body)
+	}
+	if pushed.ResponseAction != "push" || pushed.View.CallbackID != CallbackCoolingOff {
+		t.Fatalf("response_action=%q callback=%q, want push/%s", pushed.ResponseAction, pushed.View.CallbackID, CallbackCoolingOff)
+	}
+	if n := len(fs.callsTo("chat.postMessage")); n != 0 {
+		t.Fatalf("확인 전에 게시됨 (%d회)", n)
+	}
+
+	var draft coolingOffDraft
+	if err := json.Unmarshal([]byte(pushed.View.PrivateMetadata), &draft); err != nil {
+		t.Fatalf("메타데이터 파싱 실패: %v", err)
+	}
+	if draft.Message != "요즘 너무 힘들어요" || draft.Category != "concern" || draft.Urgency != "normal" {
+		t.Errorf("메타데이터 = %+v", draft)
+	}
+
+	// 2단계: 대기 시간 전에 제출 → 에러
+	resp, _ = app.handleViewSubmission(viewSubmission(CallbackCoolingOff, pushed.View.PrivateMetadata, coolingOffConfirmed()))
+	if errs := responseErrors(t, resp.Body); !strings.Contains(errs[BlockIDCoolingOff], "초 후에") {
+		t.Errorf("대기 시간 전 제출 에러 = %v", errs)
+	}
+
+	// 2단계: 대기 시간이 지난 뒤 제출 → 게시 + 모달 전체 닫기
+	draft.ShownAt = time.Now().Add(-10 * time.Second).Unix()
+	metadata, _ := json.Marshal(draft)
+	resp, _ = app.handleViewSubmission(viewSubmission(CallbackCoolingOff, string(metadata), coolingOffConfirmed()))
+	if !strings.Contains(resp.Body, `"clear"`) {
+		t.Errorf("응답 = %s, want response_action clear", resp.Body)
+	}
+	posts := fs.callsTo("chat.postMessage")
+	if len(posts) != 1 || posts[0].Form.Get("channel") != DefaultTargetChannelID {
+		t.Fatalf("게시 결과 = %+v", posts)
+	}
+	if !strings.Contains(blocksText(t, posts[0].Form.Get("blocks")), "요즘 너무 힘들어요") {
+		t.Errorf("게시 본문 누락")
+	}
+}
+
+// 미리보기 메타데이터에 담을 수 없을 만큼 긴 글은 게시하지 않고 줄여 달라고 안내
+func TestCoolingOffTooLong(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{CoolingOffCategories: []string{"concern"}}, slack: client}
+
+	resp, _ := app.pushCoolingOffView("U1", strings.Repeat("가", maxPrivateMetadata), "", nil, "concern", "normal", "")
+	if errs := responseErrors(t, resp.Body); errs[BlockIDMessage] != coolingOffTooLong {
+		t.Errorf("긴 글 에러 = %v", errs)
+	}
+	if n := len(fs.callsTo("chat.postMessage")) + len(fs.callsTo("chat.scheduleMessage")); n != 0 {
+		t.Errorf("확인 단계 없이 게시됨 (%d회)", n)
+	}
+}
+
+func TestCoolingOffSkippedForOtherCategories(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{CoolingOffCategories: []string{"concern", "urgent"}}, slack: client}
+
+	resp, _ := app.handleViewSubmission(viewSubmission(CallbackNewPost, "", newPostValues("praise", "normal")))
+	if resp.Body != "" {
+		t.Errorf("일반 카테고리인데 응답 본문 있음: %s", resp.Body)
+	}
+	if n := len(fs.callsTo("chat.postMessage")); n != 1 {
+		t.Errorf("게시 수 = %d, want 1", n)
+	}
+}
+
+func TestCoolingOffAppliesToUrgency(t *testing.T) {
+	app := &App{cfg: &Config{CoolingOffCategories: []string{"urgent"}}}
+	if !app.needsCoolingOff("question", "urgent") {
+		t.Error("긴급 글에 확인 단계가 적용되지 않음")
+	}
+	if app.needsCoolingOff("question", "normal") {
+		t.Error("보통 글에 확인 단계가 적용됨")
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"container/list"
+	"log"
+	"maps"
+	"sync"
+	"time"
+)
+
+// ─────────────────────────────────────
+// 이모지 카운트 캐시 (EMOJI_COUNT_CACHE_SECONDS)
+// Sheets 반응 저장소는 카운트를 셀 때마다 reactions 탭 전체를 읽는다. 같은 글의 카운트를 짧은 시간 안에
+// 다시 셀 때(다시 올리기 조건 확인, 정리 후 갱신 등) 탭을 또 읽지 않도록 최근 글의 카운트를 메모리에 둔다.
+// App과 함께 Lambda 인스턴스가 살아 있는 동안(웜 호출 사이) 유지되고, 글 수는 LRU로 제한한다.
+// 이 인스턴스에서 반응을 기록/취소하면 해당 글 항목을 지우므로, 다른 인스턴스에서 들어온 반응만 TTL 동안 늦게 보일 수 있다.
+
+const (
+	defaultEmojiCountCacheTTL = 30 * time.Second
+	emojiCountCacheCapacity   = 512
+)
+
+type emojiCountEntry struct {
+	messageTS string
+	counts    map[string]int
+	storedAt  time.Time
+}
+
+type emojiCountCache struct {
+	mu       sync.Mutex
+	ttl      time.Duration
+	capacity int
+	order    *list.List // 앞쪽이 최근에 쓴 항목
+	entries  map[string]*list.Element
+}
+
+func newEmojiCountCache(ttl time.Duration, capacity int) *emojiCountCache {
+	return &emojiCountCache{ttl: ttl, capacity: capacity, order: list.New(), entries: map[string]*list.Element{}}
+}
+
+// 설정된 캐시 유지 시간 (기본 30초, 음수면 캐시하지 않음)
+func (app *App) emojiCountCacheTTL() time.Duration {
+	switch {
+	case app.cfg.EmojiCountCacheSeconds < 0:
+		return 0
+	case app.cfg.EmojiCountCacheSeconds > 0:
+		return time.Duration(app.cfg.EmojiCountCacheSeconds) * time.Second
+	}
+	return defaultEmojiCountCacheTTL
+}
+
+// TTL 안에 저장된 카운트 (nil 캐시는 항상 없음)
+func (c *emojiCountCache) get(messageTS string, now time.Time) (map[string]int, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[messageTS]
+	if !ok {
+		return nil, false
+	}
+	entry := el.Value.(*emojiCountEntry)
+	if now.Sub(entry.storedAt) >= c.ttl {
+		c.order.Remove(el)
+		delete(c.entries, messageTS)
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return maps.Clone(entry.counts), true
+}
+
+func (c *emojiCountCache) put(messageTS string, counts map[string]int, now time.Time) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[messageTS]; ok {
+		el.Value = &emojiCountEntry{messageTS: messageTS, counts: maps.Clone(counts), storedAt: now}
+		c.order.MoveToFront(el)
+		return
+	}
+	c.entries[messageTS] = c.order.PushFront(&emojiCountEntry{messageTS: messageTS, counts: maps.Clone(counts), storedAt: now})
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*emojiCountEntry).messageTS)
+	}
+}
+
+// 반응을 기록/취소한 글의 항목 삭제
+func (c *emojiCountCache) invalidate(messageTS string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[messageTS]; ok {
+		c.order.Remove(el)
+		delete(c.entries, messageTS)
+		log.Printf("[디버그] 반응 카운트 캐시 삭제 (ts=%s)", messageTS)
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestEmojiCountCache(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name   string
+		run    func(c *emojiCountCache)
+		ts     string
+		at     time.Duration
+		wantOK bool
+	}{
+		{"hit within ttl", func(c *emojiCountCache) { c.put("1.0", map[string]int{"thumbsup": 1}, now) }, "1.0", 10 * time.Second, true},
+		{"expired after ttl", func(c *emojiCountCache) { c.put("1.0", map[string]int{"thumbsup": 1}, now) }, "1.0", 30 * time.Second, false},
+		{"invalidated", func(c *emojiCountCache) {
+			c.put("1.0", map[string]int{"thumbsup": 1}, now)
+			c.invalidate("1.0")
+		}, "1.0", 0, false},
+		{"least recently used evicted", func(c *emojiCountCache) {
+			c.put("1.0", map[string]int{}, now)
+			c.put("2.0", map[string]int{}, now)
+			c.get("1.0", now)
+			c.put("3.0", map[string]int{}, now)
+		}, "2.0", 0, false},
+		{"recently used kept", func(c *emojiCountCache) {
+			c.put("1.0", map[string]int{}, now)
+			c.put("2.0", map[string]int{}, now)
+			c.get("1.0", now)
+			c.put("3.0", map[string]int{}, now)
+		}, "1.0", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newEmojiCountCache(30*time.Second, 2)
+			tt.run(c)
+			if _, ok := c.get(tt.ts, now.Add(tt.at)); ok != tt.wantOK {
+				t.Errorf("get(%s) ok = %v, want %v", tt.ts, ok, tt.wantOK)
+			}
+		})
+	}
+
+	var disabled *emojiCountCache
+	disabled.put("1.0", map[string]int{"thumbsup": 1}, now)
+	if _, ok := disabled.get("1.0", now); ok {
+		t.Error("nil 캐시가 값을 돌려줌")
+	}
+}
+
+func TestGetEmojiCountsCached(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	sh.seed("reactions", []string{"h1", "1.0", "thumbsup", "t"})
+	app := &App{cfg: &Config{SheetsID: "sheet"}, slack: client, sheets: svc, countCache: newEmojiCountCache(time.Minute, 8)}
+
+	count := func() int {
+		counts, err := app.getEmojiCounts(context.Background(), "1.0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return counts["thumbsup"]
+	}
+
+	if got := count(); got != 1 {
+		t.Fatalf("첫 조회 = %d, want 1", got)
+	}
+	// 다른 인스턴스가 남긴 반응은 TTL 동안 캐시된 값으로 보임
+	sh.seed("reactions", []string{"h2", "1.0", "thumbsup", "t"})
+	if got := count(); got != 1 {
+		t.Errorf("캐시된 조회 = %d, want 1", got)
+	}
+
+	// 이 인스턴스에서 반응을 남기면 항목을 지우고 새로 센다
+	app.handleEmojiReactions(context.Background(), emojiClick("C1", "1.0", "U1", buildNewPostBlocks("글", "", nil, "question", "normal", "")...), []string{"thumbsup"})
+	if got := count(); got != 3 {
+		t.Errorf("반응 후 조회 = %d, want 3", got)
+	}
+	if n := len(fs.callsTo("chat.update")); n != 1 {
+		t.Errorf("chat.update 호출 수 = %d, want 1", n)
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+)
+
+// ─────────────────────────────────────
+// 워크스페이스 커스텀 이모지 반응 (CUSTOM_REACTION_EMOJIS)
+// :party_parrot: 같은 워크스페이스 커스텀 이모지를 기본 이모지 버튼 뒤에 반응 버튼으로 추가한다.
+// 버튼 라벨과 카운트 표시는 `:이름:` 형식으로 두면 Slack이 커스텀 이모지로 그려 준다.
+// 없는 이름은 `:이름:` 글자 그대로 보이므로, 시작할 때 emoji.list(emoji:read 필요)로 확인해 없는 이모지는 빼고 경고한다.
+
+// 커스텀 이모지 버튼 action_id 접두사 (뒤에 이모지 이름)
+const ActionEmojiCustomPrefix = "bamboo_emoji_custom_"
+
+// 커스텀 이모지 기본 가중치 (REACTION_WEIGHTS로 바꿀 수 있음)
+const customEmojiWeight = 1
+
+// 기본 이모지 뒤에 커스텀 이모지를 붙인 반응 목록 (빈 이름, 중복, ":" 감싼 이름 정리)
+func withCustomReactionEmojis(base []reactionEmoji, names []string) []reactionEmoji {
+	out := append([]reactionEmoji{}, base...)
+	seen := map[string]bool{}
+	for _, emoji := range base {
+		seen[emoji.Name] = true
+	}
+	for _, name := range names {
+		name = strings.Trim(strings.TrimSpace(name), ":")
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		out = append(out, reactionEmoji{
+			Name:     name,
+			ActionID: ActionEmojiCustomPrefix + name,
+			Icon:     ":" + name + ":",
+			Weight:   customEmojiWeight,
+		})
+	}
+	return out
+}
+
+// 이모지 반응 버튼의 action_id인지 (기본 이모지 + 커스텀 이모지)
+func isEmojiButtonAction(actionID string) bool {
+	for _, emoji := range reactionEmojis {
+		if emoji.ActionID == actionID {
+			return true
+		}
+	}
+	return false
+}
+
+// 워크스페이스에 있는 커스텀 이모지만 추림 (목록을 못 가져오면 확인 없이 모두 사용)
+func (app *App) existingCustomEmojis(ctx context.Context, names []string) []string {
+	available, err := app.slack.GetEmojiContext(ctx)
+	if err != nil {
+		log.Printf("[경고] 커스텀 이모지 목록 조회 실패, 확인 없이 사용 (emoji:read 스코프 확인): %v", err)
+		return names
+	}
+	var kept []string
+	for _, name := range names {
+		trimmed := strings.Trim(strings.TrimSpace(name), ":")
+		if _, ok := available[trimmed]; !ok {
+			log.Printf("[경고] 워크스페이스에 없는 커스텀 이모지 제외: %s", trimmed)
+			continue
+		}
+		kept = append(kept, trimmed)
+	}
+	return kept
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+func TestCustomReactionEmojis(t *testing.T) {
+	fs, _ := newFakeSlack(t)
+	fs.responses["emoji.list"] = `{"ok":true,"emoji":{"party_parrot":"https://emoji.slack-edge.com/party_parrot.gif"}}`
+	slackOptions = []slack.Option{slack.OptionAPIURL(fs.url)}
+	t.Cleanup(func() {
+		slackOptions = nil
+		reactionEmojis = defaultReactionEmojis
+	})
+
+	// 워크스페이스에 없는 이모지와 기본 이모지 중복은 빠진다
+	cfg := &Config{SlackBotToken: "xoxb-test", SlackSigningSecret: "secret", CustomReactionEmojis: []string{":party_parrot:", "no_such_emoji", "thumbsup"}}
+	if _, err := NewApp(context.Background(), cfg); err != nil {
+		t.Fatalf("NewApp: %v", err)
+	}
+	if got, want := len(reactionEmojis), len(defaultReactionEmojis)+1; got != want {
+		t.Fatalf("반응 이모지 수 = %d, want %d", got, want)
+	}
+	custom := reactionEmojis[len(reactionEmojis)-1]
+	if custom.Name != "party_parrot" || custom.Icon != ":party_parrot:" || custom.ActionID != ActionEmojiCustomPrefix+"party_parrot" {
+		t.Errorf("커스텀 이모지 정의 = %+v", custom)
+	}
+
+	// 버튼 라벨과 카운트는 :이름: 으로 표시
+	buttons := emojiButtons()
+	last := buttons[len(buttons)-1].(*slack.ButtonBlockElement)
+	if last.Text.Text != ":party_parrot:" || !last.Text.Emoji {
+		t.Errorf("버튼 라벨 = %+v", last.Text)
+	}
+	if got := formatEmojiCounts(map[string]int{"party_parrot": 3}); !strings.HasSuffix(got, " │ :party_parrot: 3") {
+		t.Errorf("카운트 표시 = %q", got)
+	}
+
+	// 커스텀 이모지 버튼 클릭도 기본 이모지처럼 기록/집계
+	sh, svc := newFakeSheets(t)
+	app := &App{cfg: &Config{SheetsID: "sheet"}, slack: slack.New("xoxb-test", slack.OptionAPIURL(fs.url)), sheets: svc}
+	payload := emojiClick("C1", "1.0", "U1")
+	payload.ActionCallback.BlockActions = []*slack.BlockAction{{ActionID: custom.ActionID, Value: "party_parrot"}}
+	app.handleBlockAction(context.Background(), payload)
+
+	rows := sh.rows("reactions")
+	if len(rows) != 1 || rows[0][2] != "party_parrot" {
+		t.Errorf("reactions 행 = %v, want party_parrot 1건", rows)
+	}
+	if n := len(fs.callsTo("chat.update")); n != 1 {
+		t.Errorf("chat.update 호출 수 = %d, want 1", n)
+	}
+}
+
+func TestCustomEmojiListFailureKeepsAll(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	fs.responses["emoji.list"] = `{"ok":false,"error":"missing_scope"}`
+	app := &App{cfg: &Config{}, slack: client}
+
+	got := app.existingCustomEmojis(context.Background(), []string{"party_parrot"})
+	if len(got) != 1 || got[0] != "party_parrot" {
+		t.Errorf("existingCustomEmojis = %v, want 확인 없이 그대로", got)
+	}
+}
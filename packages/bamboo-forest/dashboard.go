@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/slack-go/slack"
+	"google.golang.org/api/sheets/v4"
+)
+
+// ─────────────────────────────────────
+// 현황판 메시지 (DASHBOARD, opt-in)
+// 채널마다 카테고리/긴급도 누적 수를 보여주는 메시지 하나를 고정해두고 새 글이 올라올 때마다 수정한다.
+// dashboard 탭 열: A 채널 ID | B 현황판 메시지 ts | C 누적 수 JSON
+// 현황판 메시지가 삭제되었으면 새로 게시하고 ts를 갱신한다.
+
+const dashboardFallbackText = "📊 대나무숲 현황"
+
+type dashboardCounts struct {
+	Categories map[string]int `json:"categories"`
+	Urgencies  map[string]int `json:"urgencies"`
+}
+
+type dashboardState struct {
+	Row       int // 시트 행 번호 (1부터, 0이면 아직 없음)
+	MessageTS string
+	Counts    dashboardCounts
+}
+
+// 채널의 현황판 상태 조회 (없으면 Row=0인 빈 상태)
+func (app *App) loadDashboard(ctx context.Context, channelID string) (*dashboardState, error) {
+	resp, err := app.sheets.Spreadsheets.Values.Get(app.cfg.SheetsID, "dashboard!A:C").Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("Sheets 조회 실패: %w", err)
+	}
+
+	st := &dashboardState{}
+	for i, row := range resp.Values {
+		if len(row) == 0 || fmt.Sprint(row[0]) != channelID {
+			continue
+		}
+		st.Row = i + 1
+		if len(row) > 1 {
+			st.MessageTS = fmt.Sprint(row[1])
+		}
+		if len(row) > 2 {
+			if err := json.Unmarshal([]byte(fmt.Sprint(row[2])), &st.Counts); err != nil {
+				log.Printf("[경고] 현황판 누적 수 파싱 실패, 0부터 다시 셉니다 (channel=%s): %v", channelID, err)
+			}
+		}
+		break
+	}
+	if st.Counts.Categories == nil {
+		st.Counts.Categories = map[string]int{}
+	}
+	if st.Counts.Urgencies == nil {
+		st.Counts.Urgencies = map[string]int{}
+	}
+	return st, nil
+}
+
+func (app *App) saveDashboard(ctx context.Context, channelID string, st *dashboardState) error {
+	counts, err := json.Marshal(st.Counts)
+	if err != nil {
+		return err
+	}
+	values := [][]interface{}{{channelID, st.MessageTS, string(counts)}}
+
+	if st.Row == 0 {
+		_, err = app.sheets.Spreadsheets.Values.Append(
+			app.cfg.SheetsID,
+			"dashboard!A:C",
+			&sheets.ValueRange{Values: values},
+		).ValueInputOption("RAW").Context(ctx).Do()
+		return err
+	}
+
+	_, err = app.sheets.Spreadsheets.Values.Update(
+		app.cfg.SheetsID,
+		fmt.Sprintf("dashboard!A%d:C%d", st.Row, st.Row),
+		&sheets.ValueRange{Values: values},
+	).ValueInputOption("RAW").Context(ctx).Do()
+	return err
+}
+
+// 현황판 블록 (옵션 목록 순서대로 표시)
+func buildDashboardBlocks(counts dashboardCounts) []slack.Block {
+	total := 0
+	var categoryLines []string
+	for _, opt := range categoryOptions {
+		n := counts.Categories[opt.Value]
+		total += n
+		categoryLines = append(categoryLines, fmt.Sprintf("%s  *%d*", categoryLabels[opt.Value], n))
+	}
+	var urgencyLines []string
+	for _, opt := range urgencyOptions {
+		urgencyLines = append(urgencyLines, fmt.Sprintf("%s  *%d*", urgencyLabels[opt.Value], counts.Urgencies[opt.Value]))
+	}
+
+	return []slack.Block{
+		slack.NewHeaderBlock(slack.NewTextBlockObject("plain_text", dashboardFallbackText, false, false)),
+		slack.NewSectionBlock(nil, []*slack.TextBlockObject{
+			slack.NewTextBlockObject("mrkdwn", "*카테고리*\n"+strings.Join(categoryLines, "\n"), false, false),
+			slack.NewTextBlockObject("mrkdwn", "*긴급도*\n"+strings.Join(urgencyLines, "\n"), false, false),
+		}, nil),
+		slack.NewContextBlock("",
+			slack.NewTextBlockObject("mrkdwn", fmt.Sprintf("지금까지 올라온 글 %d개 · 새 글이 올라오면 자동으로 갱신됩니다", total), false, false),
+		),
+	}
+}
+
+// 새 글 게시 후 누적 수를 올리고 현황판 메시지를 수정 (없거나 삭제되었으면 새로 게시 후 고정)
+func (app *App) updateDashboard(ctx context.Context, channelID, category, urgency string) error {
+	st, err := app.loadDashboard(ctx, channelID)
+	if err != nil {
+		return err
+	}
+	st.Counts.Categories[category]++
+	st.Counts.Urgencies[urgency]++
+	blocks := buildDashboardBlocks(st.Counts)
+
+	if st.MessageTS != "" {
+		_, _, _, err := app.slack.UpdateMessage(channelID, st.MessageTS,
+			slack.MsgOptionText(dashboardFallbackText, false),
+			slack.MsgOptionBlocks(blocks...),
+		)
+		switch {
+		case err == nil:
+			return app.saveDashboard(ctx, channelID, st)
+		case isMessageGone(err):
+			log.Printf("[정보] 현황판 메시지가 삭제되어 다시 게시합니다 (channel=%s, ts=%s)", channelID, st.MessageTS)
+		default:
+			return fmt.Errorf("현황판 수정 실패: %w", err)
+		}
+	}
+
+	_, ts, err := app.slack.PostMessage(channelID,
+		slack.MsgOptionText(dashboardFallbackText, false),
+		slack.MsgOptionBlocks(blocks...),
+	)
+	if err != nil {
+		return fmt.Errorf("현황판 게시 실패: %w", err)
+	}
+	if err := app.slack.AddPin(channelID, slack.ItemRef{Channel: channelID, Timestamp: ts}); err != nil {
+		log.Printf("[경고] 현황판 고정 실패 (pins:write 스코프 확인): %v", err)
+	}
+	st.MessageTS = ts
+	log.Printf("[성공] 현황판 메시지 게시 (channel=%s, ts=%s)", channelID, ts)
+	return app.saveDashboard(ctx, channelID, st)
+}
+
+// 수정하려는 메시지가 더 이상 없는 경우
+func isMessageGone(err error) bool {
+	return strings.Contains(err.Error(), "message_not_found")
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestUpdateDashboardCreatesAndPins(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	app := &App{cfg: &Config{SheetsID: "sheet", Dashboard: true}, slack: client, sheets: svc}
+
+	if err := app.updateDashboard(context.Background(), "C1", "concern", "urgent"); err != nil {
+		t.Fatalf("updateDashboard: %v", err)
+	}
+
+	posts := fs.callsTo("chat.postMessage")
+	if len(posts) != 1 {
+		t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+	}
+	if text := blocksText(t, posts[0].Form.Get("blocks")); !strings.Contains(text, "💭 고민  *1*") || !strings.Contains(text, "🔴 긴급  *1*") {
+		t.Errorf("현황판 내용 = %q", text)
+	}
+	if n := len(fs.callsTo("pins.add")); n != 1 {
+		t.Errorf("pins.add 호출 수 = %d, want 1", n)
+	}
+
+	rows := sh.rows("dashboard")
+	if len(rows) != 1 || rows[0][0] != "C1" || rows[0][1] != "1700000000.000100" {
+		t.Fatalf("dashboard 탭 = %v", rows)
+	}
+}
+
+func TestUpdateDashboardIncrements(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	sh.seed("dashboard",
+		[]string{"C0", "0.1", `{"categories":{},"urgencies":{}}`},
+		[]string{"C1", "5.0", `{"categories":{"concern":2},"urgencies":{"urgent":2}}`},
+	)
+	app := &App{cfg: &Config{SheetsID: "sheet", Dashboard: true}, slack: client, sheets: svc}
+
+	if err := app.updateDashboard(context.Background(), "C1", "concern", "normal"); err != nil {
+		t.Fatalf("updateDashboard: %v", err)
+	}
+
+	if n := len(fs.callsTo("chat.postMessage")); n != 0 {
+		t.Errorf("기존 현황판이 있는데 새로 게시함 (%d회)", n)
+	}
+	updates := fs.callsTo("chat.update")
+	if len(updates) != 1 {
+		t.Fatalf("chat.update 호출 수 = %d, want 1", len(updates))
+	}
+	if got := updates[0].Form.Get("ts"); got != "5.0" {
+		t.Errorf("ts = %q, want 5.0", got)
+	}
+	text := blocksText(t, updates[0].Form.Get("blocks"))
+	for _, want := range []string{"💭 고민  *3*", "🔴 긴급  *2*", "🟡 보통  *1*", "지금까지 올라온 글 3개"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("현황판에 %q 없음: %q", want, text)
+		}
+	}
+
+	rows := sh.rows("dashboard")
+	if len(rows) != 2 || rows[1][0] != "C1" || rows[1][1] != "5.0" {
+		t.Fatalf("dashboard 탭 = %v", rows)
+	}
+	var counts dashboardCounts
+	if err := json.Unmarshal([]byte(rows[1][2]), &counts); err != nil {
+		t.Fatalf("누적 수 파싱 실패: %v", err)
+	}
+	if counts.Categories["concern"] != 3 || counts.Urgencies["normal"] != 1 {
+		t.Errorf("누적 수 = %+v", counts)
+	}
+}
+
+func TestUpdateDashboardRecreatesDeletedMessage(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	fs.responses["chat.update"] = `{"ok":false,"error":"message_not_found"}`
+	sh, svc := newFakeSheets(t)
+	sh.seed("dashboard", []string{"C1", "5.0", `{"categories":{"praise":1},"urgencies":{"low":1}}`})
+	app := &App{cfg: &Config{SheetsID: "sheet", Dashboard: true}, slack: client, sheets: svc}
+
+	if err := app.updateDashboard(context.Background(), "C1", "praise", "low"); err != nil {
+		t.Fatalf("updateDashboard: %v", err)
+	}
+
+	posts := fs.callsTo("chat.postMessage")
+	if len(posts) != 1 {
+		t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+	}
+	if text := blocksText(t, posts[0].Form.Get("blocks")); !strings.Contains(text, "👏 칭찬  *2*") {
+		t.Errorf("다시 게시한 현황판이 누적 수를 잃음: %q", text)
+	}
+	rows := sh.rows("dashboard")
+	if len(rows) != 1 || rows[0][1] != "1700000000.000100" {
+		t.Errorf("dashboard 탭 ts 갱신 안 됨: %v", rows)
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 게시 지연 (POST_DELAY_MIN_SECONDS ~ POST_DELAY_MAX_SECONDS)
+// `/bamboo` 실행 직후 글이 바로 올라오면 명령을 실행한 사람과 게시 시각을 맞춰볼 수 있으므로,
+// 범위 안의 임의 시간만큼 뒤로 예약 게시(chat.scheduleMessage)해 시각 상관관계를 끊는다.
+
+// 이번 게시에 적용할 지연 (최대값이 0이면 지연 없음)
+func (app *App) postDelay() time.Duration {
+	lo, hi := app.cfg.PostDelayMinSeconds, app.cfg.PostDelayMaxSeconds
+	if hi <= 0 {
+		return 0
+	}
+	if lo < 0 {
+		lo = 0
+	}
+	if lo > hi {
+		lo = hi
+	}
+	return time.Duration(lo+rand.IntN(hi-lo+1)) * time.Second
+}
+
+// 지정한 시간 뒤로 예약 게시 (예약 게시는 메시지 ts를 돌려주지 않는다)
+func (app *App) scheduleMessage(channelID string, delay time.Duration, options ...slack.MsgOption) (time.Time, error) {
+	postAt := time.Now().Add(delay)
+	_, _, err := app.slack.ScheduleMessage(channelID, strconv.FormatInt(postAt.Unix(), 10), options...)
+	return postAt, err
+}
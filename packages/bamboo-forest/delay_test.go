@@ -0,0 +1,87 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestPostDelayWithinRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		min, max int
+		lo, hi   time.Duration
+	}{
+		{"disabled", 0, 0, 0, 0},
+		{"range", 30, 120, 30 * time.Second, 120 * time.Second},
+		{"min above max", 200, 60, 60 * time.Second, 60 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &App{cfg: &Config{PostDelayMinSeconds: tt.min, PostDelayMaxSeconds: tt.max}}
+			for i := 0; i < 50; i++ {
+				if d := app.postDelay(); d < tt.lo || d > tt.hi {
+					t.Fatalf("postDelay() = %v, want %v~%v", d, tt.lo, tt.hi)
+				}
+			}
+		})
+	}
+}
+
+func TestPostNewMessageSchedulesWithinWindow(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{PostDelayMinSeconds: 10, PostDelayMaxSeconds: 120}, slack: client}
+
+	before := time.Now()
+	if _, err := app.postNewMessage("U1", "지연 게시 테스트", "", nil, "other", "low", ""); err != nil {
+		t.Fatalf("postNewMessage: %v", err)
+	}
+	after := time.Now()
+
+	if n := len(fs.callsTo("chat.postMessage")); n != 0 {
+		t.Errorf("지연 설정인데 즉시 게시됨 (%d회)", n)
+	}
+	scheduled := fs.callsTo("chat.scheduleMessage")
+	if len(scheduled) != 1 {
+		t.Fatalf("chat.scheduleMessage 호출 수 = %d, want 1", len(scheduled))
+	}
+	postAt, err := strconv.ParseInt(scheduled[0].Form.Get("post_at"), 10, 64)
+	if err != nil {
+		t.Fatalf("post_at 파싱 실패: %v", err)
+	}
+	lo := before.Add(10 * time.Second).Unix()
+	hi := after.Add(120 * time.Second).Unix()
+	if postAt < lo || postAt > hi {
+		t.Errorf("post_at = %d, want %d~%d", postAt, lo, hi)
+	}
+	if got := scheduled[0].Form.Get("channel"); got != DefaultTargetChannelID {
+		t.Errorf("channel = %q, want %q", got, DefaultTargetChannelID)
+	}
+}
+
+// 예약 게시한 글도 posts 탭에 예약 시각과 빈 ts로 기록
+func TestPostNewMessageRecordsScheduledPost(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	app := &App{cfg: &Config{SheetsID: "sheet", PostDelayMinSeconds: 10, PostDelayMaxSeconds: 120}, slack: client, sheets: svc}
+
+	if _, err := app.postNewMessage("U1", "지연 게시 테스트", "신입", nil, "concern", "urgent", "good"); err != nil {
+		t.Fatalf("postNewMessage: %v", err)
+	}
+	postAt, _ := strconv.ParseInt(fs.callsTo("chat.scheduleMessage")[0].Form.Get("post_at"), 10, 64)
+
+	rows := sh.rows("posts")
+	if len(rows) != 1 {
+		t.Fatalf("posts 행 수 = %d, want 1", len(rows))
+	}
+	row := rows[0]
+	if want := time.Unix(postAt, 0).Format(time.RFC3339); row[0] != want {
+		t.Errorf("게시 시각 = %q, want 예약 시각 %q", row[0], want)
+	}
+	if row[1] != "" {
+		t.Errorf("메시지 ts = %q, want 빈 값", row[1])
+	}
+	if row[2] != "concern" || row[3] != "urgent" || row[4] != "true" || row[8] != "good" {
+		t.Errorf("posts 행 = %v", row)
+	}
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/slack-go/slack"
+	"google.golang.org/api/sheets/v4"
+)
+
+// ─────────────────────────────────────
+// 작성자 삭제 토큰 (DELETE_TOKENS)
+// 새 글을 게시하면 무작위 삭제 토큰을 만들어 작성자에게만 DM으로 보내고, Sheets deletes 탭에는
+// 토큰의 해시와 메시지 ts만 남긴다 (A 토큰 해시 | B 메시지 ts | C 상태 | D 삭제 시각 | E 사본 채널:ts 목록).
+// 작성자가 /bamboo-delete <토큰>을 쓰면 해시로 글을 찾아 지우고 행을 deleted로 바꿔 토큰을 다시 쓸 수 없게 한다.
+// 긴급 글 사본(URGENT_FANOUT_CHANNELS)처럼 함께 올린 사본도 E열에 남겨 두었다가 같이 지운다.
+// 토큰이 있는지 없는지가 응답 시간으로 드러나지 않도록 모든 행을 끝까지 상수 시간 비교한다.
+
+const (
+	DeleteCommand      = "/bamboo-delete"
+	deleteTokenBytes   = 12
+	deleteStatusDone   = "deleted"
+	deleteUnknownToken = "알 수 없거나 이미 사용한 삭제 토큰입니다."
+)
+
+func newDeleteToken() (string, error) {
+	b := make([]byte, deleteTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func deleteTokenHash(token string) string {
+	sum := sha256.Sum256([]byte("delete|" + token))
+	return hex.EncodeToString(sum[:])
+}
+
+// 새 글 모달의 "삭제 불가" 안내를 삭제 토큰 안내로 바꿈
+func withDeleteNotice(modal slack.ModalViewRequest) slack.ModalViewRequest {
+	for _, block := range modal.Blocks.BlockSet {
+		switch b := block.(type) {
+		case *slack.SectionBlock:
+			if b.Text != nil {
+				b.Text.Text = strings.Replace(b.Text.Text, "수정하거나 삭제할 수 없습니다",
+					"수정할 수 없습니다 (삭제는 게시 후 DM으로 받는 토큰으로 `"+DeleteCommand+"`)", 1)
+			}
+		case *slack.InputBlock:
+			if cb, ok := b.Element.(*slack.CheckboxGroupsBlockElement); ok && b.BlockID == BlockIDConfirm {
+				for _, opt := range cb.Options {
+					opt.Text.Text = strings.Replace(opt.Text.Text, "수정/삭제가 불가능함을", "수정이 불가능함을", 1)
+				}
+			}
+		}
+	}
+	return modal
+}
+
+// 사본 목록 (채널 → ts)을 "채널:ts,채널:ts" 형태로 (채널 순 정렬)
+func formatCopyRefs(copies map[string]string) string {
+	var refs []string
+	for ch, ts := range copies {
+		if ch != "" && ts != "" {
+			refs = append(refs, ch+":"+ts)
+		}
+	}
+	sort.Strings(refs)
+	return strings.Join(refs, ",")
+}
+
+func parseCopyRefs(s string) map[string]string {
+	copies := map[string]string{}
+	for _, ref := range strings.Split(s, ",") {
+		if ch, ts, ok := strings.Cut(strings.TrimSpace(ref), ":"); ok && ch != "" && ts != "" {
+			copies[ch] = ts
+		}
+	}
+	return copies
+}
+
+// 삭제 토큰 발급: 해시와 사본 위치를 기록한 뒤 작성자에게 DM으로 토큰 전달
+func (app *App) issueDeleteToken(ctx context.Context, submitterID, messageTS string, copies map[string]string) error {
+	if app.sheets == nil {
+		return fmt.Errorf("Sheets 서비스 없음")
+	}
+	token, err := newDeleteToken()
+	if err != nil {
+		return fmt.Errorf("토큰 생성 실패: %w", err)
+	}
+	_, err = app.sheets.Spreadsheets.Values.Append(
+		app.cfg.SheetsID,
+		"deletes!A:E",
+		&sheets.ValueRange{Values: [][]interface{}{{deleteTokenHash(token), messageTS, "", "", formatCopyRefs(copies)}}},
+	).ValueInputOption("RAW").Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("토큰 기록 실패: %w", err)
+	}
+
+	text := fmt.Sprintf("🎋 익명 글이 게시되었어요. 나중에 이 글을 지우고 싶으면 아래 명령어를 입력하세요.\n`%s %s`\n토큰은 한 번만 쓸 수 있고 다시 보여드릴 수 없으니 필요하면 따로 보관해주세요.", DeleteCommand, token)
+	if _, _, err := app.slack.PostMessageContext(ctx, submitterID, slack.MsgOptionText(text, false)); err != nil {
+		return fmt.Errorf("토큰 DM 전송 실패: %w", err)
+	}
+	return nil
+}
+
+// 토큰 해시와 일치하는 미사용 행 찾기 (행 번호는 1부터, 없으면 0, 사본 목록은 E열 그대로)
+// 일치 여부와 관계없이 모든 행을 같은 방식으로 비교한다
+func findDeleteRow(rows [][]interface{}, hash string) (int, string, string) {
+	found, foundTS, foundCopies := 0, "", ""
+	for i, row := range rows {
+		if len(row) < 2 {
+			continue
+		}
+		match := subtle.ConstantTimeCompare([]byte(fmt.Sprint(row[0])), []byte(hash))
+		unused := 1
+		if len(row) > 2 && fmt.Sprint(row[2]) == deleteStatusDone {
+			unused = 0
+		}
+		copies := ""
+		if len(row) > 4 {
+			copies = fmt.Sprint(row[4])
+		}
+		if match&unused == 1 {
+			found, foundTS, foundCopies = i+1, fmt.Sprint(row[1]), copies
+		}
+	}
+	return found, foundTS, foundCopies
+}
+
+// /bamboo-delete <토큰>
+func (app *App) handleDeleteCommand(ctx context.Context, values url.Values) (events.LambdaFunctionURLResponse, error) {
+	token := strings.TrimSpace(values.Get("text"))
+	if token == "" {
+		return respondWithSlackError("사용법: `" + DeleteCommand + " <삭제 토큰>` (글을 올릴 때 DM으로 받은 토큰)")
+	}
+	if !app.cfg.DeleteTokens || app.sheets == nil {
+		return respondWithSlackError("글 삭제 기능이 꺼져 있습니다.")
+	}
+
+	resp, err := app.sheets.Spreadsheets.Values.Get(app.cfg.SheetsID, "deletes!A:E").Context(ctx).Do()
+	if err != nil {
+		log.Printf("[에러] 삭제 토큰 조회 실패: %v", err)
+		return respondWithSlackError("삭제 토큰을 확인하지 못했습니다. 잠시 후 다시 시도해주세요.")
+	}
+	row, messageTS, copies := findDeleteRow(resp.Values, deleteTokenHash(token))
+	if row == 0 {
+		return respondWithSlackError(deleteUnknownToken)
+	}
+
+	if _, _, err := app.slack.DeleteMessageContext(ctx, app.targetChannel(), messageTS); err != nil && !strings.Contains(err.Error(), "message_not_found") {
+		log.Printf("[에러] 글 삭제 실패 (ts=%s): %v", messageTS, err)
+		return respondWithSlackError("글을 삭제하지 못했습니다. 잠시 후 다시 시도해주세요.")
+	}
+	// 원글이 지워졌으면 사본 일부를 지우지 못해도 삭제 성공으로 처리
+	for ch, ts := range parseCopyRefs(copies) {
+		if _, _, err := app.slack.DeleteMessageContext(ctx, ch, ts); err != nil && !strings.Contains(err.Error(), "message_not_found") {
+			log.Printf("[경고] 사본 삭제 실패 (channel=%s, ts=%s): %v", ch, ts, err)
+		}
+	}
+
+	// 이미 지워진 글이어도 토큰은 사용 처리
+	_, err = app.sheets.Spreadsheets.Values.Update(
+		app.cfg.SheetsID,
+		fmt.Sprintf("deletes!C%d:D%d", row, row),
+		&sheets.ValueRange{Values: [][]interface{}{{deleteStatusDone, time.Now().Format(time.RFC3339)}}},
+	).ValueInputOption("RAW").Context(ctx).Do()
+	if err != nil {
+		log.Printf("[경고] 삭제 토큰 사용 처리 실패 (row=%d): %v", row, err)
+	}
+
+	log.Printf("[성공] 작성자 요청으로 익명 글 삭제 (ts=%s)", messageTS)
+	return events.LambdaFunctionURLResponse{
+		StatusCode: 200,
+		Headers:    map[string]string{"Content-Type": "text/plain; charset=utf-8"},
+		Body:       "🗑️ 글을 삭제했어요.",
+	}, nil
+}
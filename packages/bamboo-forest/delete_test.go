@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var deleteTokenInDM = regexp.MustCompile("`" + DeleteCommand + ` ([0-9a-f]+)` + "`")
+
+func deleteCommand(text string) url.Values {
+	return url.Values{"command": {DeleteCommand}, "user_id": {"U0"}, "text": {text}}
+}
+
+func TestDeleteTokenFlow(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	app := &App{cfg: &Config{SheetsID: "sheet", DeleteTokens: true}, slack: client, sheets: svc}
+
+	payload := viewSubmission(CallbackNewPost, "", newPostValues("concern", "normal"))
+	payload.User.ID = "U0"
+	app.handleViewSubmission(payload)
+
+	posts := fs.callsTo("chat.postMessage")
+	if len(posts) != 2 {
+		t.Fatalf("chat.postMessage 호출 수 = %d, want 2 (채널 글 + DM)", len(posts))
+	}
+	dm := posts[1].Form
+	if dm.Get("channel") != "U0" {
+		t.Fatalf("토큰 DM 대상 = %q, want U0", dm.Get("channel"))
+	}
+	m := deleteTokenInDM.FindStringSubmatch(dm.Get("text"))
+	if m == nil {
+		t.Fatalf("DM에 토큰이 없음: %q", dm.Get("text"))
+	}
+	token := m[1]
+	if strings.Contains(posts[0].Form.Get("blocks"), token) {
+		t.Error("채널 글에 삭제 토큰이 노출됨")
+	}
+	rows := sh.rows("deletes")
+	if len(rows) != 1 || rows[0][0] != deleteTokenHash(token) || strings.Contains(strings.Join(rows[0], "|"), token) {
+		t.Fatalf("deletes 행 = %v, want 토큰 해시만 기록", rows)
+	}
+
+	resp, _ := app.handleSlashCommand(context.Background(), deleteCommand(token).Encode())
+	if !strings.Contains(resp.Body, "삭제했어요") {
+		t.Fatalf("응답 = %q", resp.Body)
+	}
+	deletes := fs.callsTo("chat.delete")
+	if len(deletes) != 1 || deletes[0].Form.Get("channel") != DefaultTargetChannelID || deletes[0].Form.Get("ts") != rows[0][1] {
+		t.Fatalf("chat.delete 호출 = %v", deletes)
+	}
+	if got := sh.rows("deletes")[0]; len(got) < 3 || got[2] != deleteStatusDone {
+		t.Errorf("사용 처리되지 않음: %v", got)
+	}
+
+	// 같은 토큰은 다시 쓸 수 없다
+	resp, _ = app.handleSlashCommand(context.Background(), deleteCommand(token).Encode())
+	if !strings.Contains(resp.Body, deleteUnknownToken) {
+		t.Errorf("재사용 응답 = %q", resp.Body)
+	}
+	if n := len(fs.callsTo("chat.delete")); n != 1 {
+		t.Errorf("재사용인데 chat.delete 호출됨 (%d회)", n)
+	}
+}
+
+func TestDeleteCommandUnknownToken(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	sh.seed("deletes", []string{deleteTokenHash("known"), "1.0", "", ""})
+	app := &App{cfg: &Config{SheetsID: "sheet", DeleteTokens: true}, slack: client, sheets: svc}
+
+	resp, _ := app.handleDeleteCommand(context.Background(), deleteCommand("guess"))
+	if !strings.Contains(resp.Body, deleteUnknownToken) {
+		t.Errorf("응답 = %q", resp.Body)
+	}
+	if n := len(fs.callsTo("chat.delete")); n != 0 {
+		t.Errorf("알 수 없는 토큰인데 chat.delete 호출됨 (%d회)", n)
+	}
+}
+
+func TestFindDeleteRow(t *testing.T) {
+	rows := [][]interface{}{
+		{"hash-a", "1.0", deleteStatusDone, "2026-10-16T00:00:00Z"},
+		{"hash-b", "2.0"},
+		{"hash-c", "3.0", "", ""},
+		{"hash-d", "4.0", "", "", "CLEAD:4.1"},
+	}
+	tests := []struct {
+		hash       string
+		wantRow    int
+		wantTS     string
+		wantCopies string
+	}{
+		{"hash-a", 0, "", ""},
+		{"hash-b", 2, "2.0", ""},
+		{"hash-c", 3, "3.0", ""},
+		{"hash-d", 4, "4.0", "CLEAD:4.1"},
+		{"hash-x", 0, "", ""},
+	}
+	for _, tt := range tests {
+		row, ts, copies := findDeleteRow(rows, tt.hash)
+		if row != tt.wantRow || ts != tt.wantTS || copies != tt.wantCopies {
+			t.Errorf("findDeleteRow(%q) = (%d, %q, %q), want (%d, %q, %q)", tt.hash, row, ts, copies, tt.wantRow, tt.wantTS, tt.wantCopies)
+		}
+	}
+}
+
+func TestCopyRefsRoundTrip(t *testing.T) {
+	copies := map[string]string{"CLEAD": "1.1", "CHR": "1.2"}
+	formatted := formatCopyRefs(copies)
+	if formatted != "CHR:1.2,CLEAD:1.1" {
+		t.Errorf("formatCopyRefs = %q", formatted)
+	}
+	got := parseCopyRefs(formatted)
+	if len(got) != 2 || got["CLEAD"] != "1.1" || got["CHR"] != "1.2" {
+		t.Errorf("parseCopyRefs = %v, want %v", got, copies)
+	}
+	if got := parseCopyRefs(""); len(got) != 0 {
+		t.Errorf("빈 값 parseCopyRefs = %v", got)
+	}
+}
+
+func TestDeleteCommandRemovesFanoutCopies(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	app := &App{cfg: &Config{SheetsID: "sheet", DeleteTokens: true, UrgentFanoutChannels: []string{"CLEAD", "CHR"}}, slack: client, sheets: svc}
+
+	payload := viewSubmission(CallbackNewPost, "", newPostValues("concern", "urgent"))
+	payload.User.ID = "U0"
+	app.handleViewSubmission(payload)
+
+	var token string
+	for _, call := range fs.callsTo("chat.postMessage") {
+		if m := deleteTokenInDM.FindStringSubmatch(call.Form.Get("text")); m != nil {
+			token = m[1]
+		}
+	}
+	if token == "" {
+		t.Fatal("삭제 토큰 DM이 없음")
+	}
+	if rows := sh.rows("deletes"); len(rows) != 1 || len(rows[0]) < 5 || !strings.Contains(rows[0][4], "CLEAD:") {
+		t.Fatalf("deletes 행 = %v, want E열 사본 목록", rows)
+	}
+
+	app.handleSlashCommand(context.Background(), deleteCommand(token).Encode())
+
+	deleted := map[string]bool{}
+	for _, call := range fs.callsTo("chat.delete") {
+		deleted[call.Form.Get("channel")] = true
+	}
+	for _, ch := range []string{DefaultTargetChannelID, "CLEAD", "CHR"} {
+		if !deleted[ch] {
+			t.Errorf("%s 글이 삭제되지 않음 (삭제된 채널 %v)", ch, deleted)
+		}
+	}
+}
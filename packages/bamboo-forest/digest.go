@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 주간 요약 (WEEKLY_DIGEST)
+// 스케줄 실행에서 마지막 요약 후 7일이 지나면 posts 탭에서 지난 7일 동안 올라온 글을 모아
+// 카테고리별 글 수, 긴급 글 수, 반응 합계를 대상 채널에 올린다. 글 링크나 본문은 넣지 않는다.
+// 마지막으로 요약한 시각은 게시 전에 meta 탭의 weekly_digest_at 행에 기록해 같은 기간을 두 번 요약하지 않는다.
+// 예약 게시한 글은 예약 시각 기준으로 세지만 ts가 없어 반응 합계에는 들어가지 않는다.
+
+const (
+	metaKeyDigestAt = "weekly_digest_at"
+	digestPeriod    = 7 * 24 * time.Hour
+)
+
+// 지난 7일의 글 집계
+type weeklyDigest struct {
+	From       time.Time // 기간 시작
+	To         time.Time // 기간 끝 (요약 시각)
+	Categories map[string]int
+	Urgent     int
+	Reactions  int
+	Total      int
+}
+
+// 요약 메시지 (카테고리는 옵션 목록 순서대로, 글이 없는 카테고리는 생략)
+func buildDigest(d weeklyDigest) string {
+	from, to := d.From.In(kst), d.To.In(kst)
+	lines := []string{fmt.Sprintf("📊 *주간 대나무숲 요약* 지난 7일(%d/%d~%d/%d) 동안 글 %d건이 올라왔어요",
+		from.Month(), from.Day(), to.Month(), to.Day(), d.Total)}
+	for _, opt := range categoryOptions {
+		if n := d.Categories[opt.Value]; n > 0 {
+			lines = append(lines, fmt.Sprintf("• %s %d건", categoryLabels[opt.Value], n))
+		}
+	}
+	lines = append(lines,
+		fmt.Sprintf("• %s %d건", urgencyLabels["urgent"], d.Urgent),
+		fmt.Sprintf("• 반응 합계 %d개", d.Reactions),
+	)
+	return strings.Join(lines, "\n")
+}
+
+// 마지막 요약 후 7일이 지났으면 지난 7일 요약을 게시하고 요약한 글 수를 반환 (글이 없던 기간은 게시하지 않고 기록만)
+func (app *App) postDigest(ctx context.Context, now time.Time) (int, error) {
+	if !app.cfg.WeeklyDigest || app.sheets == nil {
+		return 0, nil
+	}
+
+	row, last, err := app.getMeta(ctx, metaKeyDigestAt)
+	if err != nil {
+		return 0, err
+	}
+	if lastAt, err := time.Parse(time.RFC3339, last); err == nil && now.Sub(lastAt) < digestPeriod {
+		return 0, nil
+	}
+
+	posts, err := app.loadPosts(ctx)
+	if err != nil {
+		return 0, err
+	}
+	d := weeklyDigest{From: now.Add(-digestPeriod), To: now, Categories: map[string]int{}}
+	var periodPosts []string
+	for _, p := range posts {
+		if !inPeriod(p.CreatedAt, d.From, d.To) {
+			continue
+		}
+		d.Total++
+		d.Categories[p.Category]++
+		if p.Urgency == "urgent" {
+			d.Urgent++
+		}
+		// 예약 게시한 글은 ts가 없어 반응을 찾을 수 없음
+		if p.MessageTS != "" {
+			periodPosts = append(periodPosts, p.MessageTS)
+		}
+	}
+	for _, n := range app.reactionTotals(ctx, periodPosts) {
+		d.Reactions += n
+	}
+
+	// 기록에 실패하면 다음 실행마다 같은 요약이 올라가므로 게시하지 않음
+	// (기록 후 게시에 실패한 기간은 다시 요약하지 않음)
+	if err := app.setMeta(ctx, row, metaKeyDigestAt, now.Format(time.RFC3339)); err != nil {
+		return 0, fmt.Errorf("주간 요약 시각 기록 실패: %w", err)
+	}
+	if d.Total > 0 {
+		if _, _, err := app.slack.PostMessageContext(ctx, app.targetChannel(),
+			slack.MsgOptionText(buildDigest(d), false)); err != nil {
+			return 0, fmt.Errorf("주간 요약 게시 실패: %w", err)
+		}
+		log.Printf("[성공] 주간 요약 게시 (글 %d건)", d.Total)
+	}
+	return d.Total, nil
+}
+
+// 글별 반응 수 (Sheets 저장소는 reactions 탭을 한 번만 읽어 글마다 모으고, 다른 저장소는 글마다 조회)
+// 조회에 실패한 글은 경고만 남기고 빼고 센다.
+func (app *App) reactionTotals(ctx context.Context, messageTSs []string) map[string]int {
+	totals := map[string]int{}
+	if len(messageTSs) == 0 {
+		return totals
+	}
+	if app.sheetsReactionsActive() {
+		wanted := map[string]bool{}
+		for _, ts := range messageTSs {
+			wanted[ts] = true
+		}
+		rows, err := app.loadReactionRows(ctx)
+		if err != nil {
+			log.Printf("[경고] 주간 요약 반응 조회 실패: %v", err)
+			return totals
+		}
+		seen := map[string]bool{}
+		for _, r := range rows {
+			if !wanted[r.MessageTS] || seen[r.Hash] {
+				continue
+			}
+			seen[r.Hash] = true
+			totals[r.MessageTS]++
+		}
+		return totals
+	}
+
+	store := app.reactionStore()
+	if store == nil {
+		return totals
+	}
+	for _, ts := range messageTSs {
+		counts, err := store.Counts(ctx, ts)
+		if err != nil {
+			log.Printf("[경고] 주간 요약 반응 조회 실패 (ts=%s): %v", ts, err)
+			continue
+		}
+		for _, n := range counts {
+			totals[ts] += n
+		}
+	}
+	return totals
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuildDigest(t *testing.T) {
+	got := buildDigest(weeklyDigest{
+		From:       time.Date(2026, 11, 4, 10, 0, 0, 0, kst),
+		To:         time.Date(2026, 11, 11, 10, 0, 0, 0, kst),
+		Categories: map[string]int{"concern": 1, "suggestion": 2},
+		Urgent:     1,
+		Reactions:  7,
+		Total:      3,
+	})
+	want := "📊 *주간 대나무숲 요약* 지난 7일(11/4~11/11) 동안 글 3건이 올라왔어요\n" +
+		"• 💡 건의사항 2건\n" +
+		"• 💭 고민 1건\n" +
+		"• 🔴 긴급 1건\n" +
+		"• 반응 합계 7개"
+	if got != want {
+		t.Errorf("buildDigest =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestPostDigest(t *testing.T) {
+	// 2026-11-11(수) 10:00 KST → 지난 7일은 11/4 10:00 ~ 11/11 10:00
+	now := time.Date(2026, 11, 11, 10, 0, 0, 0, kst)
+	at := func(month time.Month, day, hour int) string {
+		return time.Date(2026, month, day, hour, 0, 0, 0, kst).Format(time.RFC3339)
+	}
+	tests := []struct {
+		name      string
+		posts     [][]string
+		wantN     int
+		wantTexts []string
+	}{
+		{
+			"posts in the past 7 days",
+			[][]string{
+				{at(11, 4, 10), "1.0", "suggestion", "urgent"},
+				{at(11, 8, 23), "2.0", "suggestion", "normal"},
+				{at(11, 11, 9), "3.0", "concern", "urgent"},
+				{at(11, 4, 9), "4.0", "praise", "urgent"}, // 7일보다 이전
+				{at(11, 1, 23), "5.0", "question", "low"}, // 7일보다 이전
+			},
+			3,
+			[]string{"11/4~11/11", "글 3건", "💡 건의사항 2건", "💭 고민 1건", "🔴 긴급 2건", "반응 합계 3개"},
+		},
+		{"no posts in the past 7 days", [][]string{{at(11, 2, 9), "4.0", "praise", "normal"}}, 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			sh, svc := newFakeSheets(t)
+			sh.seed("posts", append([][]string{{"created_at", "message_ts", "category", "urgency"}}, tt.posts...)...)
+			sh.seed("reactions",
+				[]string{reactionHashFor("U1", "1.0", "thumbsup"), "1.0", "thumbsup", "t"},
+				[]string{reactionHashFor("U2", "1.0", "hug"), "1.0", "hug", "t"},
+				[]string{reactionHashFor("U1", "3.0", "thumbsup"), "3.0", "thumbsup", "t"},
+				[]string{reactionHashFor("U1", "4.0", "thumbsup"), "4.0", "thumbsup", "t"}, // 기간 밖 글
+			)
+			app := &App{cfg: &Config{SheetsID: "sheet", BambooChannelID: "CBAMBOO", WeeklyDigest: true}, slack: client, sheets: svc}
+
+			n, err := app.postDigest(context.Background(), now)
+			if err != nil {
+				t.Fatalf("postDigest: %v", err)
+			}
+			if n != tt.wantN {
+				t.Errorf("요약한 글 수 = %d, want %d", n, tt.wantN)
+			}
+			if reads := sh.readCount("reactions"); reads > 1 {
+				t.Errorf("reactions 탭 조회 %d회, want 글 수와 관계없이 최대 1회", reads)
+			}
+			posts := fs.callsTo("chat.postMessage")
+			if len(posts) != map[bool]int{true: 1, false: 0}[tt.wantN > 0] {
+				t.Fatalf("chat.postMessage 호출 수 = %d", len(posts))
+			}
+			if len(posts) == 1 {
+				if ch := posts[0].Form.Get("channel"); ch != "CBAMBOO" {
+					t.Errorf("게시 채널 = %q", ch)
+				}
+				text := posts[0].Form.Get("text")
+				for _, want := range tt.wantTexts {
+					if !strings.Contains(text, want) {
+						t.Errorf("요약에 %q 없음: %q", want, text)
+					}
+				}
+			}
+			if got := sh.rows("meta"); len(got) != 1 || got[0][0] != metaKeyDigestAt || got[0][1] != now.Format(time.RFC3339) {
+				t.Errorf("meta 행 = %v", got)
+			}
+
+			// 7일이 지나기 전에 다시 실행하면 요약하지 않는다
+			before := len(fs.callsTo("chat.postMessage"))
+			if n, _ := app.postDigest(context.Background(), now.Add(6*24*time.Hour)); n != 0 || len(fs.callsTo("chat.postMessage")) != before {
+				t.Errorf("재실행 요약 수 = %d", n)
+			}
+
+			// 7일이 지나면 다시 요약하고 시각을 갱신한다
+			next := now.Add(digestPeriod)
+			if _, err := app.postDigest(context.Background(), next); err != nil {
+				t.Fatalf("7일 뒤 postDigest: %v", err)
+			}
+			if got := sh.rows("meta"); len(got) != 1 || got[0][1] != next.Format(time.RFC3339) {
+				t.Errorf("7일 뒤 meta 행 = %v", got)
+			}
+		})
+	}
+}
+
+// 요약 시각을 기록하지 못하면 게시하지 않고 실패를 반환 (다음 실행마다 다시 올라가지 않게)
+func TestPostDigestMetaFailure(t *testing.T) {
+	now := time.Date(2026, 11, 11, 10, 0, 0, 0, kst)
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	sh.seed("posts", []string{now.Add(-time.Hour).Format(time.RFC3339), "1.0", "question", "normal"})
+	sh.seed("meta", []string{metaKeyDigestAt, now.Add(-8 * 24 * time.Hour).Format(time.RFC3339)})
+	sh.failUpdates = true
+	app := &App{cfg: &Config{SheetsID: "sheet", WeeklyDigest: true}, slack: client, sheets: svc}
+
+	if _, err := app.postDigest(context.Background(), now); err == nil {
+		t.Error("meta 기록 실패인데 에러 없음")
+	}
+	if n := len(fs.callsTo("chat.postMessage")); n != 0 {
+		t.Errorf("chat.postMessage 호출 수 = %d, want 0", n)
+	}
+}
+
+// EventBridge 스케줄 이벤트는 서명 검증 없이 주간 요약까지 실행
+func TestScheduledEventPostsDigest(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	sh.seed("posts", []string{time.Now().AddDate(0, 0, -1).Format(time.RFC3339), "1.0", "question", "normal"})
+	app := &App{cfg: &Config{SheetsID: "sheet", SlackSigningSecret: "secret", WeeklyDigest: true}, slack: client, sheets: svc}
+
+	raw := json.RawMessage(`{"source":"aws.events","detail-type":"Scheduled Event","detail":{}}`)
+	if _, err := app.invoke(context.Background(), raw); err != nil {
+		t.Fatalf("invoke: %v", err)
+	}
+	posts := fs.callsTo("chat.postMessage")
+	if len(posts) != 1 || !strings.Contains(posts[0].Form.Get("text"), "주간 대나무숲 요약") {
+		t.Errorf("주간 요약 게시 = %v", posts)
+	}
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"log"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 테스트 모드 (DRY_RUN)
+// 배포 후 설정 확인용. 모더레이터가 작성한 글/답글은 채널 대신 작성자 본인의 DM으로 보낸다.
+// 모더레이터가 아닌 사용자의 글은 평소처럼 채널에 게시된다.
+
+const dryRunNotice = "🧪 테스트 모드(DRY_RUN) 미리보기입니다. 실제 채널에는 게시되지 않았습니다."
+
+func (app *App) isModerator(userID string) bool {
+	for _, id := range app.cfg.ModeratorUserIDs {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// 테스트 모드 대상이면 DM 채널(사용자 ID)과 true 반환
+func (app *App) dryRunTarget(submitterID string) (string, bool) {
+	if !app.cfg.DryRun || submitterID == "" || !app.isModerator(submitterID) {
+		return "", false
+	}
+	log.Println("[정보] 테스트 모드: 모더레이터 DM으로 게시")
+	return submitterID, true
+}
+
+// 미리보기 블록 앞에 테스트 모드 안내 추가
+func withDryRunNotice(blocks []slack.Block) []slack.Block {
+	notice := slack.NewContextBlock("", slack.NewTextBlockObject("mrkdwn", dryRunNotice, false, false))
+	return append([]slack.Block{notice}, blocks...)
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDryRunPostsToModeratorDM(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{DryRun: true, ModeratorUserIDs: []string{"UMOD"}}, slack: client}
+
+	resp, _ := app.postNewMessage("UMOD", "설정 확인용 글입니다", "", nil, "other", "normal", "")
+	if resp.Body != "" {
+		t.Fatalf("게시 실패: %s", resp.Body)
+	}
+
+	posts := fs.callsTo("chat.postMessage")
+	if len(posts) != 1 {
+		t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+	}
+	if got := posts[0].Form.Get("channel"); got != "UMOD" {
+		t.Errorf("게시 대상 = %q, want 모더레이터 DM(UMOD)", got)
+	}
+	if !strings.Contains(posts[0].Form.Get("blocks"), "테스트 모드") {
+		t.Errorf("테스트 모드 안내 누락: %s", posts[0].Form.Get("blocks"))
+	}
+}
+
+func TestDryRunThreadReplyGoesToDM(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{DryRun: true, ModeratorUserIDs: []string{"UMOD"}}, slack: client}
+
+	app.postThreadReply("UMOD", DefaultTargetChannelID+"|1.0", "답글 확인", "", nil, "", "")
+
+	posts := fs.callsTo("chat.postMessage")
+	if len(posts) != 1 {
+		t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+	}
+	if got := posts[0].Form.Get("channel"); got != "UMOD" {
+		t.Errorf("게시 대상 = %q, want UMOD", got)
+	}
+	if got := posts[0].Form.Get("thread_ts"); got != "" {
+		t.Errorf("DM 미리보기에 thread_ts 지정됨: %q", got)
+	}
+}
+
+func TestDryRunIgnoredForNonModerators(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{DryRun: true, ModeratorUserIDs: []string{"UMOD"}}, slack: client}
+
+	app.postNewMessage("UOTHER", "일반 사용자 글", "", nil, "other", "normal", "")
+
+	posts := fs.callsTo("chat.postMessage")
+	if len(posts) != 1 || posts[0].Form.Get("channel") != DefaultTargetChannelID {
+		t.Errorf("일반 사용자 글이 채널에 게시되지 않음: %+v", posts)
+	}
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// ─────────────────────────────────────
+// DynamoDB 반응 저장소 (REACTION_STORE=dynamodb)
+// 테이블: 파티션 키 hash(S), 속성 message_ts(S) | emoji(S) | created_at(S) | expires_at(N, 보관 기간을 쓸 때)
+// 글별 카운트는 message_ts를 파티션 키로 하는 GSI(DYNAMODB_MESSAGE_INDEX, 기본 message_ts-index)로 조회한다.
+// 해시가 파티션 키라 같은 반응을 두 번 기록해도 한 행만 남고, 중복 확인은 키 조회라 반응 수와 관계없이 빠르다.
+// REACTION_RETENTION_DAYS를 쓰면 expires_at에 만료 시각을 넣으므로 테이블 TTL을 expires_at으로 켜 두면 DynamoDB가 지운다.
+
+const (
+	defaultDynamoMessageIndex = "message_ts-index"
+	// BatchGetItem 한 번에 조회할 수 있는 최대 키 수
+	dynamoBatchGetMax = 100
+	// BatchWriteItem 한 번에 쓸 수 있는 최대 항목 수
+	dynamoBatchWriteMax = 25
+)
+
+// DynamoDB 클라이언트 생성 (endpoint가 있으면 DynamoDB Local 등으로 보냄)
+func newDynamoClient(awsCfg aws.Config, endpoint string) *dynamodb.Client {
+	return dynamodb.NewFromConfig(awsCfg, func(o *dynamodb.Options) {
+		if endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+	})
+}
+
+// 테이블 항목
+type dynamoReaction struct {
+	Hash      string `dynamodbav:"hash"`
+	MessageTS string `dynamodbav:"message_ts,omitempty"`
+	Emoji     string `dynamodbav:"emoji,omitempty"`
+	CreatedAt string `dynamodbav:"created_at,omitempty"`
+	ExpiresAt int64  `dynamodbav:"expires_at,omitempty"`
+}
+
+// ─────────────────────────────────────
+// 저장소 구현
+
+type dynamoReactionStore struct {
+	client *dynamodb.Client
+	table  string
+	index  string
+	// 0보다 크면 expires_at(TTL)을 기록
+	retention time.Duration
+}
+
+func (s dynamoReactionStore) CheckDuplicate(ctx context.Context, hashes []string) (map[string]bool, error) {
+	found := map[string]bool{}
+	for start := 0; start < len(hashes); start += dynamoBatchGetMax {
+		var keys []map[string]types.AttributeValue
+		for _, h := range hashes[start:min(start+dynamoBatchGetMax, len(hashes))] {
+			keys = append(keys, map[string]types.AttributeValue{"hash": &types.AttributeValueMemberS{Value: h}})
+		}
+		// 처리되지 않은 키는 다시 요청
+		for len(keys) > 0 {
+			out, err := s.client.BatchGetItem(ctx, &dynamodb.BatchGetItemInput{
+				RequestItems: map[string]types.KeysAndAttributes{
+					s.table: {
+						Keys:                     keys,
+						ProjectionExpression:     aws.String("#h"),
+						ExpressionAttributeNames: map[string]string{"#h": "hash"},
+					},
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+			var items []dynamoReaction
+			if err := attributevalue.UnmarshalListOfMaps(out.Responses[s.table], &items); err != nil {
+				return nil, err
+			}
+			for _, item := range items {
+				found[item.Hash] = true
+			}
+			keys = out.UnprocessedKeys[s.table].Keys
+		}
+	}
+	return found, nil
+}
+
+func (s dynamoReactionStore) Record(ctx context.Context, messageTS string, reactions []pendingReaction) error {
+	now := time.Now()
+	for start := 0; start < len(reactions); start += dynamoBatchWriteMax {
+		var writes []types.WriteRequest
+		for _, r := range reactions[start:min(start+dynamoBatchWriteMax, len(reactions))] {
+			item := dynamoReaction{Hash: r.Hash, MessageTS: messageTS, Emoji: r.Emoji, CreatedAt: now.Format(time.RFC3339)}
+			if s.retention > 0 {
+				item.ExpiresAt = now.Add(s.retention).Unix()
+			}
+			av, err := attributevalue.MarshalMap(item)
+			if err != nil {
+				return err
+			}
+			writes = append(writes, types.WriteRequest{PutRequest: &types.PutRequest{Item: av}})
+		}
+		// 처리되지 않은 항목은 다시 요청
+		for len(writes) > 0 {
+			out, err := s.client.BatchWriteItem(ctx, &dynamodb.BatchWriteItemInput{
+				RequestItems: map[string][]types.WriteRequest{s.table: writes},
+			})
+			if err != nil {
+				return err
+			}
+			writes = out.UnprocessedItems[s.table]
+		}
+	}
+	return nil
+}
+
+func (s dynamoReactionStore) Counts(ctx context.Context, messageTS string) (map[string]int, error) {
+	counts := map[string]int{}
+	read := 0
+	pages := dynamodb.NewQueryPaginator(s.client, &dynamodb.QueryInput{
+		TableName:                 aws.String(s.table),
+		IndexName:                 aws.String(s.index),
+		KeyConditionExpression:    aws.String("message_ts = :ts"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{":ts": &types.AttributeValueMemberS{Value: messageTS}},
+		ProjectionExpression:      aws.String("emoji"),
+	})
+	for pages.HasMorePages() {
+		out, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var items []dynamoReaction
+		if err := attributevalue.UnmarshalListOfMaps(out.Items, &items); err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			counts[item.Emoji]++
+		}
+		read += len(items)
+	}
+	log.Printf("[디버그] 카운트 계산에 DynamoDB 항목 %d개 조회 (ts=%s)", read, messageTS)
+	return counts, nil
+}
+
+func (s dynamoReactionStore) Remove(ctx context.Context, hash string) (int, error) {
+	out, err := s.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName:    aws.String(s.table),
+		Key:          map[string]types.AttributeValue{"hash": &types.AttributeValueMemberS{Value: hash}},
+		ReturnValues: types.ReturnValueAllOld,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(out.Attributes) == 0 {
+		return 0, nil
+	}
+	return 1, nil
+}
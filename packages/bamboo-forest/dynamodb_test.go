@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// DynamoDB JSON 속성 값 (이 저장소는 문자열과 숫자만 씀)
+type fakeDynamoValue struct {
+	S string `json:"S,omitempty"`
+	N string `json:"N,omitempty"`
+}
+
+type fakeDynamoItem map[string]fakeDynamoValue
+
+// 메모리에 항목을 두는 DynamoDB JSON API 서버 (이 저장소가 쓰는 작업만)
+type fakeDynamo struct {
+	mu    sync.Mutex
+	items map[string]fakeDynamoItem // hash → 항목
+	ops   []string
+	auth  []string
+}
+
+func newFakeDynamo(t *testing.T) (*fakeDynamo, dynamoReactionStore) {
+	t.Helper()
+	fd := &fakeDynamo{items: map[string]fakeDynamoItem{}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		op := strings.TrimPrefix(r.Header.Get("X-Amz-Target"), "DynamoDB_20120810.")
+
+		fd.mu.Lock()
+		defer fd.mu.Unlock()
+		fd.ops = append(fd.ops, op)
+		fd.auth = append(fd.auth, r.Header.Get("Authorization"))
+
+		var out interface{}
+		switch op {
+		case "BatchGetItem":
+			var in struct {
+				RequestItems map[string]struct{ Keys []fakeDynamoItem }
+			}
+			json.Unmarshal(raw, &in)
+			var found []fakeDynamoItem
+			for _, k := range in.RequestItems["reactions"].Keys {
+				if item, ok := fd.items[k["hash"].S]; ok {
+					found = append(found, fakeDynamoItem{"hash": item["hash"]})
+				}
+			}
+			out = map[string]interface{}{"Responses": map[string][]fakeDynamoItem{"reactions": found}}
+		case "BatchWriteItem":
+			var in struct {
+				RequestItems map[string][]struct{ PutRequest struct{ Item fakeDynamoItem } }
+			}
+			json.Unmarshal(raw, &in)
+			for _, wr := range in.RequestItems["reactions"] {
+				fd.items[wr.PutRequest.Item["hash"].S] = wr.PutRequest.Item
+			}
+			out = map[string]interface{}{}
+		case "Query":
+			var in struct {
+				IndexName                 string
+				ExpressionAttributeValues fakeDynamoItem
+			}
+			json.Unmarshal(raw, &in)
+			if in.IndexName != defaultDynamoMessageIndex {
+				t.Errorf("Query IndexName = %q", in.IndexName)
+			}
+			var items []fakeDynamoItem
+			for _, item := range fd.items {
+				if item["message_ts"].S == in.ExpressionAttributeValues[":ts"].S {
+					items = append(items, fakeDynamoItem{"emoji": item["emoji"]})
+				}
+			}
+			out = map[string]interface{}{"Items": items}
+		case "DeleteItem":
+			var in struct{ Key fakeDynamoItem }
+			json.Unmarshal(raw, &in)
+			old, ok := fd.items[in.Key["hash"].S]
+			delete(fd.items, in.Key["hash"].S)
+			if ok {
+				out = map[string]interface{}{"Attributes": old}
+			} else {
+				out = map[string]interface{}{}
+			}
+		default:
+			w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+			w.WriteHeader(http.StatusBadRequest)
+			io.WriteString(w, `{"__type":"UnknownOperationException","message":"`+op+`"}`)
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		json.NewEncoder(w).Encode(out)
+	}))
+	t.Cleanup(srv.Close)
+
+	awsCfg := aws.Config{
+		Region:           "ap-northeast-2",
+		RetryMaxAttempts: 1,
+		Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "AKIDTEST", SecretAccessKey: "secret"}, nil
+		}),
+	}
+	return fd, dynamoReactionStore{client: newDynamoClient(awsCfg, srv.URL), table: "reactions", index: defaultDynamoMessageIndex}
+}
+
+func TestDynamoReactionStore(t *testing.T) {
+	fd, store := newFakeDynamo(t)
+	ctx := context.Background()
+
+	err := store.Record(ctx, "1.0", []pendingReaction{{Hash: "h1", Emoji: "thumbsup"}, {Hash: "h2", Emoji: "hug"}})
+	if err != nil {
+		t.Fatalf("Record: %v", err)
+	}
+	store.Record(ctx, "2.0", []pendingReaction{{Hash: "h3", Emoji: "thumbsup"}})
+
+	dup, err := store.CheckDuplicate(ctx, []string{"h1", "h9"})
+	if err != nil || !dup["h1"] || dup["h9"] {
+		t.Errorf("CheckDuplicate = %v, %v", dup, err)
+	}
+
+	counts, err := store.Counts(ctx, "1.0")
+	if err != nil || counts["thumbsup"] != 1 || counts["hug"] != 1 {
+		t.Errorf("Counts(1.0) = %v, %v", counts, err)
+	}
+
+	if n, err := store.Remove(ctx, "h1"); err != nil || n != 1 {
+		t.Errorf("Remove(h1) = %d, %v", n, err)
+	}
+	if n, _ := store.Remove(ctx, "h1"); n != 0 {
+		t.Errorf("없는 반응 Remove = %d, want 0", n)
+	}
+
+	for i, auth := range fd.auth {
+		if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKIDTEST/") || !strings.Contains(auth, "/ap-northeast-2/dynamodb/aws4_request") {
+			t.Errorf("%s 요청 서명 = %q", fd.ops[i], auth)
+		}
+	}
+}
+
+func TestDynamoRecordSetsTTL(t *testing.T) {
+	fd, store := newFakeDynamo(t)
+	store.retention = 24 * time.Hour
+
+	store.Record(context.Background(), "1.0", []pendingReaction{{Hash: "h1", Emoji: "thumbsup"}})
+	if fd.items["h1"]["expires_at"].N == "" {
+		t.Error("보관 기간이 있는데 expires_at 없음")
+	}
+}
+
+func TestDynamoStoreEmojiToggle(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	fd, store := newFakeDynamo(t)
+	app := &App{cfg: &Config{}, slack: client, reactions: store}
+
+	click := func() {
+		app.handleEmojiReaction(context.Background(), emojiClick("C1", "1.0", "U1", buildNewPostBlocks("고민이 있어요", "", nil, "question", "normal", "")...), ActionEmojiThumbsUp, "thumbsup")
+	}
+
+	click()
+	if len(fd.items) != 1 {
+		t.Fatalf("첫 클릭 후 항목 수 = %d, want 1", len(fd.items))
+	}
+	if !strings.Contains(fs.callsTo("chat.update")[0].Form.Get("blocks"), "👍 1") {
+		t.Errorf("카운트 블록 = %s", fs.callsTo("chat.update")[0].Form.Get("blocks"))
+	}
+
+	click()
+	if len(fd.items) != 0 {
+		t.Errorf("두 번째 클릭 후 항목 수 = %d, want 0 (취소)", len(fd.items))
+	}
+	if n := len(fs.callsTo("chat.update")); n != 2 {
+		t.Errorf("chat.update 호출 수 = %d, want 2", n)
+	}
+}
+
+func TestInitReactionStore(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{"default sheets", Config{}, false},
+		{"explicit sheets", Config{ReactionStore: "Sheets"}, false},
+		{"dynamodb without table", Config{ReactionStore: "dynamodb"}, true},
+		{"unknown", Config{ReactionStore: "redis"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &App{cfg: &tt.cfg}
+			err := app.initReactionStore(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("initReactionStore err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if app.reactions != nil {
+				t.Error("Sheets 저장소는 별도 저장소를 두지 않아야 함")
+			}
+		})
+	}
+}
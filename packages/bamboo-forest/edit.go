@@ -0,0 +1,277 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/slack-go/slack"
+	"google.golang.org/api/sheets/v4"
+)
+
+// ─────────────────────────────────────
+// 게시 직후 본문 수정 (EDIT_GRACE_MINUTES)
+// 새 글에 "✏️ 수정" 버튼을 달고, 작성자가 게시 후 N분 안에 누르면 본문을 미리 채운 모달을 열어 다시 쓰게 한다.
+// 작성자 확인을 위해 edits 탭(A 작성자 해시 | B 메시지 ts | C 게시 시각)에 hash(userID + messageTS)만 남기므로
+// 시트를 봐도 누가 썼는지 알 수 없고, 채널에는 어떤 식별 정보도 드러나지 않는다.
+// 작성자가 아니거나 시간이 지난 경우 버튼은 아무것도 바꾸지 않고 누른 사람에게만 안내한다.
+
+const (
+	ActionEditButton = "bamboo_edit_post"
+	CallbackEditPost = "bamboo_edit_post_modal"
+	editedMark       = " │ ✏️ 수정됨"
+)
+
+var mentionPrefixRegex = regexp.MustCompile(`^(<@[A-Z0-9]+> ?)+\n\n`)
+
+func (app *App) editGrace() time.Duration {
+	return time.Duration(app.cfg.EditGraceMinutes) * time.Minute
+}
+
+// 작성자 해시: HMAC-SHA256(서명 비밀 값, "edit" + userID + messageTS)
+// 비밀 값 없이 만든 해시는 시트를 본 사람이 사용자 ID 목록으로 작성자를 역추적할 수 있다.
+func (app *App) editAuthorHash(userID, messageTS string) string {
+	mac := hmac.New(sha256.New, []byte(app.cfg.SlackSigningSecret))
+	mac.Write([]byte("edit|" + userID + "|" + messageTS))
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
+
+// 글 하단 버튼 줄(답글 버튼이 있는 블록) 끝에 수정 버튼 추가
+func withEditButton(blocks []slack.Block) []slack.Block {
+	button := slack.NewButtonBlockElement(
+		ActionEditButton,
+		"edit",
+		slack.NewTextBlockObject("plain_text", "✏️ 수정", true, false),
+	)
+
+	out := make([]slack.Block, 0, len(blocks))
+	for _, block := range blocks {
+		if b, ok := block.(*slack.ActionBlock); ok && hasReplyButton(b) {
+			elements := append(append([]slack.BlockElement{}, b.Elements.ElementSet...), button)
+			block = slack.NewActionBlock(b.BlockID, elements...)
+		}
+		out = append(out, block)
+	}
+	return out
+}
+
+// 새 글 모달의 "수정/삭제 불가" 안내를 수정 가능 시간에 맞게 바꿈
+func withEditNotice(modal slack.ModalViewRequest, minutes int) slack.ModalViewRequest {
+	for _, block := range modal.Blocks.BlockSet {
+		switch b := block.(type) {
+		case *slack.SectionBlock:
+			if b.Text != nil {
+				b.Text.Text = strings.Replace(b.Text.Text, "게시된 메시지는 수정하거나 삭제할 수 없습니다",
+					fmt.Sprintf("게시 후 %d분 동안은 본문을 수정할 수 있고, 그 뒤에는 수정하거나 삭제할 수 없습니다", minutes), 1)
+			}
+		case *slack.InputBlock:
+			if cb, ok := b.Element.(*slack.CheckboxGroupsBlockElement); ok && b.BlockID == BlockIDConfirm {
+				for _, opt := range cb.Options {
+					opt.Text.Text = strings.Replace(opt.Text.Text, "게시 후 수정/삭제가 불가능함을",
+						fmt.Sprintf("게시 %d분 뒤에는 수정/삭제가 불가능함을", minutes), 1)
+				}
+			}
+		}
+	}
+	return modal
+}
+
+// 게시한 글의 작성자 해시 기록 (열람 전용 사본이 있으면 D열에 위치를 함께 남긴다)
+func (app *App) recordEditGrant(ctx context.Context, submitterID, messageTS string, now time.Time, copies map[string]string) error {
+	if app.sheets == nil {
+		return fmt.Errorf("Sheets 서비스 없음")
+	}
+	_, err := app.sheets.Spreadsheets.Values.Append(
+		app.cfg.SheetsID,
+		"edits!A:D",
+		&sheets.ValueRange{Values: [][]interface{}{{app.editAuthorHash(submitterID, messageTS), messageTS, now.Format(time.RFC3339), formatCopyRefs(copies)}}},
+	).ValueInputOption("RAW").Context(ctx).Do()
+	return err
+}
+
+// 수정 가능 여부 확인 (불가능하면 누른 사람에게 보여줄 안내 문구 반환)
+func (app *App) checkEditAllowed(ctx context.Context, userID, messageTS string, now time.Time) string {
+	_, notice := app.findEditGrant(ctx, userID, messageTS, now)
+	return notice
+}
+
+// 수정 권한 행 조회: 수정할 수 있으면 함께 기록한 사본 위치, 아니면 안내 문구
+func (app *App) findEditGrant(ctx context.Context, userID, messageTS string, now time.Time) (map[string]string, string) {
+	notAuthor := "이 글은 작성자만 수정할 수 있어요."
+	if app.sheets == nil || app.editGrace() <= 0 {
+		return nil, notAuthor
+	}
+	resp, err := app.sheets.Spreadsheets.Values.Get(app.cfg.SheetsID, "edits!A:D").Context(ctx).Do()
+	if err != nil {
+		log.Printf("[경고] 수정 권한 조회 실패: %v", err)
+		return nil, "수정 권한을 확인하지 못했어요. 잠시 후 다시 시도해주세요."
+	}
+
+	hash := app.editAuthorHash(userID, messageTS)
+	for _, row := range resp.Values {
+		if len(row) < 3 || fmt.Sprint(row[0]) != hash || fmt.Sprint(row[1]) != messageTS {
+			continue
+		}
+		postedAt, err := time.Parse(time.RFC3339, fmt.Sprint(row[2]))
+		if err != nil {
+			return nil, notAuthor
+		}
+		if now.Sub(postedAt) > app.editGrace() {
+			return nil, fmt.Sprintf("수정 가능 시간(게시 후 %d분)이 지나 더 이상 수정할 수 없어요.", app.cfg.EditGraceMinutes)
+		}
+		copies := ""
+		if len(row) > 3 {
+			copies = fmt.Sprint(row[3])
+		}
+		return parseCopyRefs(copies), ""
+	}
+	return nil, notAuthor
+}
+
+// 본문 섹션 텍스트를 멘션 부분과 본문으로 나눔
+func splitMentionPrefix(text string) (string, string) {
+	prefix := mentionPrefixRegex.FindString(text)
+	return prefix, strings.TrimPrefix(text, prefix)
+}
+
+// 글의 본문 섹션 블록
+func postBodyBlock(blocks []slack.Block) *slack.SectionBlock {
+	for _, block := range blocks {
+		if b, ok := block.(*slack.SectionBlock); ok && b.Text != nil {
+			return b
+		}
+	}
+	return nil
+}
+
+func buildEditPostModal(channelID, messageTS, body string) slack.ModalViewRequest {
+	input := slack.NewPlainTextInputBlockElement(
+		slack.NewTextBlockObject("plain_text", "익명으로 전달하고 싶은 이야기를 적어주세요...", false, false),
+		ActionIDMessage,
+	).WithMultiline(true)
+	input.InitialValue = body
+
+	return slack.ModalViewRequest{
+		Type:            slack.ViewType("modal"),
+		CallbackID:      CallbackEditPost,
+		PrivateMetadata: fmt.Sprintf("%s|%s", channelID, messageTS),
+		Title:           slack.NewTextBlockObject("plain_text", "✏️ 글 수정", false, false),
+		Submit:          slack.NewTextBlockObject("plain_text", "수정하기", false, false),
+		Close:           slack.NewTextBlockObject("plain_text", "취소", false, false),
+		Blocks: slack.Blocks{
+			BlockSet: []slack.Block{
+				slack.NewInputBlock(
+					BlockIDMessage,
+					slack.NewTextBlockObject("plain_text", "익명 메시지", false, false),
+					slack.NewTextBlockObject("plain_text", "본문만 바뀌고 카테고리·닉네임·멘션·이모지 반응은 그대로 유지됩니다", false, false),
+					input,
+				),
+			},
+		},
+	}
+}
+
+// ✏️ 수정 버튼 클릭: 작성자이고 시간 안이면 본문을 채운 모달 열기, 아니면 안내만
+func (app *App) handleEditButton(ctx context.Context, payload slack.InteractionCallback) (events.LambdaFunctionURLResponse, error) {
+	channelID, messageTS, userID := payload.Channel.ID, payload.Message.Timestamp, payload.User.ID
+
+	if notice := app.checkEditAllowed(ctx, userID, messageTS, time.Now()); notice != "" {
+		if _, err := app.slack.PostEphemeral(channelID, userID, slack.MsgOptionText(notice, false)); err != nil {
+			log.Printf("[경고] 수정 불가 안내 전송 실패: %v", err)
+		}
+		return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+	}
+
+	body := ""
+	if b := postBodyBlock(payload.Message.Blocks.BlockSet); b != nil {
+		_, body = splitMentionPrefix(b.Text.Text)
+	}
+	if _, err := app.slack.OpenView(payload.TriggerID, buildEditPostModal(channelID, messageTS, body)); err != nil {
+		log.Printf("[에러] 수정 모달 열기 실패: %v", err)
+		return respondWithSlackError("수정 모달을 열 수 없습니다. 잠시 후 다시 시도해주세요.")
+	}
+	return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+}
+
+// 수정 모달 제출: 권한과 시간을 다시 확인하고 본문 섹션만 교체
+func (app *App) handleEditSubmission(payload slack.InteractionCallback) (events.LambdaFunctionURLResponse, error) {
+	ctx := context.Background()
+	parts := strings.Split(payload.View.PrivateMetadata, "|")
+	if len(parts) != 2 {
+		return respondWithError("잘못된 요청입니다")
+	}
+	channelID, messageTS := parts[0], parts[1]
+	submitterID := payload.User.ID
+
+	message := strings.TrimSpace(payload.View.State.Values[BlockIDMessage][ActionIDMessage].Value)
+	message, _ = app.applyMentionPolicy(message, nil)
+	if message == "" {
+		return respondWithError("메시지를 입력해주세요")
+	}
+	if lengthErr := app.validateMessageLength(message); lengthErr != "" {
+		return respondWithError(lengthErr)
+	}
+	if app.containsBannedWord(message) {
+		return respondWithError(bannedWordMessage)
+	}
+	copies, notice := app.findEditGrant(ctx, submitterID, messageTS, time.Now())
+	if notice != "" {
+		return respondWithError(notice)
+	}
+	message, _ = scrubSubmitter(submitterID, message, nil)
+
+	history, err := app.slack.GetConversationHistoryContext(ctx, &slack.GetConversationHistoryParameters{
+		ChannelID: channelID,
+		Latest:    messageTS,
+		Inclusive: true,
+		Limit:     1,
+	})
+	if err != nil || len(history.Messages) == 0 || history.Messages[0].Timestamp != messageTS {
+		log.Printf("[에러] 수정할 글 조회 실패 (ts=%s): %v", messageTS, err)
+		return respondWithError("수정할 글을 찾지 못했습니다. 잠시 후 다시 시도해주세요.")
+	}
+
+	blocks := history.Messages[0].Blocks.BlockSet
+	body := postBodyBlock(blocks)
+	if body == nil {
+		return respondWithError("수정할 글을 찾지 못했습니다. 잠시 후 다시 시도해주세요.")
+	}
+	prefix, _ := splitMentionPrefix(body.Text.Text)
+	body.Text.Text = prefix + message
+	markEdited(blocks)
+
+	if err := app.checkAnonymity(submitterID, blocks); err != nil {
+		return respondWithError(anonymityErrorMessage)
+	}
+	if _, _, _, err := app.slack.UpdateMessageContext(ctx, channelID, messageTS, slack.MsgOptionBlocks(blocks...)); err != nil {
+		log.Printf("[에러] 글 수정 실패: %v", err)
+		return respondWithError("글 수정에 실패했습니다. 잠시 후 다시 시도해주세요.")
+	}
+	app.updateMirror(ctx, copies, blocks)
+
+	log.Printf("[성공] 익명 글 수정 (ts=%s)", messageTS)
+	return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+}
+
+// 헤더 끝에 수정됨 표시 (한 번만)
+func markEdited(blocks []slack.Block) {
+	for _, block := range blocks {
+		b, ok := block.(*slack.ContextBlock)
+		if !ok || b.BlockID == "emoji_counts" || len(b.ContextElements.Elements) == 0 {
+			continue
+		}
+		if text, ok := b.ContextElements.Elements[0].(*slack.TextBlockObject); ok && strings.HasPrefix(text.Text, "🎋") {
+			if !strings.Contains(text.Text, editedMark) {
+				text.Text += editedMark
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+const editHistoryResponse = `{"ok":true,"messages":[{"ts":"1.0","text":"글","blocks":[
+	{"type":"context","elements":[{"type":"mrkdwn","text":"🎋 *익명 게시* │ 💬 질문"}]},
+	{"type":"section","text":{"type":"mrkdwn","text":"<@U9>\n\n처음 쓴 본문"}}
+]}]}`
+
+func TestCheckEditAllowed(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		userID   string
+		postedAt time.Time
+		want     string
+	}{
+		{"author within window", "U0", now.Add(-4 * time.Minute), ""},
+		{"author after window", "U0", now.Add(-6 * time.Minute), "수정 가능 시간"},
+		{"other user", "U1", now.Add(-time.Minute), "작성자만"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sheetsFake, svc := newFakeSheets(t)
+			sheetsFake.seed("edits", []string{editHashFor("U0", "1.0"), "1.0", tt.postedAt.Format(time.RFC3339)})
+			app := &App{cfg: &Config{EditGraceMinutes: 5}, sheets: svc}
+
+			got := app.checkEditAllowed(context.Background(), tt.userID, "1.0", now)
+			if tt.want == "" && got != "" || !strings.Contains(got, tt.want) {
+				t.Errorf("checkEditAllowed = %q, want %q 포함", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEditButtonOpensPrefilledModal(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sheetsFake, svc := newFakeSheets(t)
+	sheetsFake.seed("edits", []string{editHashFor("U0", "1.0"), "1.0", time.Now().Format(time.RFC3339)})
+	app := &App{cfg: &Config{EditGraceMinutes: 5}, slack: client, sheets: svc}
+
+	body := slack.NewSectionBlock(slack.NewTextBlockObject("mrkdwn", "<@U9>\n\n처음 쓴 본문", false, false), nil, nil)
+	payload := emojiClick("C1", "1.0", "U0", body)
+	payload.TriggerID = "trigger"
+	payload.ActionCallback.BlockActions = []*slack.BlockAction{{ActionID: ActionEditButton}}
+	app.handleBlockAction(context.Background(), payload)
+
+	opens := fs.callsTo("views.open")
+	if len(opens) != 1 {
+		t.Fatalf("views.open 호출 수 = %d, want 1", len(opens))
+	}
+	var req struct {
+		View slack.ModalViewRequest `json:"view"`
+	}
+	if err := json.Unmarshal([]byte(opens[0].Body), &req); err != nil {
+		t.Fatalf("views.open 본문 파싱 실패: %v", err)
+	}
+	if req.View.CallbackID != CallbackEditPost || req.View.PrivateMetadata != "C1|1.0" {
+		t.Errorf("callback=%q metadata=%q", req.View.CallbackID, req.View.PrivateMetadata)
+	}
+	if !strings.Contains(opens[0].Body, `"initial_value":"처음 쓴 본문"`) {
+		t.Errorf("본문이 미리 채워지지 않음 (멘션 제외): %s", opens[0].Body)
+	}
+}
+
+func TestEditButtonRejectsOthers(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sheetsFake, svc := newFakeSheets(t)
+	sheetsFake.seed("edits", []string{editHashFor("U0", "1.0"), "1.0", time.Now().Format(time.RFC3339)})
+	app := &App{cfg: &Config{EditGraceMinutes: 5}, slack: client, sheets: svc}
+
+	payload := emojiClick("C1", "1.0", "U1")
+	payload.ActionCallback.BlockActions = []*slack.BlockAction{{ActionID: ActionEditButton}}
+	app.handleBlockAction(context.Background(), payload)
+
+	if n := len(fs.callsTo("views.open")); n != 0 {
+		t.Errorf("작성자가 아닌데 모달이 열림 (%d회)", n)
+	}
+	ephemerals := fs.callsTo("chat.postEphemeral")
+	if len(ephemerals) != 1 || ephemerals[0].Form.Get("user") != "U1" {
+		t.Fatalf("안내 메시지 = %v, want U1에게 1회", ephemerals)
+	}
+}
+
+func TestEditSubmissionUpdatesBody(t *testing.T) {
+	tests := []struct {
+		name      string
+		postedAt  time.Duration
+		wantError string
+	}{
+		{"within window", -time.Minute, ""},
+		{"window expired", -10 * time.Minute, "수정 가능 시간"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			fs.responses["conversations.history"] = editHistoryResponse
+			sheetsFake, svc := newFakeSheets(t)
+			sheetsFake.seed("edits", []string{editHashFor("U0", "1.0"), "1.0", time.Now().Add(tt.postedAt).Format(time.RFC3339)})
+			app := &App{cfg: &Config{EditGraceMinutes: 5}, slack: client, sheets: svc}
+
+			payload := viewSubmission(CallbackEditPost, "C1|1.0", map[string]map[string]slack.BlockAction{
+				BlockIDMessage: {ActionIDMessage: {Value: "  고친 본문  "}},
+			})
+			payload.User.ID = "U0"
+			resp, _ := app.handleViewSubmission(payload)
+
+			updates := fs.callsTo("chat.update")
+			if tt.wantError != "" {
+				if errs := responseErrors(t, resp.Body); !strings.Contains(errs[BlockIDMessage], tt.wantError) {
+					t.Errorf("에러 = %v, want %q 포함", errs, tt.wantError)
+				}
+				if len(updates) != 0 {
+					t.Errorf("수정 불가인데 chat.update 호출됨 (%d회)", len(updates))
+				}
+				return
+			}
+			if len(updates) != 1 {
+				t.Fatalf("chat.update 호출 수 = %d, want 1 (body=%s)", len(updates), resp.Body)
+			}
+			form := updates[0].Form
+			if form.Get("channel") != "C1" || form.Get("ts") != "1.0" {
+				t.Errorf("channel=%q ts=%q", form.Get("channel"), form.Get("ts"))
+			}
+			text := blocksText(t, form.Get("blocks"))
+			if !strings.Contains(text, "<@U9>\n\n고친 본문") || strings.Contains(text, "처음 쓴 본문") {
+				t.Errorf("수정된 블록 = %q", text)
+			}
+			if !strings.Contains(text, "수정됨") {
+				t.Errorf("수정됨 표시 없음: %q", text)
+			}
+			if strings.Contains(form.Get("blocks"), "U0") {
+				t.Error("수정된 글에 작성자 ID가 포함됨")
+			}
+		})
+	}
+}
+
+func TestWithEditButton(t *testing.T) {
+	blocks := withEditButton(buildNewPostBlocks("본문", "", nil, "question", "normal", ""))
+
+	found := false
+	for _, block := range blocks {
+		if b, ok := block.(*slack.ActionBlock); ok && hasReplyButton(b) {
+			last := b.Elements.ElementSet[len(b.Elements.ElementSet)-1]
+			found = last.(*slack.ButtonBlockElement).ActionID == ActionEditButton
+		}
+	}
+	if !found {
+		t.Error("답글 버튼 줄 끝에 수정 버튼이 없음")
+	}
+}
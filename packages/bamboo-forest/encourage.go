@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"log"
+	"math/rand/v2"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 격려 보내기 (모달 없이 프리셋 격려 메시지를 익명 게시)
+
+// 설정에 ENCOURAGEMENT_MESSAGES가 없을 때 사용하는 기본 문구
+var defaultEncouragements = []string{
+	"오늘도 고생 많으셨어요. 충분히 잘하고 있어요 🌱",
+	"힘든 하루였다면 잠깐 쉬어가도 괜찮아요 ☕",
+	"보이지 않는 곳에서 애쓰는 모든 분들, 고맙습니다 🙏",
+	"작은 진전도 진전이에요. 응원합니다 💪",
+	"혼자라고 느껴질 때, 여기 함께하는 사람들이 있어요 🤗",
+}
+
+func (app *App) encouragementMessages() []string {
+	if len(app.cfg.EncouragementMessages) > 0 {
+		return app.cfg.EncouragementMessages
+	}
+	return defaultEncouragements
+}
+
+// Shortcut 처리 (글로벌 숏컷)
+func (app *App) handleShortcut(payload slack.InteractionCallback) (events.LambdaFunctionURLResponse, error) {
+	switch payload.CallbackID {
+	case CallbackEncourage:
+		return app.postEncouragement(payload.User.ID)
+	default:
+		log.Printf("[무시] 처리하지 않는 shortcut: %s", payload.CallbackID)
+		return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+	}
+}
+
+// 프리셋 격려 메시지 중 하나를 골라 칭찬 카테고리로 게시
+func (app *App) postEncouragement(userID string) (events.LambdaFunctionURLResponse, error) {
+	// 숏컷 응답 본문은 사용자에게 표시되지 않으므로 권한·제한 안내도 DM으로 보낸다
+	if !app.canPostInCategory(userID, "praise") {
+		log.Printf("[스킵] 칭찬 카테고리 작성 권한 없음 (격려 보내기)")
+		if _, _, err := app.slack.PostMessage(userID, slack.MsgOptionText("⚠️ "+categoryNotAllowedMessage, false)); err != nil {
+			log.Printf("[경고] 카테고리 권한 안내 DM 전송 실패: %v", err)
+		}
+		return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+	}
+	if notice := app.checkRateLimit(context.Background(), userID, time.Now()); notice != "" {
+		if _, _, err := app.slack.PostMessage(userID, slack.MsgOptionText("⚠️ "+notice, false)); err != nil {
+			log.Printf("[경고] 게시 제한 안내 DM 전송 실패: %v", err)
+		}
+		return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+	}
+
+	messages := app.encouragementMessages()
+	message := messages[rand.IntN(len(messages))]
+
+	resp, err := app.postNewMessage(userID, message, "", nil, "praise", "normal", "")
+	if resp.Body != "" {
+		// 숏컷 응답 본문은 사용자에게 표시되지 않으므로 DM으로 실패를 알린다
+		_, _, dmErr := app.slack.PostMessage(
+			userID,
+			slack.MsgOptionText("⚠️ 격려 메시지 게시에 실패했습니다. 잠시 후 다시 시도해주세요.", false),
+		)
+		if dmErr != nil {
+			log.Printf("[경고] 격려 실패 안내 DM 전송 실패: %v", dmErr)
+		}
+		return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+	}
+	return resp, err
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPostEncouragement(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{
+		cfg:   &Config{EncouragementMessages: []string{"오늘도 수고했어요"}},
+		slack: client,
+	}
+
+	resp, err := app.postEncouragement("U123")
+	if err != nil || resp.StatusCode != 200 || resp.Body != "" {
+		t.Fatalf("postEncouragement() = %+v, %v", resp, err)
+	}
+
+	posts := fs.callsTo("chat.postMessage")
+	if len(posts) != 1 {
+		t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+	}
+	if got := posts[0].Form.Get("channel"); got != DefaultTargetChannelID {
+		t.Errorf("channel = %q, want %q", got, DefaultTargetChannelID)
+	}
+	text := blocksText(t, posts[0].Form.Get("blocks"))
+	if !strings.Contains(text, "오늘도 수고했어요") {
+		t.Errorf("프리셋 메시지가 게시되지 않음: %s", text)
+	}
+	if !strings.Contains(text, categoryLabels["praise"]) {
+		t.Errorf("칭찬 카테고리 라벨 누락: %s", text)
+	}
+	if strings.Contains(text, "U123") {
+		t.Errorf("게시글에 사용자 ID가 노출됨: %s", text)
+	}
+}
+
+func TestPostEncouragementDefaultMessages(t *testing.T) {
+	app := &App{cfg: &Config{}}
+	if got := app.encouragementMessages(); len(got) != len(defaultEncouragements) {
+		t.Errorf("기본 문구 개수 = %d, want %d", len(got), len(defaultEncouragements))
+	}
+}
+
+func TestPostEncouragementRespectsCategoryPermission(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     string
+		wantPost bool
+	}{
+		{"allowed user", "UHR", true},
+		{"other user", "U123", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{
+				cfg:   &Config{CategoryAllowedUsers: map[string][]string{"praise": {"UHR"}}},
+				slack: client,
+			}
+
+			app.postEncouragement(tt.user)
+
+			posts := fs.callsTo("chat.postMessage")
+			if len(posts) != 1 {
+				t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+			}
+			channel := posts[0].Form.Get("channel")
+			if posted := channel == DefaultTargetChannelID; posted != tt.wantPost {
+				t.Errorf("게시 채널 = %q, want 게시=%v", channel, tt.wantPost)
+			}
+			if !tt.wantPost && !strings.Contains(posts[0].Form.Get("text"), categoryNotAllowedMessage) {
+				t.Errorf("권한 안내 DM = %q", posts[0].Form.Get("text"))
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 긴급 글 미처리 알림
+// URGENT_ESCALATE_AFTER(예: "24h")가 지나도록 처리완료되지 않은 긴급 글에 스레드 알림을 한 번 남긴다.
+// URGENT_ESCALATE_CHANNEL이 설정되어 있으면 해당 채널에도 글 링크를 올린다.
+// EventBridge 스케줄 실행(handleScheduled)에서 호출된다.
+
+// 설정된 미처리 기준 시간 (미설정/잘못된 값이면 0 → 기능 비활성화)
+func (app *App) urgentEscalateAfter() time.Duration {
+	if app.cfg.UrgentEscalateAfter == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(app.cfg.UrgentEscalateAfter)
+	if err != nil || d <= 0 {
+		log.Printf("[경고] URGENT_ESCALATE_AFTER 값이 잘못됨: %q", app.cfg.UrgentEscalateAfter)
+		return 0
+	}
+	return d
+}
+
+// 기준 시간이 지난 미처리 긴급 글에 알림을 보내고 알림 보낸 글 수를 반환
+func (app *App) escalateOverdueUrgentPosts(ctx context.Context, now time.Time) (int, error) {
+	after := app.urgentEscalateAfter()
+	if after == 0 || app.sheets == nil {
+		return 0, nil
+	}
+
+	posts, err := app.loadPosts(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	nudged := 0
+	for _, p := range posts {
+		// 예약 게시한 글은 ts가 없어 스레드에 알릴 수 없음
+		if p.Urgency != "urgent" || p.Status != "" || p.MessageTS == "" || now.Sub(p.CreatedAt) < after {
+			continue
+		}
+
+		_, _, err := app.slack.PostMessage(
+			app.targetChannel(),
+			slack.MsgOptionText(fmt.Sprintf("⏰ 이 긴급 글이 %s 넘게 처리되지 않았어요. 확인 부탁드립니다 🙏", formatElapsed(after)), false),
+			slack.MsgOptionTS(p.MessageTS),
+		)
+		if err != nil {
+			log.Printf("[에러] 긴급 글 알림 실패 (ts=%s): %v", p.MessageTS, err)
+			continue
+		}
+
+		if ch := app.cfg.UrgentEscalateChannel; ch != "" {
+			link, err := app.slack.GetPermalink(&slack.PermalinkParameters{Channel: app.targetChannel(), Ts: p.MessageTS})
+			if err != nil {
+				log.Printf("[경고] 긴급 글 링크 조회 실패 (ts=%s): %v", p.MessageTS, err)
+			} else if _, _, err := app.slack.PostMessage(ch, slack.MsgOptionText("🚨 처리되지 않은 긴급 글이 있어요: "+link, false)); err != nil {
+				log.Printf("[경고] 긴급 글 에스컬레이션 실패 (channel=%s): %v", ch, err)
+			}
+		}
+
+		// 같은 글에 반복해서 알리지 않도록 상태 기록
+		if err := app.setPostStatus(ctx, p.Row, PostStatusNudged); err != nil {
+			log.Printf("[경고] 긴급 글 상태 기록 실패 (ts=%s): %v", p.MessageTS, err)
+		}
+		nudged++
+	}
+
+	log.Printf("[정보] 미처리 긴급 글 알림 %d건", nudged)
+	return nudged, nil
+}
+
+// "24h0m0s" 대신 "24시간", "30분"처럼 표시
+func formatElapsed(d time.Duration) string {
+	if d >= time.Hour && d%time.Hour == 0 {
+		return fmt.Sprintf("%d시간", int(d/time.Hour))
+	}
+	return fmt.Sprintf("%d분", int(d/time.Minute))
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEscalateOverdueUrgentPosts(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	ago := func(d time.Duration) string { return now.Add(-d).Format(time.RFC3339) }
+
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	sh.seed("posts",
+		[]string{"created_at", "message_ts", "category", "urgency", "has_nickname", "mention_count", "status", "status_at"},
+		[]string{ago(25 * time.Hour), "1.0", "concern", "urgent", "false", "0"},
+		[]string{ago(30 * time.Hour), "2.0", "concern", "urgent", "false", "0", PostStatusCompleted, ago(29 * time.Hour)},
+		[]string{ago(1 * time.Hour), "3.0", "concern", "urgent", "false", "0"},
+		[]string{ago(48 * time.Hour), "4.0", "question", "normal", "false", "0"},
+		[]string{ago(26 * time.Hour), "", "concern", "urgent", "false", "0"}, // 예약 게시 (ts 없음)
+	)
+	app := &App{cfg: &Config{SheetsID: "sheet", UrgentEscalateAfter: "24h"}, slack: client, sheets: svc}
+
+	n, err := app.escalateOverdueUrgentPosts(context.Background(), now)
+	if err != nil {
+		t.Fatalf("escalateOverdueUrgentPosts: %v", err)
+	}
+	if n != 1 {
+		t.Fatalf("알림 수 = %d, want 1", n)
+	}
+
+	posts := fs.callsTo("chat.postMessage")
+	if len(posts) != 1 {
+		t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+	}
+	if got := posts[0].Form.Get("thread_ts"); got != "1.0" {
+		t.Errorf("thread_ts = %q, want 1.0", got)
+	}
+	if text := posts[0].Form.Get("text"); !strings.Contains(text, "24시간") {
+		t.Errorf("알림 문구 = %q", text)
+	}
+	if got := sh.rows("posts")[1][6]; got != PostStatusNudged {
+		t.Errorf("상태 = %q, want %q", got, PostStatusNudged)
+	}
+
+	// 이미 알림을 보낸 글에는 다시 알리지 않는다
+	if n, _ := app.escalateOverdueUrgentPosts(context.Background(), now.Add(time.Hour)); n != 0 {
+		t.Errorf("재실행 알림 수 = %d, want 0", n)
+	}
+}
+
+func TestEscalateToChannel(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	fs, client := newFakeSlack(t)
+	fs.responses["chat.getPermalink"] = `{"ok":true,"permalink":"https://example.slack.com/archives/C09SQ9N05MZ/p10"}`
+	sh, svc := newFakeSheets(t)
+	sh.seed("posts", []string{now.Add(-2 * time.Hour).Format(time.RFC3339), "1.0", "concern", "urgent", "false", "0"})
+	app := &App{cfg: &Config{SheetsID: "sheet", UrgentEscalateAfter: "1h", UrgentEscalateChannel: "CLEAD"}, slack: client, sheets: svc}
+
+	app.escalateOverdueUrgentPosts(context.Background(), now)
+
+	var toLead int
+	for _, c := range fs.callsTo("chat.postMessage") {
+		if c.Form.Get("channel") == "CLEAD" {
+			toLead++
+			if !strings.Contains(c.Form.Get("text"), "https://example.slack.com/archives/C09SQ9N05MZ/p10") {
+				t.Errorf("에스컬레이션 문구에 링크 누락: %q", c.Form.Get("text"))
+			}
+		}
+	}
+	if toLead != 1 {
+		t.Errorf("에스컬레이션 채널 게시 수 = %d, want 1", toLead)
+	}
+}
+
+func TestMarkPostCompleted(t *testing.T) {
+	sh, svc := newFakeSheets(t)
+	created := time.Now().Format(time.RFC3339)
+	sh.seed("posts",
+		[]string{created, "1.0", "concern", "urgent", "false", "0"},
+		[]string{created, "2.0", "concern", "urgent", "false", "0"},
+	)
+	app := &App{cfg: &Config{SheetsID: "sheet"}, sheets: svc}
+
+	if err := app.markPostCompleted(context.Background(), "2.0"); err != nil {
+		t.Fatalf("markPostCompleted: %v", err)
+	}
+	rows := sh.rows("posts")
+	if len(rows[0]) > 6 && rows[0][6] != "" {
+		t.Errorf("다른 글의 상태가 바뀜: %v", rows[0])
+	}
+	if rows[1][6] != PostStatusCompleted {
+		t.Errorf("상태 = %q, want %q", rows[1][6], PostStatusCompleted)
+	}
+}
+
+func TestIsScheduledEvent(t *testing.T) {
+	scheduled := `{"version":"0","source":"aws.events","detail-type":"Scheduled Event","detail":{}}`
+	if !isScheduledEvent(json.RawMessage(scheduled)) {
+		t.Error("EventBridge 스케줄 이벤트를 인식하지 못함")
+	}
+	functionURL := `{"version":"2.0","rawPath":"/","headers":{},"body":"payload=..."}`
+	if isScheduledEvent(json.RawMessage(functionURL)) {
+		t.Error("Function URL 요청을 스케줄 이벤트로 인식함")
+	}
+}
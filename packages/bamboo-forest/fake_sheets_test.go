@@ -0,0 +1,212 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/sheets/v4"
+)
+
+// ─────────────────────────────────────
+// 테스트용 가짜 Google Sheets API 서버 (values.get/append/update/clear 지원)
+
+type fakeSheets struct {
+	mu    sync.Mutex
+	tabs  map[string][][]string
+	reads map[string]int // 탭별 조회 횟수
+	// 값 수정(PUT)을 실패시킬지 (기록 실패 처리 테스트용)
+	failUpdates bool
+}
+
+func newFakeSheets(t *testing.T) (*fakeSheets, *sheets.Service) {
+	t.Helper()
+	fs := &fakeSheets{tabs: map[string][][]string{}, reads: map[string]int{}}
+	srv := httptest.NewServer(http.HandlerFunc(fs.serve))
+	t.Cleanup(srv.Close)
+
+	svc, err := sheets.NewService(context.Background(),
+		option.WithEndpoint(srv.URL+"/"),
+		option.WithHTTPClient(srv.Client()),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("가짜 Sheets 서비스 생성 실패: %v", err)
+	}
+	return fs, svc
+}
+
+// 탭에 행 추가 (테스트 준비용)
+func (fs *fakeSheets) seed(tab string, rows ...[]string) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.tabs[tab] = append(fs.tabs[tab], rows...)
+}
+
+// 탭의 현재 행 (빈 행 제외)
+func (fs *fakeSheets) rows(tab string) [][]string {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	var out [][]string
+	for _, row := range fs.tabs[tab] {
+		if strings.Join(row, "") != "" {
+			out = append(out, append([]string(nil), row...))
+		}
+	}
+	return out
+}
+
+// 탭 조회 횟수
+func (fs *fakeSheets) readCount(tab string) int {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return fs.reads[tab]
+}
+
+var a1Regex = regexp.MustCompile(`^([^!]+)!([A-Z]+)(\d*)(?::([A-Z]+)(\d*))?$`)
+
+type a1Range struct {
+	tab              string
+	startCol, endCol int // 0-based, 포함
+	startRow, endRow int // 0-based, 포함 (endRow < 0 이면 끝까지)
+}
+
+func colIndex(col string) int {
+	n := 0
+	for _, c := range col {
+		n = n*26 + int(c-'A'+1)
+	}
+	return n - 1
+}
+
+func parseA1(s string) (a1Range, error) {
+	m := a1Regex.FindStringSubmatch(s)
+	if m == nil {
+		return a1Range{}, fmt.Errorf("지원하지 않는 범위: %s", s)
+	}
+	r := a1Range{tab: m[1], startCol: colIndex(m[2]), endCol: colIndex(m[2]), startRow: 0, endRow: -1}
+	if m[3] != "" {
+		n, _ := strconv.Atoi(m[3])
+		r.startRow, r.endRow = n-1, n-1
+	}
+	if m[4] != "" {
+		r.endCol = colIndex(m[4])
+		r.endRow = -1
+		if m[5] != "" {
+			n, _ := strconv.Atoi(m[5])
+			r.endRow = n - 1
+		}
+	}
+	return r, nil
+}
+
+func (fs *fakeSheets) serve(w http.ResponseWriter, r *http.Request) {
+	// /v4/spreadsheets/{id}/values/{range}[:append|:clear]
+	path := r.URL.Path
+	i := strings.Index(path, "/values/")
+	if i < 0 {
+		http.Error(w, "unsupported", http.StatusNotFound)
+		return
+	}
+	rangeStr := path[i+len("/values/"):]
+	op := ""
+	if j := strings.LastIndex(rangeStr, ":"); j >= 0 && (strings.HasSuffix(rangeStr, ":append") || strings.HasSuffix(rangeStr, ":clear")) {
+		rangeStr, op = rangeStr[:j], rangeStr[j+1:]
+	}
+	rg, err := parseA1(rangeStr)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	var in sheets.ValueRange
+	if r.Method == http.MethodPost || r.Method == http.MethodPut {
+		json.NewDecoder(r.Body).Decode(&in)
+	}
+
+	switch {
+	case r.Method == http.MethodPut && fs.failUpdates:
+		http.Error(w, "update failed", http.StatusInternalServerError)
+
+	case r.Method == http.MethodGet:
+		fs.reads[rg.tab]++
+		var out [][]interface{}
+		for ri, row := range fs.tabs[rg.tab] {
+			if ri < rg.startRow || (rg.endRow >= 0 && ri > rg.endRow) {
+				continue
+			}
+			var cells []interface{}
+			for ci := rg.startCol; ci <= rg.endCol && ci < len(row); ci++ {
+				cells = append(cells, row[ci])
+			}
+			for len(cells) > 0 && cells[len(cells)-1] == "" {
+				cells = cells[:len(cells)-1]
+			}
+			out = append(out, cells)
+		}
+		// 실제 API처럼 끝쪽 빈 행은 잘라낸다
+		for len(out) > 0 && len(out[len(out)-1]) == 0 {
+			out = out[:len(out)-1]
+		}
+		json.NewEncoder(w).Encode(sheets.ValueRange{Range: rangeStr, Values: out})
+
+	case op == "append":
+		for _, row := range in.Values {
+			fs.tabs[rg.tab] = append(fs.tabs[rg.tab], toStrings(row, rg.startCol))
+		}
+		json.NewEncoder(w).Encode(sheets.AppendValuesResponse{})
+
+	case op == "clear":
+		for ri := range fs.tabs[rg.tab] {
+			if ri < rg.startRow || (rg.endRow >= 0 && ri > rg.endRow) {
+				continue
+			}
+			row := fs.tabs[rg.tab][ri]
+			for ci := rg.startCol; ci <= rg.endCol && ci < len(row); ci++ {
+				row[ci] = ""
+			}
+		}
+		json.NewEncoder(w).Encode(sheets.ClearValuesResponse{})
+
+	case r.Method == http.MethodPut:
+		for k, row := range in.Values {
+			ri := rg.startRow + k
+			for len(fs.tabs[rg.tab]) <= ri {
+				fs.tabs[rg.tab] = append(fs.tabs[rg.tab], nil)
+			}
+			cur := fs.tabs[rg.tab][ri]
+			vals := toStrings(row, rg.startCol)
+			for len(cur) < len(vals) {
+				cur = append(cur, "")
+			}
+			for ci := rg.startCol; ci < len(vals); ci++ {
+				cur[ci] = vals[ci]
+			}
+			fs.tabs[rg.tab][ri] = cur
+		}
+		json.NewEncoder(w).Encode(sheets.UpdateValuesResponse{})
+
+	default:
+		http.Error(w, "unsupported", http.StatusNotFound)
+	}
+}
+
+// 셀 값을 문자열로 변환 (실제 API의 FORMATTED_VALUE 응답처럼)
+func toStrings(row []interface{}, offset int) []string {
+	out := make([]string, offset, offset+len(row))
+	for _, v := range row {
+		out = append(out, fmt.Sprint(v))
+	}
+	return out
+}
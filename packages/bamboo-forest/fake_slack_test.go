@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 테스트용 가짜 Slack API 서버
+
+type fakeSlackCall struct {
+	Method string
+	Form   url.Values
+	Body   string
+}
+
+type fakeSlack struct {
+	mu        sync.Mutex
+	calls     []fakeSlackCall
+	responses map[string]string   // method 또는 "method channel" → 응답 JSON (없으면 기본 성공 응답)
+	url       string              // slack.OptionAPIURL에 넘길 주소
+	onCall    func(method string) // 응답 직전에 호출 (요청 사이에 끼어드는 동작 흉내용)
+}
+
+func newFakeSlack(t *testing.T) (*fakeSlack, *slack.Client) {
+	t.Helper()
+	fs := &fakeSlack{responses: map[string]string{}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		form, _ := url.ParseQuery(string(raw))
+		method := strings.TrimPrefix(r.URL.Path, "/")
+
+		fs.mu.Lock()
+		fs.calls = append(fs.calls, fakeSlackCall{Method: method, Form: form, Body: string(raw)})
+		resp, ok := fs.responses[method+" "+form.Get("channel")]
+		if !ok {
+			resp, ok = fs.responses[method]
+		}
+		onCall := fs.onCall
+		fs.mu.Unlock()
+
+		if onCall != nil {
+			onCall(method)
+		}
+		if !ok {
+			resp = `{"ok":true,"channel":"` + form.Get("channel") + `","ts":"1700000000.000100"}`
+		}
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, resp)
+	}))
+	t.Cleanup(srv.Close)
+	fs.url = srv.URL + "/"
+	return fs, slack.New("xoxb-test", slack.OptionAPIURL(fs.url))
+}
+
+// 특정 메서드 호출 목록
+func (fs *fakeSlack) callsTo(method string) []fakeSlackCall {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	var out []fakeSlackCall
+	for _, c := range fs.calls {
+		if c.Method == method {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// blocks JSON에서 모든 "text" 값을 모아 개행으로 이어붙인다
+func blocksText(t *testing.T, raw string) string {
+	t.Helper()
+	var v interface{}
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		t.Fatalf("blocks 파싱 실패: %v", err)
+	}
+	var texts []string
+	var walk func(interface{})
+	walk = func(n interface{}) {
+		switch x := n.(type) {
+		case map[string]interface{}:
+			for k, val := range x {
+				if s, ok := val.(string); ok && k == "text" {
+					texts = append(texts, s)
+					continue
+				}
+				walk(val)
+			}
+		case []interface{}:
+			for _, val := range x {
+				walk(val)
+			}
+		}
+	}
+	walk(v)
+	return strings.Join(texts, "\n")
+}
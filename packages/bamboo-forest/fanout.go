@@ -0,0 +1,62 @@
+package main
+
+import (
+	"log"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 긴급 글 여러 채널 동시 게시 (URGENT_FANOUT_CHANNELS)
+// 긴급 글은 팀 채널뿐 아니라 리더 채널 등에도 바로 보여야 할 수 있으므로, 대상 채널에 올린 뒤 같은 블록을 나열한 채널에도 올린다.
+// 채널마다 별도 메시지라 이모지 반응은 각 사본의 ts로 따로 기록/집계된다.
+// 대상 채널 게시가 성공했다면 일부 사본이 실패해도 글은 게시된 것으로 보고 실패한 채널만 로그에 남긴다.
+// 사본 ts는 삭제 토큰 행(deletes 탭 E열)에 남겨, 작성자가 글을 지우면 사본도 함께 지운다.
+
+// 긴급 글 사본을 올릴 채널 (대상 채널과 중복 제외)
+func (app *App) urgentFanoutChannels(urgency string) []string {
+	if urgency != "urgent" {
+		return nil
+	}
+	var channels []string
+	seen := map[string]bool{app.targetChannel(): true}
+	for _, ch := range app.cfg.UrgentFanoutChannels {
+		if ch == "" || seen[ch] {
+			continue
+		}
+		seen[ch] = true
+		channels = append(channels, ch)
+	}
+	return channels
+}
+
+// 사본 게시 결과 (채널 → 사본 ts, 실패한 채널)
+type fanoutResult struct {
+	Posted map[string]string
+	Failed []string
+}
+
+// 긴급 글 사본을 각 채널에 게시 (delay가 있으면 대상 채널과 같은 시각으로 예약)
+func (app *App) fanOutUrgentPost(channels []string, delay time.Duration, blocks []slack.Block) fanoutResult {
+	result := fanoutResult{Posted: map[string]string{}}
+	for _, ch := range channels {
+		var ts string
+		var err error
+		if delay > 0 {
+			_, err = app.scheduleMessage(ch, delay, slack.MsgOptionBlocks(blocks...))
+		} else {
+			_, ts, err = app.slack.PostMessage(ch, slack.MsgOptionBlocks(blocks...))
+		}
+		if err != nil {
+			log.Printf("[경고] 긴급 글 사본 게시 실패 (channel=%s): %v", ch, err)
+			result.Failed = append(result.Failed, ch)
+			continue
+		}
+		result.Posted[ch] = ts
+	}
+	if len(channels) > 0 {
+		log.Printf("[정보] 긴급 글 사본 게시 (성공 %d / %d)", len(result.Posted), len(channels))
+	}
+	return result
+}
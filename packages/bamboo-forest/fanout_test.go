@@ -0,0 +1,72 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+func TestUrgentFanoutChannels(t *testing.T) {
+	app := &App{cfg: &Config{UrgentFanoutChannels: []string{"CLEAD", DefaultTargetChannelID, "", "CLEAD", "CHR"}}}
+	if got, want := app.urgentFanoutChannels("urgent"), []string{"CLEAD", "CHR"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("urgentFanoutChannels(urgent) = %v, want %v", got, want)
+	}
+	if got := app.urgentFanoutChannels("normal"); got != nil {
+		t.Errorf("urgentFanoutChannels(normal) = %v, want nil", got)
+	}
+}
+
+func TestUrgentPostFansOut(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{UrgentFanoutChannels: []string{"CLEAD", "CHR"}}, slack: client}
+
+	resp, _ := app.handleViewSubmission(viewSubmission(CallbackNewPost, "", newPostValues("concern", "urgent")))
+	if resp.Body != "" {
+		t.Fatalf("응답 본문 있음: %s", resp.Body)
+	}
+
+	posts := fs.callsTo("chat.postMessage")
+	var channels []string
+	for _, p := range posts {
+		channels = append(channels, p.Form.Get("channel"))
+		if p.Form.Get("blocks") != posts[0].Form.Get("blocks") {
+			t.Errorf("사본 블록이 원본과 다름 (channel=%s)", p.Form.Get("channel"))
+		}
+	}
+	if want := []string{DefaultTargetChannelID, "CLEAD", "CHR"}; !reflect.DeepEqual(channels, want) {
+		t.Errorf("게시 채널 = %v, want %v", channels, want)
+	}
+
+	// 일반 글은 대상 채널에만 게시
+	fs.calls = nil
+	app.handleViewSubmission(viewSubmission(CallbackNewPost, "", newPostValues("concern", "normal")))
+	if n := len(fs.callsTo("chat.postMessage")); n != 1 {
+		t.Errorf("일반 글 게시 수 = %d, want 1", n)
+	}
+}
+
+func TestFanOutPartialFailure(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	fs.responses["chat.postMessage CGONE"] = `{"ok":false,"error":"channel_not_found"}`
+	fs.responses["chat.postMessage CHR"] = `{"ok":true,"channel":"CHR","ts":"1700000000.000200"}`
+	app := &App{cfg: &Config{UrgentFanoutChannels: []string{"CGONE", "CHR"}}, slack: client}
+
+	// 사본 하나가 실패해도 게시는 성공하고 나머지 채널에는 올라간다
+	resp, _ := app.handleViewSubmission(viewSubmission(CallbackNewPost, "", newPostValues("concern", "urgent")))
+	if resp.Body != "" {
+		t.Fatalf("응답 본문 있음: %s", resp.Body)
+	}
+	if n := len(fs.callsTo("chat.postMessage")); n != 3 {
+		t.Errorf("게시 시도 수 = %d, want 3", n)
+	}
+
+	// 사본마다 자기 ts를 가진다 (반응은 이 ts로 따로 집계)
+	result := app.fanOutUrgentPost([]string{"CGONE", "CHR"}, 0, []slack.Block{slack.NewDividerBlock()})
+	if want := map[string]string{"CHR": "1700000000.000200"}; !reflect.DeepEqual(result.Posted, want) {
+		t.Errorf("Posted = %v, want %v", result.Posted, want)
+	}
+	if want := []string{"CGONE"}; !reflect.DeepEqual(result.Failed, want) {
+		t.Errorf("Failed = %v, want %v", result.Failed, want)
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"strings"
+	"time"
+)
+
+// ─────────────────────────────────────
+// 같은 글 반복 게시 막기 (DUPLICATE_POST_WINDOW_HOURS)
+// 주목을 끌려고 같은 내용을 카테고리만 바꿔 여러 번 올리는 것을 막는다.
+// 본문을 소문자로 바꾸고 공백을 하나로 합친 뒤 해시한 지문을 posts 탭 J열에 남기고,
+// 기간 안에 지문이 같은 글이 있으면 게시하지 않는다. 한 글자라도 다르면 다른 글로 본다.
+// 예약 게시한 글은 posts 탭에 기록하지 않으므로 비교 대상이 아니다.
+
+const duplicatePostMessage = "같은 내용의 글이 최근에 이미 올라왔어요. 내용을 바꿔서 다시 올려주세요"
+
+// 정규화한 본문의 해시 (32자)
+func contentFingerprint(message string) string {
+	normalized := strings.Join(strings.Fields(strings.ToLower(message)), " ")
+	hash := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(hash[:16])
+}
+
+func (app *App) duplicatePostWindow() time.Duration {
+	return time.Duration(app.cfg.DuplicatePostWindowHours) * time.Hour
+}
+
+// 기간 안에 같은 지문의 글이 있는지 (조회에 실패하면 게시를 막지 않는다)
+func (app *App) isDuplicatePost(ctx context.Context, message string, now time.Time) bool {
+	window := app.duplicatePostWindow()
+	if window <= 0 || app.sheets == nil {
+		return false
+	}
+
+	posts, err := app.loadPosts(ctx)
+	if err != nil {
+		log.Printf("[경고] 중복 글 확인 실패: %v", err)
+		return false
+	}
+	fingerprint := contentFingerprint(message)
+	for _, p := range posts {
+		if p.Fingerprint == fingerprint && now.Sub(p.CreatedAt) < window {
+			return true
+		}
+	}
+	return false
+}
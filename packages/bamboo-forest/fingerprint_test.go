@@ -0,0 +1,66 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+func TestContentFingerprint(t *testing.T) {
+	base := contentFingerprint("회의실 예약 좀 지켜주세요")
+	if got := contentFingerprint("  회의실   예약 좀\n지켜주세요 "); got != base {
+		t.Error("공백만 다른 본문의 지문이 다름")
+	}
+	if got := contentFingerprint("Hello World"); got != contentFingerprint("hello world") {
+		t.Error("대소문자만 다른 본문의 지문이 다름")
+	}
+	if got := contentFingerprint("회의실 예약 좀 지켜주세요!"); got == base {
+		t.Error("문장부호가 다른 본문의 지문이 같음")
+	}
+}
+
+func TestDuplicatePostSubmission(t *testing.T) {
+	const earlier = "회의실 예약 좀 지켜주세요"
+	tests := []struct {
+		name      string
+		message   string
+		postedAgo time.Duration
+		wantBlock bool
+	}{
+		{"exact duplicate in another category", "  회의실 예약 좀   지켜주세요 ", time.Hour, true},
+		{"near duplicate", "회의실 예약 좀 꼭 지켜주세요", time.Hour, false},
+		{"duplicate outside window", earlier, 48 * time.Hour, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			sh, svc := newFakeSheets(t)
+			postedAt := time.Now().Add(-tt.postedAgo).Format(time.RFC3339)
+			sh.seed("posts", []string{postedAt, "1.0", "suggestion", "normal", "FALSE", "0", "", "", "", contentFingerprint(earlier)})
+			app := &App{cfg: &Config{SheetsID: "sheet", DuplicatePostWindowHours: 24}, slack: client, sheets: svc}
+
+			values := newPostValues("concern", "normal")
+			values[BlockIDMessage] = map[string]slack.BlockAction{ActionIDMessage: {Value: tt.message}}
+			resp, _ := app.handleViewSubmission(viewSubmission(CallbackNewPost, "", values))
+
+			posts := fs.callsTo("chat.postMessage")
+			if tt.wantBlock {
+				if errs := responseErrors(t, resp.Body); errs[BlockIDMessage] != duplicatePostMessage {
+					t.Errorf("에러 = %v, want 중복 글 에러", errs)
+				}
+				if len(posts) != 0 {
+					t.Errorf("중복 글이 게시됨 (%d회)", len(posts))
+				}
+				return
+			}
+			if len(posts) != 1 {
+				t.Fatalf("chat.postMessage 호출 수 = %d, want 1 (body=%s)", len(posts), resp.Body)
+			}
+			rows := sh.rows("posts")
+			if len(rows) != 2 || rows[1][9] != contentFingerprint(tt.message) {
+				t.Errorf("posts 탭 = %v, want 새 글 지문 기록", rows)
+			}
+		})
+	}
+}
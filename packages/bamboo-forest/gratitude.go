@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 감사 릴레이 (GRATITUDE_RELAY_START ~ GRATITUDE_RELAY_END)
+// 캠페인 기간에 올라온 칭찬(praise) 글을 posts 탭에 기록해 두고, 스케줄 실행에서 한 주가 바뀌면
+// 지난주 칭찬 글 링크를 모은 요약 메시지를 올린다. 본문은 기록하지 않고 링크만 모은다.
+// GRATITUDE_RELAY_THREAD_TS(캠페인 안내 글 ts)가 있으면 새 칭찬 글마다 그 스레드에 링크를 이어 붙인다.
+// 마지막으로 요약한 주는 meta 탭의 gratitude_summary_week 행에 기록해 같은 주를 두 번 요약하지 않는다.
+
+const (
+	metaKeyGratitudeWeek  = "gratitude_summary_week"
+	gratitudeDateLayout   = "2006-01-02"
+	maxGratitudeSummaries = 30 // 요약 메시지에 넣을 최대 링크 수
+)
+
+// 캠페인 날짜와 주 경계는 한국 시간 기준
+var kst = time.FixedZone("KST", 9*60*60)
+
+// 캠페인 기간 (시작일 0시 ~ 종료일 다음 날 0시, 종료일이 없으면 끝없음). 시작일이 없거나 잘못되면 ok=false
+func (app *App) gratitudeRelayPeriod() (start, end time.Time, ok bool) {
+	if app.cfg.GratitudeRelayStart == "" {
+		return time.Time{}, time.Time{}, false
+	}
+	start, err := time.ParseInLocation(gratitudeDateLayout, app.cfg.GratitudeRelayStart, kst)
+	if err != nil {
+		log.Printf("[경고] GRATITUDE_RELAY_START 값이 잘못됨: %q", app.cfg.GratitudeRelayStart)
+		return time.Time{}, time.Time{}, false
+	}
+	if app.cfg.GratitudeRelayEnd != "" {
+		last, err := time.ParseInLocation(gratitudeDateLayout, app.cfg.GratitudeRelayEnd, kst)
+		if err != nil {
+			log.Printf("[경고] GRATITUDE_RELAY_END 값이 잘못됨: %q", app.cfg.GratitudeRelayEnd)
+			return time.Time{}, time.Time{}, false
+		}
+		end = last.AddDate(0, 0, 1)
+	}
+	return start, end, true
+}
+
+func inPeriod(t, start, end time.Time) bool {
+	return !t.Before(start) && (end.IsZero() || t.Before(end))
+}
+
+// 지금 캠페인 중인 칭찬 글인지
+func (app *App) isGratitudeRelayPost(category string, now time.Time) bool {
+	if category != "praise" {
+		return false
+	}
+	start, end, ok := app.gratitudeRelayPeriod()
+	return ok && inPeriod(now, start, end)
+}
+
+// t가 속한 주의 월요일 0시 (한국 시간)
+func weekStart(t time.Time) time.Time {
+	t = t.In(kst)
+	offset := (int(t.Weekday()) + 6) % 7 // 월요일 = 0
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, kst)
+}
+
+func weekKey(t time.Time) string {
+	year, week := t.In(kst).ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// 새 칭찬 글 링크를 캠페인 스레드에 이어 붙임
+func (app *App) relayGratitudePost(messageTS string) {
+	parentTS := app.cfg.GratitudeRelayThreadTS
+	if parentTS == "" {
+		return
+	}
+	link, err := app.slack.GetPermalink(&slack.PermalinkParameters{Channel: app.targetChannel(), Ts: messageTS})
+	if err != nil {
+		log.Printf("[경고] 감사 릴레이 링크 조회 실패: %v", err)
+		return
+	}
+	_, _, err = app.slack.PostMessage(
+		app.targetChannel(),
+		slack.MsgOptionText(fmt.Sprintf("🙏 감사 릴레이가 이어졌어요 → <%s|새 칭찬 보기>", link), false),
+		slack.MsgOptionTS(parentTS),
+	)
+	if err != nil {
+		log.Printf("[경고] 감사 릴레이 스레드 게시 실패: %v", err)
+	}
+}
+
+// 주가 바뀌었으면 지난주 캠페인 칭찬 글 요약을 게시하고 요약에 넣은 글 수를 반환
+func (app *App) postGratitudeSummary(ctx context.Context, now time.Time) (int, error) {
+	start, end, ok := app.gratitudeRelayPeriod()
+	if !ok || app.sheets == nil {
+		return 0, nil
+	}
+
+	weekTo := weekStart(now)
+	weekFrom := weekTo.AddDate(0, 0, -7)
+	// 지난주가 캠페인 기간과 겹치지 않으면 요약할 게 없다
+	if !weekTo.After(start) || (!end.IsZero() && !weekFrom.Before(end)) {
+		return 0, nil
+	}
+
+	key := weekKey(weekFrom)
+	row, last, err := app.getMeta(ctx, metaKeyGratitudeWeek)
+	if err != nil {
+		return 0, err
+	}
+	if last == key {
+		return 0, nil
+	}
+
+	posts, err := app.loadPosts(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var links []string
+	for _, p := range posts {
+		// 예약 게시한 글은 ts가 없어 링크를 만들 수 없음
+		if p.Category != "praise" || p.MessageTS == "" || !inPeriod(p.CreatedAt, weekFrom, weekTo) || !inPeriod(p.CreatedAt, start, end) {
+			continue
+		}
+		link, err := app.slack.GetPermalinkContext(ctx, &slack.PermalinkParameters{Channel: app.targetChannel(), Ts: p.MessageTS})
+		if err != nil {
+			log.Printf("[경고] 칭찬 글 링크 조회 실패 (ts=%s): %v", p.MessageTS, err)
+			continue
+		}
+		links = append(links, link)
+	}
+
+	if len(links) > 0 {
+		if _, _, err := app.slack.PostMessageContext(ctx, app.targetChannel(),
+			slack.MsgOptionText(gratitudeSummaryText(weekFrom, links), false)); err != nil {
+			return 0, fmt.Errorf("감사 릴레이 요약 게시 실패: %w", err)
+		}
+		log.Printf("[성공] 감사 릴레이 요약 게시 (week=%s, 칭찬 %d건)", key, len(links))
+	}
+	if err := app.setMeta(ctx, row, metaKeyGratitudeWeek, key); err != nil {
+		log.Printf("[경고] 감사 릴레이 요약 주 기록 실패: %v", err)
+	}
+	return len(links), nil
+}
+
+func gratitudeSummaryText(weekFrom time.Time, links []string) string {
+	weekLast := weekFrom.AddDate(0, 0, 6)
+	lines := []string{fmt.Sprintf("🙏 *감사 릴레이* 지난주(%d/%d~%d/%d)에 칭찬 %d건이 이어졌어요!",
+		weekFrom.Month(), weekFrom.Day(), weekLast.Month(), weekLast.Day(), len(links))}
+	for i, link := range links {
+		if i == maxGratitudeSummaries {
+			lines = append(lines, fmt.Sprintf("…외 %d건", len(links)-maxGratitudeSummaries))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("• <%s|칭찬 %d>", link, i+1))
+	}
+	return strings.Join(lines, "\n")
+}
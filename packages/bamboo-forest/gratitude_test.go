@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGratitudeSummaryCollectsPraisePosts(t *testing.T) {
+	// 2026-11-11(수) 10:00 KST → 지난주는 11/2(월) ~ 11/8(일)
+	now := time.Date(2026, 11, 11, 10, 0, 0, 0, kst)
+	at := func(month time.Month, day, hour int) string {
+		return time.Date(2026, month, day, hour, 0, 0, 0, kst).Format(time.RFC3339)
+	}
+
+	fs, client := newFakeSlack(t)
+	fs.responses["chat.getPermalink"] = `{"ok":true,"permalink":"https://example.slack.com/archives/C09SQ9N05MZ/p1"}`
+	sh, svc := newFakeSheets(t)
+	sh.seed("posts",
+		[]string{"created_at", "message_ts", "category", "urgency"},
+		[]string{at(11, 3, 9), "1.0", "praise", "normal"},
+		[]string{at(11, 8, 23), "2.0", "praise", "normal"},
+		[]string{at(11, 4, 12), "3.0", "concern", "normal"}, // 칭찬 아님
+		[]string{at(11, 10, 9), "4.0", "praise", "normal"},  // 이번 주
+		[]string{at(10, 30, 9), "5.0", "praise", "normal"},  // 캠페인 전
+		[]string{at(11, 1, 23), "6.0", "praise", "normal"},  // 지난지난주
+	)
+	app := &App{cfg: &Config{SheetsID: "sheet", GratitudeRelayStart: "2026-11-01", GratitudeRelayEnd: "2026-11-30"}, slack: client, sheets: svc}
+
+	n, err := app.postGratitudeSummary(context.Background(), now)
+	if err != nil {
+		t.Fatalf("postGratitudeSummary: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("요약한 칭찬 수 = %d, want 2", n)
+	}
+	posts := fs.callsTo("chat.postMessage")
+	if len(posts) != 1 {
+		t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+	}
+	text := posts[0].Form.Get("text")
+	if !strings.Contains(text, "11/2~11/8") || !strings.Contains(text, "칭찬 2건") {
+		t.Errorf("요약 문구 = %q", text)
+	}
+	if got := strings.Count(text, "• <https://example.slack.com/"); got != 2 {
+		t.Errorf("요약 링크 수 = %d, want 2 (%q)", got, text)
+	}
+	if got := sh.rows("meta"); len(got) != 1 || got[0][0] != metaKeyGratitudeWeek || got[0][1] != "2026-W45" {
+		t.Errorf("meta 행 = %v", got)
+	}
+
+	// 같은 주에 다시 실행하면 요약하지 않는다
+	if n, _ := app.postGratitudeSummary(context.Background(), now.Add(time.Hour)); n != 0 {
+		t.Errorf("재실행 요약 수 = %d, want 0", n)
+	}
+	if got := len(fs.callsTo("chat.postMessage")); got != 1 {
+		t.Errorf("재실행 후 chat.postMessage 호출 수 = %d, want 1", got)
+	}
+}
+
+func TestGratitudeSummaryOutsideCampaign(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	sh.seed("posts", []string{time.Date(2026, 12, 2, 9, 0, 0, 0, kst).Format(time.RFC3339), "1.0", "praise", "normal"})
+	app := &App{cfg: &Config{SheetsID: "sheet", GratitudeRelayStart: "2026-11-01", GratitudeRelayEnd: "2026-11-30"}, slack: client, sheets: svc}
+
+	// 지난주(12/7~12/13)가 캠페인 이후면 아무것도 하지 않는다
+	if n, err := app.postGratitudeSummary(context.Background(), time.Date(2026, 12, 16, 10, 0, 0, 0, kst)); n != 0 || err != nil {
+		t.Errorf("캠페인 이후 요약 = %d, %v", n, err)
+	}
+	if len(fs.callsTo("chat.postMessage")) != 0 || len(sh.rows("meta")) != 0 {
+		t.Error("캠페인 이후인데 요약을 게시하거나 기록함")
+	}
+}
+
+func TestPraisePostRelayedToCampaignThread(t *testing.T) {
+	today := time.Now().In(kst).Format(gratitudeDateLayout)
+	fs, client := newFakeSlack(t)
+	fs.responses["chat.getPermalink"] = `{"ok":true,"permalink":"https://example.slack.com/archives/C09SQ9N05MZ/p1"}`
+	sh, svc := newFakeSheets(t)
+	app := &App{cfg: &Config{SheetsID: "sheet", GratitudeRelayStart: today, GratitudeRelayThreadTS: "1690000000.000100"}, slack: client, sheets: svc}
+
+	app.handleViewSubmission(viewSubmission(CallbackNewPost, "", newPostValues("praise", "normal")))
+
+	posts := fs.callsTo("chat.postMessage")
+	if len(posts) != 2 {
+		t.Fatalf("chat.postMessage 호출 수 = %d, want 2 (글 + 릴레이 답글)", len(posts))
+	}
+	if got := posts[1].Form.Get("thread_ts"); got != "1690000000.000100" {
+		t.Errorf("릴레이 thread_ts = %q", got)
+	}
+	if rows := sh.rows("posts"); len(rows) != 1 || rows[0][2] != "praise" {
+		t.Errorf("posts 행 = %v, want 칭찬 글 1건", rows)
+	}
+
+	// 칭찬이 아닌 글은 기록/릴레이하지 않는다
+	fs.calls = nil
+	app.handleViewSubmission(viewSubmission(CallbackNewPost, "", newPostValues("question", "normal")))
+	if n := len(fs.callsTo("chat.postMessage")); n != 1 {
+		t.Errorf("질문 글 chat.postMessage 호출 수 = %d, want 1", n)
+	}
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 채널 안내 (캔버스/북마크)
+// `/bamboo setup` 실행 시 대상 채널에 /bamboo 사용법을 캔버스로 만들거나 갱신한다.
+// GUIDE_BOOKMARK_URL이 설정되어 있으면 캔버스 대신 해당 URL로 북마크를 단다.
+
+const guideBookmarkTitle = "🎋 대나무숲 사용법"
+
+var guideContents = map[string]string{
+	"ko": `# 🎋 대나무숲 사용법
+
+아무 채널에서나 ` + "`/bamboo`" + `를 입력하면 익명 메시지 작성 창이 열립니다.
+
+## 글 쓰기
+1. 카테고리와 긴급도를 고릅니다
+2. 하고 싶은 말을 적고, 필요하면 닉네임과 멘션할 사람을 지정합니다
+3. 확인 체크박스를 선택하고 "게시하기"를 누릅니다
+
+## 답글과 반응
+- "💬 익명 답글 달기" 버튼으로 스레드에 익명 답글을 남길 수 있습니다
+- 👍 👎 🤗 💪 버튼으로 익명 반응을 남길 수 있습니다
+
+## 지켜주세요
+- 게시된 메시지는 수정하거나 삭제할 수 없습니다
+- 타인을 비방하거나 불쾌감을 주는 내용은 삼가주세요
+`,
+	"ja": `# 🎋 竹林（匿名投稿）の使い方
+
+どのチャンネルでも ` + "`/bamboo`" + ` と入力すると匿名メッセージの作成画面が開きます。
+
+## 投稿する
+1. カテゴリーと緊急度を選びます
+2. メッセージを書き、必要に応じてニックネームとメンション先を指定します
+3. 確認チェックボックスを選択して「게시하기」を押します
+
+## 返信とリアクション
+- 「💬 익명 답글 달기」ボタンでスレッドに匿名で返信できます
+- 👍 👎 🤗 💪 ボタンで匿名リアクションができます
+
+## お願い
+- 投稿したメッセージは編集・削除できません
+- 他人を誹謗したり不快にさせる内容は控えてください
+`,
+	"en": `# 🎋 Bamboo Forest guide
+
+Type ` + "`/bamboo`" + ` in any channel to open the anonymous post form.
+
+## Posting
+1. Pick a category and urgency
+2. Write your message and optionally set a nickname and people to mention
+3. Tick the confirmation checkbox and press "게시하기"
+
+## Replies and reactions
+- Use "💬 익명 답글 달기" to reply anonymously in the thread
+- Use the 👍 👎 🤗 💪 buttons to react anonymously
+
+## Please note
+- Posts cannot be edited or deleted
+- Please avoid content that slanders or offends others
+`,
+}
+
+// 수정/삭제 안내 줄 (EDIT_GRACE_MINUTES, DELETE_TOKENS 설정에 따라 기본 문구를 바꾼다)
+type guideNotice struct {
+	fixed  string // 기본: 수정·삭제 불가
+	edit   string // 수정만 가능 (%d: 분)
+	delete string // 삭제만 가능
+	both   string // 수정·삭제 모두 가능 (%d: 분)
+}
+
+var guideNotices = map[string]guideNotice{
+	"ko": {
+		fixed:  "- 게시된 메시지는 수정하거나 삭제할 수 없습니다",
+		edit:   "- 게시 후 %d분 동안은 본문을 수정할 수 있고, 그 뒤에는 수정하거나 삭제할 수 없습니다",
+		delete: "- 게시된 메시지는 수정할 수 없습니다 (삭제는 게시 후 DM으로 받는 토큰으로 `" + DeleteCommand + "`)",
+		both:   "- 게시 후 %d분 동안은 본문을 수정할 수 있습니다 (삭제는 게시 후 DM으로 받는 토큰으로 `" + DeleteCommand + "`)",
+	},
+	"ja": {
+		fixed:  "- 投稿したメッセージは編集・削除できません",
+		edit:   "- 投稿後%d分間は本文を編集できますが、その後は編集・削除できません",
+		delete: "- 投稿したメッセージは編集できません（削除は投稿後にDMで届くトークンで `" + DeleteCommand + "`）",
+		both:   "- 投稿後%d分間は本文を編集できます（削除は投稿後にDMで届くトークンで `" + DeleteCommand + "`）",
+	},
+	"en": {
+		fixed:  "- Posts cannot be edited or deleted",
+		edit:   "- Posts can be edited for %d minutes after posting, and cannot be edited or deleted after that",
+		delete: "- Posts cannot be edited (to delete one, use `" + DeleteCommand + "` with the token sent to you by DM after posting)",
+		both:   "- Posts can be edited for %d minutes after posting (to delete one, use `" + DeleteCommand + "` with the token sent to you by DM after posting)",
+	},
+}
+
+// 설정에 맞는 안내 본문 (GUIDE_MARKDOWN > GUIDE_LOCALE > 한국어)
+// 직접 지정한 본문은 그대로 두고, 기본 본문만 수정/삭제 설정에 맞춰 안내 줄을 바꾼다.
+func (app *App) guideContent() string {
+	if app.cfg.GuideMarkdown != "" {
+		return app.cfg.GuideMarkdown
+	}
+	locale := app.cfg.GuideLocale
+	if _, ok := guideContents[locale]; !ok {
+		locale = "ko"
+	}
+	notice := guideNotices[locale]
+
+	edit := app.cfg.EditGraceMinutes > 0
+	del := app.cfg.DeleteTokens && app.sheets != nil
+	line := notice.fixed
+	switch {
+	case edit && del:
+		line = fmt.Sprintf(notice.both, app.cfg.EditGraceMinutes)
+	case edit:
+		line = fmt.Sprintf(notice.edit, app.cfg.EditGraceMinutes)
+	case del:
+		line = notice.delete
+	}
+	return strings.Replace(guideContents[locale], notice.fixed, line, 1)
+}
+
+// 대상 채널에 안내 캔버스(또는 북마크)를 생성/갱신하고 사용자에게 보여줄 결과 문구를 반환
+func (app *App) setupChannelGuide() (string, error) {
+	if app.cfg.GuideBookmarkURL != "" {
+		if err := app.upsertGuideBookmark(); err != nil {
+			return "", err
+		}
+		return "✅ 채널에 사용법 북마크를 등록했습니다.", nil
+	}
+	if err := app.upsertGuideCanvas(); err != nil {
+		return "", err
+	}
+	return "✅ 채널 캔버스에 사용법을 등록했습니다.", nil
+}
+
+func (app *App) upsertGuideCanvas() error {
+	content := slack.DocumentContent{Type: "markdown", Markdown: app.guideContent()}
+
+	_, err := app.slack.CreateChannelCanvas(app.targetChannel(), content)
+	if err == nil {
+		log.Printf("[성공] 채널 안내 캔버스 생성 (channel=%s)", app.targetChannel())
+		return nil
+	}
+	if !strings.Contains(err.Error(), "channel_canvas_already_exists") {
+		return guideError(err)
+	}
+
+	// 이미 채널 캔버스가 있으면 내용을 통째로 교체
+	info, err := app.slack.GetConversationInfo(&slack.GetConversationInfoInput{ChannelID: app.targetChannel()})
+	if err != nil {
+		return guideError(err)
+	}
+	canvasID := info.Properties.Canvas.FileId
+	if canvasID == "" {
+		return fmt.Errorf("기존 채널 캔버스 ID를 찾을 수 없습니다")
+	}
+	err = app.slack.EditCanvas(slack.EditCanvasParams{
+		CanvasID: canvasID,
+		Changes:  []slack.CanvasChange{{Operation: "replace", DocumentContent: content}},
+	})
+	if err != nil {
+		return guideError(err)
+	}
+	log.Printf("[성공] 채널 안내 캔버스 갱신 (channel=%s, canvas=%s)", app.targetChannel(), canvasID)
+	return nil
+}
+
+func (app *App) upsertGuideBookmark() error {
+	bookmarks, err := app.slack.ListBookmarks(app.targetChannel())
+	if err != nil {
+		return guideError(err)
+	}
+	for _, b := range bookmarks {
+		if b.Title == guideBookmarkTitle {
+			_, err := app.slack.EditBookmark(app.targetChannel(), b.ID, slack.EditBookmarkParameters{Link: app.cfg.GuideBookmarkURL})
+			if err != nil {
+				return guideError(err)
+			}
+			log.Printf("[성공] 채널 안내 북마크 갱신 (channel=%s)", app.targetChannel())
+			return nil
+		}
+	}
+
+	_, err = app.slack.AddBookmark(app.targetChannel(), slack.AddBookmarkParameters{
+		Title: guideBookmarkTitle,
+		Type:  "link",
+		Link:  app.cfg.GuideBookmarkURL,
+	})
+	if err != nil {
+		return guideError(err)
+	}
+	log.Printf("[성공] 채널 안내 북마크 생성 (channel=%s)", app.targetChannel())
+	return nil
+}
+
+// 권한/스코프 에러를 설정 안내가 담긴 에러로 변환
+func guideError(err error) error {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "missing_scope"):
+		return fmt.Errorf("봇 권한이 부족합니다. canvases:write, bookmarks:write, channels:read 스코프를 추가하고 앱을 재설치해주세요 (%w)", err)
+	case strings.Contains(msg, "not_in_channel"), strings.Contains(msg, "channel_not_found"):
+		return fmt.Errorf("봇이 대상 채널에 참여하지 않았습니다. 채널에 봇을 초대해주세요 (%w)", err)
+	case strings.Contains(msg, "free_team_not_allowed"), strings.Contains(msg, "team_tier_cannot_create_channel_canvases"):
+		return fmt.Errorf("현재 워크스페이스 요금제에서는 채널 캔버스를 만들 수 없습니다. GUIDE_BOOKMARK_URL로 북마크를 사용해주세요 (%w)", err)
+	}
+	return err
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestSetupChannelGuideCreatesCanvas(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{}, slack: client}
+
+	if _, err := app.setupChannelGuide(); err != nil {
+		t.Fatalf("setupChannelGuide() error: %v", err)
+	}
+
+	calls := fs.callsTo("conversations.canvases.create")
+	if len(calls) != 1 {
+		t.Fatalf("conversations.canvases.create 호출 수 = %d, want 1", len(calls))
+	}
+	if got := calls[0].Form.Get("channel_id"); got != DefaultTargetChannelID {
+		t.Errorf("channel_id = %q, want %q", got, DefaultTargetChannelID)
+	}
+	if !strings.Contains(calls[0].Form.Get("document_content"), "/bamboo") {
+		t.Errorf("안내 본문에 /bamboo 사용법 누락: %s", calls[0].Form.Get("document_content"))
+	}
+}
+
+func TestSetupChannelGuideReplacesExistingCanvas(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	fs.responses["conversations.canvases.create"] = `{"ok":false,"error":"channel_canvas_already_exists"}`
+	fs.responses["conversations.info"] = `{"ok":true,"channel":{"id":"` + DefaultTargetChannelID + `","properties":{"canvas":{"file_id":"F123"}}}}`
+	app := &App{cfg: &Config{GuideLocale: "ja"}, slack: client}
+
+	if _, err := app.setupChannelGuide(); err != nil {
+		t.Fatalf("setupChannelGuide() error: %v", err)
+	}
+
+	calls := fs.callsTo("canvases.edit")
+	if len(calls) != 1 {
+		t.Fatalf("canvases.edit 호출 수 = %d, want 1", len(calls))
+	}
+	if got := calls[0].Form.Get("canvas_id"); got != "F123" {
+		t.Errorf("canvas_id = %q, want F123", got)
+	}
+	changes := calls[0].Form.Get("changes")
+	if !strings.Contains(changes, `"replace"`) || !strings.Contains(changes, "使い方") {
+		t.Errorf("일본어 안내로 교체되지 않음: %s", changes)
+	}
+}
+
+func TestSetupChannelGuideMissingScope(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	fs.responses["conversations.canvases.create"] = `{"ok":false,"error":"missing_scope"}`
+	app := &App{cfg: &Config{}, slack: client}
+
+	_, err := app.setupChannelGuide()
+	if err == nil || !strings.Contains(err.Error(), "canvases:write") {
+		t.Errorf("스코프 안내가 담긴 에러를 기대함, got %v", err)
+	}
+}
+
+func TestSetupChannelGuideBookmark(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	fs.responses["bookmarks.list"] = `{"ok":true,"bookmarks":[]}`
+	app := &App{cfg: &Config{GuideBookmarkURL: "https://example.com/bamboo"}, slack: client}
+
+	if _, err := app.setupChannelGuide(); err != nil {
+		t.Fatalf("setupChannelGuide() error: %v", err)
+	}
+
+	calls := fs.callsTo("bookmarks.add")
+	if len(calls) != 1 {
+		t.Fatalf("bookmarks.add 호출 수 = %d, want 1", len(calls))
+	}
+	if got := calls[0].Form.Get("link"); got != "https://example.com/bamboo" {
+		t.Errorf("link = %q", got)
+	}
+	if n := len(fs.callsTo("conversations.canvases.create")); n != 0 {
+		t.Errorf("북마크 모드에서 캔버스 생성됨 (%d회)", n)
+	}
+}
+
+func TestSetupCommandModeratorOnly(t *testing.T) {
+	tests := []struct {
+		name        string
+		user        string
+		wantCreated bool
+	}{
+		{"모더레이터", "UMOD", true},
+		{"일반 사용자", "U9", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: &Config{ModeratorUserIDs: []string{"UMOD"}}, slack: client}
+			body := url.Values{"command": {"/bamboo"}, "user_id": {tt.user}, "text": {"setup"}}.Encode()
+
+			resp, _ := app.handleSlashCommand(context.Background(), body)
+
+			created := len(fs.callsTo("conversations.canvases.create")) == 1
+			if created != tt.wantCreated {
+				t.Errorf("캔버스 등록 = %v, want %v (응답 %q)", created, tt.wantCreated, resp.Body)
+			}
+			if !tt.wantCreated && !strings.Contains(resp.Body, "모더레이터만") {
+				t.Errorf("응답 = %q, want 권한 안내", resp.Body)
+			}
+		})
+	}
+}
+
+func TestGuideContentFollowsEditDeleteSettings(t *testing.T) {
+	_, svc := newFakeSheets(t)
+	tests := []struct {
+		name   string
+		cfg    Config
+		want   string
+		absent string
+	}{
+		{"기본", Config{}, "수정하거나 삭제할 수 없습니다", "분 동안"},
+		{"수정 허용", Config{EditGraceMinutes: 5}, "게시 후 5분 동안은 본문을 수정할 수 있고", DeleteCommand},
+		{"삭제 토큰", Config{DeleteTokens: true}, "삭제는 게시 후 DM으로 받는 토큰으로 `" + DeleteCommand + "`", "수정하거나 삭제할 수 없습니다"},
+		{"수정 + 삭제", Config{EditGraceMinutes: 5, DeleteTokens: true}, "게시 후 5분 동안은 본문을 수정할 수 있습니다", "삭제할 수 없습니다"},
+		{"일본어 수정 허용", Config{GuideLocale: "ja", EditGraceMinutes: 10}, "投稿後10分間は本文を編集できますが", "投稿したメッセージは編集・削除できません"},
+		{"영어 삭제 토큰", Config{GuideLocale: "en", DeleteTokens: true}, "to delete one, use `" + DeleteCommand + "`", "cannot be edited or deleted"},
+		{"직접 지정한 본문은 그대로", Config{GuideMarkdown: "게시된 메시지는 수정하거나 삭제할 수 없습니다", EditGraceMinutes: 5}, "게시된 메시지는 수정하거나 삭제할 수 없습니다", "분 동안"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := tt.cfg
+			app := &App{cfg: &cfg, sheets: svc}
+
+			got := app.guideContent()
+			if !strings.Contains(got, tt.want) || strings.Contains(got, tt.absent) {
+				t.Errorf("안내 본문 = %q, want %q 포함, %q 없음", got, tt.want, tt.absent)
+			}
+		})
+	}
+}
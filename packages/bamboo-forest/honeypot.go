@@ -0,0 +1,61 @@
+package main
+
+import (
+	"log"
+	"strings"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 자동 입력 방지 칸 (HONEYPOT, opt-in)
+// 새 글/답글 모달에 "비워 두세요"라고 적힌 선택 입력칸을 하나 더 둔다.
+// 사람은 비워 두지만 모든 칸을 채우는 스크립트는 값을 넣으므로, 값이 있으면 에러 없이 모달만 닫고 게시하지 않는다.
+// 스크립트가 거절을 알아채지 못하도록 응답은 정상 제출과 같은 200이다.
+
+const (
+	BlockIDHoneypot  = "website_block"
+	ActionIDHoneypot = "website_input"
+)
+
+func honeypotBlock() *slack.InputBlock {
+	return slack.NewInputBlock(
+		BlockIDHoneypot,
+		slack.NewTextBlockObject("plain_text", "홈페이지 (비워 두세요)", false, false),
+		slack.NewTextBlockObject("plain_text", "자동 입력 방지용 칸입니다. 아무것도 입력하지 마세요", false, false),
+		slack.NewPlainTextInputBlockElement(nil, ActionIDHoneypot),
+	).WithOptional(true)
+}
+
+// 구분선 바로 앞(입력칸 맨 끝)에 자동 입력 방지 칸을 넣은 모달
+func withHoneypotBlock(modal slack.ModalViewRequest) slack.ModalViewRequest {
+	blocks := make([]slack.Block, 0, len(modal.Blocks.BlockSet)+1)
+	inserted := false
+	for _, block := range modal.Blocks.BlockSet {
+		if _, ok := block.(*slack.DividerBlock); ok && !inserted {
+			blocks = append(blocks, honeypotBlock())
+			inserted = true
+		}
+		blocks = append(blocks, block)
+	}
+	if !inserted {
+		blocks = append(blocks, honeypotBlock())
+	}
+	modal.Blocks.BlockSet = blocks
+	return modal
+}
+
+// 자동 입력 방지 칸에 값이 들어있는지 (설정이 꺼져 있으면 항상 false)
+func (app *App) honeypotFilled(values map[string]map[string]slack.BlockAction) bool {
+	if !app.cfg.Honeypot {
+		return false
+	}
+	return strings.TrimSpace(values[BlockIDHoneypot][ActionIDHoneypot].Value) != ""
+}
+
+// 자동 입력으로 보이는 제출은 게시하지 않고 모달만 닫음
+func rejectHoneypot(callbackID string) (events.LambdaFunctionURLResponse, error) {
+	log.Printf("[경고] 자동 입력 방지 칸이 채워진 제출, 게시하지 않음 (callback=%s)", callbackID)
+	return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+}
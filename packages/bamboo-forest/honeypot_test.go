@@ -0,0 +1,88 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+func TestWithHoneypotBlock(t *testing.T) {
+	modals := map[string]slack.ModalViewRequest{
+		"new post": buildNewPostModal(false),
+		"thread":   buildThreadModal("C1", "1.0", false),
+	}
+	for name, modal := range modals {
+		t.Run(name, func(t *testing.T) {
+			blocks := withHoneypotBlock(modal).Blocks.BlockSet
+			for i, block := range blocks {
+				input, ok := block.(*slack.InputBlock)
+				if !ok || input.BlockID != BlockIDHoneypot {
+					continue
+				}
+				if !input.Optional {
+					t.Error("자동 입력 방지 칸이 필수 입력임")
+				}
+				if _, ok := blocks[i+1].(*slack.DividerBlock); !ok {
+					t.Errorf("자동 입력 방지 칸 다음 블록 = %T, want 구분선", blocks[i+1])
+				}
+				return
+			}
+			t.Fatal("자동 입력 방지 칸 없음")
+		})
+	}
+}
+
+func TestHoneypotSubmission(t *testing.T) {
+	tests := []struct {
+		name     string
+		honeypot bool
+		value    string
+		wantPost bool
+	}{
+		{"empty accepted", true, "", true},
+		{"whitespace accepted", true, "  ", true},
+		{"filled rejected", true, "https://spam.example", false},
+		{"disabled ignores field", false, "https://spam.example", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: &Config{Honeypot: tt.honeypot}, slack: client}
+
+			values := newPostValues("question", "normal")
+			values[BlockIDHoneypot] = map[string]slack.BlockAction{ActionIDHoneypot: {Value: tt.value}}
+			payload := viewSubmission(CallbackNewPost, "", values)
+			payload.User.ID = "U0"
+			resp, err := app.handleViewSubmission(payload)
+			if err != nil || resp.StatusCode != 200 {
+				t.Fatalf("handleViewSubmission: status=%d err=%v", resp.StatusCode, err)
+			}
+
+			posts := len(fs.callsTo("chat.postMessage"))
+			if tt.wantPost && posts != 1 {
+				t.Errorf("chat.postMessage 호출 수 = %d, want 1 (body=%s)", posts, resp.Body)
+			}
+			if !tt.wantPost {
+				if posts != 0 {
+					t.Errorf("자동 입력 제출이 게시됨 (%d회)", posts)
+				}
+				if resp.Body != "" {
+					t.Errorf("거절 응답 본문 = %q, want 빈 본문(모달 닫힘)", resp.Body)
+				}
+			}
+		})
+	}
+}
+
+func TestHoneypotThreadReply(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{Honeypot: true}, slack: client}
+
+	payload := replySubmission("")
+	payload.View.State.Values[BlockIDHoneypot] = map[string]slack.BlockAction{ActionIDHoneypot: {Value: "buy now"}}
+	app.handleViewSubmission(payload)
+
+	if n := len(fs.callsTo("chat.postMessage")); n != 0 {
+		t.Errorf("자동 입력 답글이 게시됨 (%d회)", n)
+	}
+}
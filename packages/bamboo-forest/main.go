@@ -2,15 +2,19 @@ package main
 
 import (
 	"context"
+	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"maps"
 	"net/http"
 	"net/url"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -27,11 +31,12 @@ import (
 // ─────────────────────────────────────
 // 상수
 const (
-	TargetChannelID = "C09SQ9N05MZ" // 익명 메시지가 게시될 채널
+	DefaultTargetChannelID = "C09SQ9N05MZ" // 익명 메시지가 게시될 채널 (BAMBOO_CHANNEL_ID가 없을 때)
 
 	// Callback IDs
 	CallbackNewPost   = "bamboo_new_post"
 	CallbackNewThread = "bamboo_new_thread"
+	CallbackEncourage = "bamboo_encourage" // 격려 보내기 글로벌 숏컷
 
 	// Block IDs
 	BlockIDMessage  = "message_block"
@@ -50,25 +55,140 @@ const (
 	ActionIDConfirm  = "confirm_checkbox"
 
 	// Button Action IDs
-	ActionReplyButton    = "bamboo_reply"
-	ActionCompleteButton = "bamboo_complete"
+	ActionReplyButton      = "bamboo_reply"
+	ActionCompleteButton   = "bamboo_complete"
+	ActionUncompleteButton = "bamboo_uncomplete"
 
 	// Emoji Reaction Action IDs
 	ActionEmojiThumbsUp   = "bamboo_emoji_thumbsup"
 	ActionEmojiThumbsDown = "bamboo_emoji_thumbsdown"
 	ActionEmojiHug        = "bamboo_emoji_hug"
 	ActionEmojiFlex       = "bamboo_emoji_flex"
+	ActionEmojiMulti      = "bamboo_emoji_multi"
 )
 
 // ─────────────────────────────────────
 // 설정
 type Config struct {
+	// 익명 메시지가 게시될 채널 ID (없으면 DefaultTargetChannelID)
+	BambooChannelID    string `json:"BAMBOO_CHANNEL_ID"`
 	SlackBotToken      string `json:"SLACK_BOT_TOKEN"`
 	SlackSigningSecret string `json:"SLACK_SIGNING_SECRET"`
 	// GCP 설정 (익명 이모지 리액션용)
 	GoogleCloudProjectID string `json:"GOOGLE_CLOUD_PROJECT_ID"`
 	GoogleCreds          string `json:"GOOGLE_CREDS"`
 	SheetsID             string `json:"SHEETS_ID"`
+	// 격려 보내기 프리셋 문구 (비어있으면 기본 문구 사용)
+	EncouragementMessages []string `json:"ENCOURAGEMENT_MESSAGES"`
+	// 한 사용자가 한 글에 남길 수 있는 서로 다른 이모지 반응 수 (0이면 제한 없음)
+	MaxReactionsPerUser int `json:"MAX_REACTIONS_PER_USER"`
+	// 같은 사람이 같은 글에 같은 이모지를 이 초 안에 다시 누르면 무시 (0이면 사용 안 함)
+	ReactionCooldownSeconds int `json:"REACTION_COOLDOWN_SECONDS"`
+	// 반응 해시에 넣는 비밀 값 (기본: SLACK_SIGNING_SECRET, 바꾸면 기존 반응의 중복 확인/취소가 되지 않음)
+	ReactionHashSalt string `json:"REACTION_HASH_SALT"`
+	// 반응 저장소 (sheets(기본) | dynamodb)와 DynamoDB 테이블/글별 조회 인덱스/엔드포인트(로컬 테스트용)
+	ReactionStore        string `json:"REACTION_STORE"`
+	DynamoDBTable        string `json:"DYNAMODB_REACTIONS_TABLE"`
+	DynamoDBMessageIndex string `json:"DYNAMODB_MESSAGE_INDEX"`
+	DynamoDBEndpoint     string `json:"DYNAMODB_ENDPOINT"`
+	// 금칙어 목록 (들어 있는 글은 게시하지 않고 다시 쓰게 함, 대소문자/공백/전각 차이 무시)
+	BannedWords []string `json:"BANNED_WORDS"`
+	// 채널 안내 (`/bamboo setup`): 언어(ko|ja|en), 본문 직접 지정, 캔버스 대신 북마크로 걸 URL
+	GuideLocale      string `json:"GUIDE_LOCALE"`
+	GuideMarkdown    string `json:"GUIDE_MARKDOWN"`
+	GuideBookmarkURL string `json:"GUIDE_BOOKMARK_URL"`
+	// 익명성 검사 실패 시 동작 (기본: 게시 중단, "warn": 로그만 남기고 게시)
+	AnonymityAuditMode string `json:"ANONYMITY_AUDIT_MODE"`
+	// 긴급 글이 이 시간(예: "24h") 동안 처리완료되지 않으면 스레드에 알림, 에스컬레이션 채널이 있으면 링크 공유
+	UrgentEscalateAfter   string `json:"URGENT_ESCALATE_AFTER"`
+	UrgentEscalateChannel string `json:"URGENT_ESCALATE_CHANNEL"`
+	// 이모지별 반응 점수 가중치 (예: {"thumbsup": 1, "thumbsdown": -1}). 설정 시 카운트 옆에 "반응 점수" 표시
+	ReactionWeights map[string]int `json:"REACTION_WEIGHTS"`
+	// 게시 전 확인 단계를 거칠 카테고리/긴급도 값 (예: ["concern", "urgent"])과 대기 시간(초, 기본: 5)
+	CoolingOffCategories []string `json:"COOLING_OFF_CATEGORIES"`
+	CoolingOffSeconds    int      `json:"COOLING_OFF_SECONDS"`
+	// 한 사람이 이 시간(분, 기본: 60) 안에 올릴 수 있는 새 글 수 (0이면 제한 없음, Sheets 필요)와 사용자 해시에 쓸 비밀 값 (없으면 서명 시크릿)
+	RateLimitPosts         int    `json:"RATE_LIMIT_POSTS"`
+	RateLimitWindowMinutes int    `json:"RATE_LIMIT_WINDOW_MINUTES"`
+	RateLimitSalt          string `json:"RATE_LIMIT_SALT"`
+	// 모더레이터 사용자 ID 목록
+	ModeratorUserIDs []string `json:"MODERATOR_USER_IDS"`
+	// 처리 완료 버튼을 누를 수 있는 관리자 사용자 ID (쉼표 구분, 예: "U0123,U0456"). 설정하면 관리자와 글 작성자만 처리 가능
+	CompleteAdminUserIDs string `json:"COMPLETE_ADMIN_USER_IDS"`
+	// 테스트 모드: 모더레이터의 글/답글을 채널 대신 본인 DM으로 보냄
+	DryRun bool `json:"DRY_RUN"`
+	// 채널에 카테고리/긴급도 누적 현황판 메시지를 고정하고 새 글마다 갱신 (Sheets 필요)
+	Dashboard bool `json:"DASHBOARD"`
+	// 이모지 버튼 옆에 여러 이모지를 한 번에 고르는 선택 메뉴 추가
+	MultiReactionSelect bool `json:"MULTI_REACTION_SELECT"`
+	// 게시 시각으로 작성자를 추측하지 못하도록 새 글을 이 범위(초) 안의 임의 시간 뒤로 예약 게시 (최대값 0이면 즉시 게시)
+	PostDelayMinSeconds int `json:"POST_DELAY_MIN_SECONDS"`
+	PostDelayMaxSeconds int `json:"POST_DELAY_MAX_SECONDS"`
+	// 글/답글 하나에 멘션할 수 있는 최대 인원 (기본: 5)
+	MaxMentions int `json:"MAX_MENTIONS"`
+	// 모달에서 멘션 입력칸을 빼고 본문에 적은 멘션(<@U…>, @here 등)도 지움
+	DisableMentions bool `json:"DISABLE_MENTIONS"`
+	// 새 글/답글 모달에 비워 둬야 하는 자동 입력 방지 칸을 추가하고, 값이 들어오면 게시하지 않음
+	Honeypot bool `json:"HONEYPOT"`
+	// 시작 시 대상 채널 참여 확인 ("warn": 로그 안내, "join": 공개 채널이면 자동 참여, 비어있으면 확인 안 함)
+	ChannelCheck string `json:"CHANNEL_CHECK"`
+	// 스레드 답글에도 종류(답변/추가 의견/추가 질문) 선택을 필수로 받음
+	CategorizeReplies bool `json:"CATEGORIZE_REPLIES"`
+	// 스케줄 실행 때 워크스페이스를 떠난 사용자의 이모지 반응을 지우고 카운트 갱신 (users:read 필요)
+	ReactionSweep bool `json:"REACTION_SWEEP"`
+	// 글 하단에 한 줄 익명 답글을 바로 남기는 "빠른 한마디" 버튼 추가
+	QuickReply bool `json:"QUICK_REPLY"`
+	// 닉네임 최대 글자 수 (기본: 30)
+	MaxNicknameLength int `json:"MAX_NICKNAME_LENGTH"`
+	// 본문 최대 글자 수 (기본: 2000)
+	MaxMessageLength int `json:"MAX_MESSAGE_LENGTH"`
+	// 새 글을 외부 시스템으로 전달할 웹훅 (서명용 공유 비밀은 필수, 본문 포함 여부)
+	ForwardWebhookURL     string `json:"FORWARD_WEBHOOK_URL"`
+	ForwardWebhookSecret  string `json:"FORWARD_WEBHOOK_SECRET"`
+	ForwardWebhookContent bool   `json:"FORWARD_WEBHOOK_CONTENT"`
+	// 새 글 모달에 "오늘의 기분" 선택을 추가하고 헤더 표시 + posts 탭에 기록
+	MoodTracking bool `json:"MOOD_TRACKING"`
+	// 스케줄 실행 때 이 일수(예: 90)가 지난 반응 기록을 reactions 탭에서 삭제 (0이면 보관)
+	ReactionRetentionDays int `json:"REACTION_RETENTION_DAYS"`
+	// 반응 정리 결과 요약을 올릴 운영 채널 ID (비어있으면 로그만 남김)
+	OpsChannel string `json:"OPS_CHANNEL"`
+	// 카테고리별로 새 글을 쓸 수 있는 사용자 ID (예: {"other": ["U0123"]}), 없는 카테고리는 누구나
+	CategoryAllowedUsers map[string][]string `json:"CATEGORY_ALLOWED_USERS"`
+	// 이 시간(예: 24) 안에 본문이 같은 글(대소문자/공백 차이 무시)을 다시 올리면 게시하지 않음 (0이면 확인 안 함)
+	DuplicatePostWindowHours int `json:"DUPLICATE_POST_WINDOW_HOURS"`
+	// 반응 버튼에 추가할 워크스페이스 커스텀 이모지 이름 (예: ["party_parrot"], 시작 시 emoji.list로 확인)
+	CustomReactionEmojis []string `json:"CUSTOM_REACTION_EMOJIS"`
+	// 기본 반응 버튼 대신 쓸 반응 목록 (예: [{"value": "heart", "emoji": "❤️", "weight": 2}], 순서대로 버튼과 카운트 표시)
+	ReactionSet []ReactionSetEntry `json:"REACTION_SET"`
+	// 글마다 단어 목록 기반 감정 라벨(positive/negative/neutral)만 posts 탭에 기록 (본문은 남기지 않음)
+	SentimentTagging bool `json:"SENTIMENT_TAGGING"`
+	// 감사 릴레이 캠페인 기간 (예: "2026-11-01" ~ "2026-11-30", 종료일이 없으면 계속)과 칭찬 글 링크를 이어 붙일 캠페인 안내 글 ts
+	GratitudeRelayStart    string `json:"GRATITUDE_RELAY_START"`
+	GratitudeRelayEnd      string `json:"GRATITUDE_RELAY_END"`
+	GratitudeRelayThreadTS string `json:"GRATITUDE_RELAY_THREAD_TS"`
+	// 작성자가 게시 후 이 시간(분, 예: 5) 안에 "✏️ 수정" 버튼으로 본문을 고칠 수 있음 (Sheets 필요, 0이면 사용 안 함)
+	EditGraceMinutes int `json:"EDIT_GRACE_MINUTES"`
+	// 게시 후 작성자에게 일회용 삭제 토큰을 DM으로 보내 /bamboo-delete로 직접 지울 수 있게 함 (Sheets 필요)
+	DeleteTokens bool `json:"DELETE_TOKENS"`
+	// 헤더에 게시 시각을 보는 사람의 시간대로 표시 (Slack 날짜 토큰)
+	ShowTimestamp bool `json:"SHOW_TIMESTAMP"`
+	// 게시 후 이 시간(예: 24) 동안 반응·답글이 없으면 작성자가 "🔁 다시 올리기"로 한 번 다시 알릴 수 있음 (Sheets 필요, 0이면 사용 안 함)
+	BumpAfterHours int `json:"BUMP_AFTER_HOURS"`
+	// 긴급 글을 대상 채널과 함께 올릴 채널 ID 목록 (이모지 반응은 채널별 사본마다 따로 집계)
+	UrgentFanoutChannels []string `json:"URGENT_FANOUT_CHANNELS"`
+	// 글별 이모지 카운트를 인스턴스 메모리에 둘 시간(초, 기본: 30, 음수면 캐시 안 함). 이 인스턴스에서 반응이 바뀌면 바로 지움
+	EmojiCountCacheSeconds int `json:"EMOJI_COUNT_CACHE_SECONDS"`
+	// 새 글의 내용만(버튼·반응 카운트 없이) 함께 올릴 열람 전용 채널 ID
+	MirrorChannel string `json:"MIRROR_CHANNEL"`
+	// 검토를 거쳐 게시할 카테고리/긴급도 값 (예: ["concern"])과 검토 단계 (예: [{"channel": "C1", "user_ids": ["U1"], "escalate_after": "4h"}, {"channel": "C2"}], Sheets 필요)
+	ModeratedCategories []string         `json:"MODERATED_CATEGORIES"`
+	ModerationTiers     []ModerationTier `json:"MODERATION_TIERS"`
+	// 카테고리별 반응 기준 (예: {"suggestion": {"emoji": "thumbsup", "count": 10, "badge": "🌟 주목받는 글", "complete": false}}). 닿으면 헤더 표시 또는 자동 처리 완료
+	ReactionThresholds map[string]ReactionThreshold `json:"REACTION_THRESHOLDS"`
+	// 긴급 글이 게시되면 글 링크를 DM으로 받을 관리자 사용자 ID 목록
+	UrgentAlertUserIDs []string `json:"URGENT_ALERT_USER_IDS"`
+	// 스케줄 실행에서 주가 바뀌면 지난주 카테고리별 글 수·긴급 글 수·반응 합계를 대상 채널에 게시 (Sheets 필요)
+	WeeklyDigest bool `json:"WEEKLY_DIGEST"`
 }
 
 func LoadConfigFromSecrets(ctx context.Context) (*Config, error) {
@@ -78,6 +198,7 @@ func LoadConfigFromSecrets(ctx context.Context) (*Config, error) {
 		return &Config{
 			SlackBotToken:      os.Getenv("SLACK_BOT_TOKEN"),
 			SlackSigningSecret: os.Getenv("SLACK_SIGNING_SECRET"),
+			BambooChannelID:    os.Getenv("BAMBOO_CHANNEL_ID"),
 		}, nil
 	}
 
@@ -109,6 +230,23 @@ type App struct {
 	cfg    *Config
 	slack  *slack.Client
 	sheets *sheets.Service
+	// 반응 저장소 (DynamoDB 등, 없으면 Sheets)
+	reactions ReactionStore
+	// 최근 글의 이모지 카운트 (nil이면 캐시하지 않음)
+	countCache *emojiCountCache
+}
+
+// Slack 클라이언트 옵션 (테스트에서 가짜 API 서버 주소 지정)
+var slackOptions []slack.Option
+
+var channelIDRegex = regexp.MustCompile(`^[CG][A-Z0-9]+$`)
+
+// 익명 메시지가 게시될 채널 (NewApp을 거치지 않은 경우 기본 채널)
+func (app *App) targetChannel() string {
+	if app.cfg.BambooChannelID != "" {
+		return app.cfg.BambooChannelID
+	}
+	return DefaultTargetChannelID
 }
 
 func NewApp(ctx context.Context, cfg *Config) (*App, error) {
@@ -116,9 +254,29 @@ func NewApp(ctx context.Context, cfg *Config) (*App, error) {
 		return nil, fmt.Errorf("Slack 설정 누락")
 	}
 
+	// 대상 채널 (설정이 없으면 기존 배포와 같은 기본 채널)
+	cfg.BambooChannelID = strings.TrimSpace(cfg.BambooChannelID)
+	if cfg.BambooChannelID == "" {
+		log.Printf("[정보] BAMBOO_CHANNEL_ID 없음, 기본 채널 사용 (channel=%s)", DefaultTargetChannelID)
+		cfg.BambooChannelID = DefaultTargetChannelID
+	}
+	if !channelIDRegex.MatchString(cfg.BambooChannelID) {
+		return nil, fmt.Errorf("BAMBOO_CHANNEL_ID 형식 오류 (%q, C 또는 G로 시작하는 채널 ID)", cfg.BambooChannelID)
+	}
+
+	if err := validateModerationTiers(cfg.ModerationTiers); err != nil {
+		return nil, err
+	}
+
+	// 서명 없는 웹훅은 받는 쪽이 위조 요청과 구별할 수 없으므로 전달을 끈다
+	if cfg.ForwardWebhookURL != "" && cfg.ForwardWebhookSecret == "" {
+		log.Println("[경고] FORWARD_WEBHOOK_SECRET 없음, 웹훅 전달 비활성화")
+		cfg.ForwardWebhookURL = ""
+	}
+
 	app := &App{
 		cfg:   cfg,
-		slack: slack.New(cfg.SlackBotToken),
+		slack: slack.New(cfg.SlackBotToken, slackOptions...),
 	}
 
 	// Google Sheets 클라이언트 초기화 (설정이 있는 경우에만)
@@ -139,9 +297,45 @@ func NewApp(ctx context.Context, cfg *Config) (*App, error) {
 		log.Println("[정보] Google Sheets 설정 없음, 이모지 기능 비활성화")
 	}
 
+	if err := app.initReactionStore(ctx); err != nil {
+		return nil, err
+	}
+	if ttl := app.emojiCountCacheTTL(); ttl > 0 {
+		app.countCache = newEmojiCountCache(ttl, emojiCountCacheCapacity)
+	}
+
+	baseEmojis := baseReactionEmojis(cfg.ReactionSet)
+	reactionEmojis = baseEmojis
+	if len(cfg.CustomReactionEmojis) > 0 {
+		reactionEmojis = withCustomReactionEmojis(baseEmojis, app.existingCustomEmojis(ctx, cfg.CustomReactionEmojis))
+		log.Printf("[정보] 이모지 반응 %d종 사용 (커스텀 %d종)", len(reactionEmojis), len(reactionEmojis)-len(baseEmojis))
+	} else if len(cfg.ReactionSet) > 0 {
+		log.Printf("[정보] 이모지 반응 %d종 사용 (REACTION_SET)", len(reactionEmojis))
+	}
+
+	// 대상 채널 참여 확인 (비공개 채널 미참여는 게시가 불가능하므로 초기화 중단)
+	if cfg.ChannelCheck != "" {
+		if err := app.checkTargetChannel(); err != nil {
+			if errors.Is(err, errPrivateChannelNotMember) {
+				return nil, err
+			}
+			log.Printf("[경고] %v", err)
+		}
+	}
+
 	return app, nil
 }
 
+// 멘션 인원 제한 (대량 알림 방지)
+const defaultMaxMentions = 5
+
+func (app *App) maxMentions() int {
+	if app.cfg.MaxMentions > 0 {
+		return app.cfg.MaxMentions
+	}
+	return defaultMaxMentions
+}
+
 // ─────────────────────────────────────
 // 카테고리/긴급도 옵션
 var categoryOptions = []*slack.OptionBlockObject{
@@ -166,6 +360,37 @@ var categoryLabels = map[string]string{
 	"other":      "📝 기타",
 }
 
+// 이모지 반응 정의 (버튼 순서, 시트 기록 값, 반응 점수 기본 가중치)
+type reactionEmoji struct {
+	Name     string // 버튼 value, 시트에 기록되는 값
+	ActionID string
+	Icon     string
+	Weight   int
+}
+
+var defaultReactionEmojis = []reactionEmoji{
+	{Name: "thumbsup", ActionID: ActionEmojiThumbsUp, Icon: "👍", Weight: 1},
+	{Name: "thumbsdown", ActionID: ActionEmojiThumbsDown, Icon: "👎", Weight: -1},
+	{Name: "hug", ActionID: ActionEmojiHug, Icon: "🤗", Weight: 2},
+	{Name: "flex", ActionID: ActionEmojiFlex, Icon: "💪", Weight: 1},
+}
+
+// 현재 반응 목록 (NewApp에서 REACTION_SET으로 바꾸고, CUSTOM_REACTION_EMOJIS가 있으면 커스텀 이모지를 덧붙임)
+var reactionEmojis = defaultReactionEmojis
+
+// 답글 분류 (CATEGORIZE_REPLIES)
+var replyCategoryOptions = []*slack.OptionBlockObject{
+	slack.NewOptionBlockObject("answer", slack.NewTextBlockObject("plain_text", "💬 답변", false, false), nil),
+	slack.NewOptionBlockObject("opinion", slack.NewTextBlockObject("plain_text", "➕ 추가 의견", false, false), nil),
+	slack.NewOptionBlockObject("followup", slack.NewTextBlockObject("plain_text", "❓ 추가 질문", false, false), nil),
+}
+
+var replyCategoryLabels = map[string]string{
+	"answer":   "💬 답변",
+	"opinion":  "➕ 추가 의견",
+	"followup": "❓ 추가 질문",
+}
+
 var urgencyLabels = map[string]string{
 	"urgent": "🔴 긴급",
 	"normal": "🟡 보통",
@@ -174,8 +399,8 @@ var urgencyLabels = map[string]string{
 
 // ─────────────────────────────────────
 // 모달 생성: 새 글 작성
-func buildNewPostModal() slack.ModalViewRequest {
-	return slack.ModalViewRequest{
+func buildNewPostModal(moodTracking bool) slack.ModalViewRequest {
+	modal := slack.ModalViewRequest{
 		Type:       slack.ViewType("modal"),
 		CallbackID: CallbackNewPost,
 		Title:      slack.NewTextBlockObject("plain_text", "🎋 대나무숲", false, false),
@@ -262,15 +487,43 @@ func buildNewPostModal() slack.ModalViewRequest {
 			},
 		},
 	}
+
+	// 오늘의 기분 선택 (MOOD_TRACKING, 맨 위)
+	if moodTracking {
+		modal.Blocks.BlockSet = append([]slack.Block{moodBlock()}, modal.Blocks.BlockSet...)
+	}
+	return modal
+}
+
+// 카테고리를 미리 선택한 새 글 모달 (`/bamboo question`, 알 수 없는 값이면 그대로)
+func withCategoryPreselected(modal slack.ModalViewRequest, category string) slack.ModalViewRequest {
+	category = strings.ToLower(strings.TrimSpace(category))
+	if _, ok := categoryLabels[category]; !ok {
+		return modal
+	}
+	for _, block := range modal.Blocks.BlockSet {
+		b, ok := block.(*slack.InputBlock)
+		if !ok || b.BlockID != BlockIDCategory {
+			continue
+		}
+		if sel, ok := b.Element.(*slack.SelectBlockElement); ok {
+			for _, opt := range sel.Options {
+				if opt.Value == category {
+					sel.InitialOption = opt
+				}
+			}
+		}
+	}
+	return modal
 }
 
 // ─────────────────────────────────────
 // 모달 생성: 스레드 답글
-func buildThreadModal(channelID, threadTS string) slack.ModalViewRequest {
+func buildThreadModal(channelID, threadTS string, categorize bool) slack.ModalViewRequest {
 	// private_metadata에 채널과 스레드 정보 저장
 	metadata := fmt.Sprintf("%s|%s", channelID, threadTS)
 
-	return slack.ModalViewRequest{
+	modal := slack.ModalViewRequest{
 		Type:            slack.ViewType("modal"),
 		CallbackID:      CallbackNewThread,
 		PrivateMetadata: metadata,
@@ -310,6 +563,18 @@ func buildThreadModal(channelID, threadTS string) slack.ModalViewRequest {
 						ActionIDMention,
 					),
 				).WithOptional(true),
+				// 긴급도 선택 (선택, 고르지 않으면 헤더에 표시하지 않음)
+				slack.NewInputBlock(
+					BlockIDUrgency,
+					slack.NewTextBlockObject("plain_text", "긴급도 (선택사항)", false, false),
+					slack.NewTextBlockObject("plain_text", "급한 후속 내용이면 긴급으로 표시하세요", false, false),
+					slack.NewOptionsSelectBlockElement(
+						"static_select",
+						slack.NewTextBlockObject("plain_text", "긴급도 선택...", false, false),
+						ActionIDUrgency,
+						urgencyOptions...,
+					),
+				).WithOptional(true),
 				// 구분선
 				slack.NewDividerBlock(),
 				// 확인 체크박스 (필수)
@@ -329,11 +594,29 @@ func buildThreadModal(channelID, threadTS string) slack.ModalViewRequest {
 			},
 		},
 	}
+
+	// 답글 분류 선택 (CATEGORIZE_REPLIES, 메시지 입력 바로 아래)
+	if categorize {
+		set := modal.Blocks.BlockSet
+		categoryBlock := slack.NewInputBlock(
+			BlockIDCategory,
+			slack.NewTextBlockObject("plain_text", "답글 종류", false, false),
+			slack.NewTextBlockObject("plain_text", "답글의 성격을 선택하세요", false, false),
+			slack.NewOptionsSelectBlockElement(
+				"static_select",
+				slack.NewTextBlockObject("plain_text", "답글 종류 선택...", false, false),
+				ActionIDCategory,
+				replyCategoryOptions...,
+			),
+		)
+		modal.Blocks.BlockSet = append([]slack.Block{set[0], categoryBlock}, set[1:]...)
+	}
+	return modal
 }
 
 // ─────────────────────────────────────
 // 새 글 메시지 블록 생성 (카테고리/긴급도/처리완료 버튼 포함)
-func buildNewPostBlocks(message, nickname string, mentions []string, category, urgency string) []slack.Block {
+func buildNewPostBlocks(message, nickname string, mentions []string, category, urgency, mood string) []slack.Block {
 	displayName := nickname
 	if displayName == "" {
 		displayName = "익명"
@@ -349,15 +632,19 @@ func buildNewPostBlocks(message, nickname string, mentions []string, category, u
 		mentionText = strings.Join(mentionParts, " ") + "\n\n"
 	}
 
-	// 카테고리/긴급도 라벨
+	// 카테고리/긴급도 라벨 (+ 오늘의 기분)
 	categoryLabel := categoryLabels[category]
 	urgencyLabel := urgencyLabels[urgency]
+	header := fmt.Sprintf("🎋 *%s* │ %s │ %s", displayName, categoryLabel, urgencyLabel)
+	if glyph, ok := moodGlyphs[mood]; ok {
+		header += " │ " + glyph
+	}
 
 	return []slack.Block{
 		// 헤더 (닉네임 + 카테고리 + 긴급도)
 		slack.NewContextBlock(
 			"",
-			slack.NewTextBlockObject("mrkdwn", fmt.Sprintf("🎋 *%s* │ %s │ %s", displayName, categoryLabel, urgencyLabel), false, false),
+			slack.NewTextBlockObject("mrkdwn", header, false, false),
 		),
 		// 메시지 본문
 		slack.NewSectionBlock(
@@ -367,32 +654,10 @@ func buildNewPostBlocks(message, nickname string, mentions []string, category, u
 		// 이모지 리액션 카운트 (초기값 0)
 		slack.NewContextBlock(
 			"emoji_counts",
-			slack.NewTextBlockObject("mrkdwn", "👍 0 │ 👎 0 │ 🤗 0 │ 💪 0", false, false),
+			slack.NewTextBlockObject("mrkdwn", formatEmojiCounts(map[string]int{}), false, false),
 		),
 		// 이모지 버튼들
-		slack.NewActionBlock(
-			"emoji_actions",
-			slack.NewButtonBlockElement(
-				ActionEmojiThumbsUp,
-				"thumbsup",
-				slack.NewTextBlockObject("plain_text", "👍", true, false),
-			),
-			slack.NewButtonBlockElement(
-				ActionEmojiThumbsDown,
-				"thumbsdown",
-				slack.NewTextBlockObject("plain_text", "👎", true, false),
-			),
-			slack.NewButtonBlockElement(
-				ActionEmojiHug,
-				"hug",
-				slack.NewTextBlockObject("plain_text", "🤗", true, false),
-			),
-			slack.NewButtonBlockElement(
-				ActionEmojiFlex,
-				"flex",
-				slack.NewTextBlockObject("plain_text", "💪", true, false),
-			),
-		),
+		slack.NewActionBlock("emoji_actions", emojiButtons()...),
 		// 구분선
 		slack.NewDividerBlock(),
 		// 버튼들 (답글 + 처리완료)
@@ -414,7 +679,7 @@ func buildNewPostBlocks(message, nickname string, mentions []string, category, u
 
 // ─────────────────────────────────────
 // 스레드 답글 메시지 블록 생성
-func buildThreadReplyBlocks(message, nickname string, mentions []string) []slack.Block {
+func buildThreadReplyBlocks(message, nickname string, mentions []string, category, urgency string) []slack.Block {
 	displayName := nickname
 	if displayName == "" {
 		displayName = "익명"
@@ -430,11 +695,19 @@ func buildThreadReplyBlocks(message, nickname string, mentions []string) []slack
 		mentionText = strings.Join(mentionParts, " ") + "\n\n"
 	}
 
+	// 헤더 (닉네임 + 답글 분류 + 긴급도, 고르지 않은 항목은 생략)
+	header := fmt.Sprintf("🎋 *%s*", displayName)
+	if label, ok := replyCategoryLabels[category]; ok {
+		header += " │ " + label
+	}
+	if label, ok := urgencyLabels[urgency]; ok {
+		header += " │ " + label
+	}
+
 	return []slack.Block{
-		// 헤더 (닉네임)
 		slack.NewContextBlock(
 			"",
-			slack.NewTextBlockObject("mrkdwn", fmt.Sprintf("🎋 *%s*", displayName), false, false),
+			slack.NewTextBlockObject("mrkdwn", header, false, false),
 		),
 		// 메시지 본문
 		slack.NewSectionBlock(
@@ -457,21 +730,65 @@ func buildThreadReplyBlocks(message, nickname string, mentions []string) []slack
 
 // ─────────────────────────────────────
 // Slash Command 처리
-func (app *App) handleSlashCommand(body string) (events.LambdaFunctionURLResponse, error) {
+func (app *App) handleSlashCommand(ctx context.Context, body string) (events.LambdaFunctionURLResponse, error) {
 	values, err := url.ParseQuery(body)
 	if err != nil {
 		log.Printf("[에러] 요청 파싱 실패: %v", err)
 		return respondWithSlackError("요청을 처리할 수 없습니다.")
 	}
 
+	// 관리 명령어 (/bamboo-admin)
+	if values.Get("command") == AdminCommand {
+		return app.handleAdminCommand(ctx, values)
+	}
+	// 작성자 삭제 (/bamboo-delete <토큰>)
+	if values.Get("command") == DeleteCommand {
+		return app.handleDeleteCommand(ctx, values)
+	}
+
+	// 채널 안내 등록 (/bamboo setup, 모더레이터 전용)
+	if strings.TrimSpace(values.Get("text")) == "setup" {
+		if !app.isModerator(values.Get("user_id")) {
+			log.Printf("[스킵] 채널 안내 등록 권한 없음")
+			return respondWithSlackError("모더레이터만 사용할 수 있는 명령어입니다.")
+		}
+		result, err := app.setupChannelGuide()
+		if err != nil {
+			log.Printf("[에러] 채널 안내 등록 실패: %v", err)
+			return respondWithSlackError(err.Error())
+		}
+		return events.LambdaFunctionURLResponse{
+			StatusCode: 200,
+			Headers:    map[string]string{"Content-Type": "text/plain; charset=utf-8"},
+			Body:       result,
+		}, nil
+	}
+
 	triggerID := values.Get("trigger_id")
 	if triggerID == "" {
 		log.Println("[에러] trigger_id 없음")
 		return respondWithSlackError("요청 정보가 부족합니다.")
 	}
 
+	// 사용자별 새 글 제한 (RATE_LIMIT_POSTS)
+	if notice := app.checkRateLimit(ctx, values.Get("user_id"), time.Now()); notice != "" {
+		return respondWithSlackError(notice)
+	}
+
 	// 모달 열기
-	modal := buildNewPostModal()
+	modal := withCategoryPreselected(buildNewPostModal(app.cfg.MoodTracking), values.Get("text"))
+	if app.cfg.DisableMentions {
+		modal = withoutMentionBlock(modal)
+	}
+	if app.cfg.Honeypot {
+		modal = withHoneypotBlock(modal)
+	}
+	if app.cfg.EditGraceMinutes > 0 {
+		modal = withEditNotice(modal, app.cfg.EditGraceMinutes)
+	}
+	if app.cfg.DeleteTokens && app.sheets != nil {
+		modal = withDeleteNotice(modal)
+	}
 	_, err = app.slack.OpenView(triggerID, modal)
 	if err != nil {
 		log.Printf("[에러] 모달 열기 실패: %v", err)
@@ -508,6 +825,8 @@ func (app *App) handleInteraction(ctx context.Context, body string) (events.Lamb
 		return app.handleViewSubmission(payload)
 	case slack.InteractionTypeBlockActions:
 		return app.handleBlockAction(ctx, payload)
+	case slack.InteractionTypeShortcut:
+		return app.handleShortcut(payload)
 	default:
 		log.Printf("[무시] 처리하지 않는 interaction type: %s", payload.Type)
 		return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
@@ -520,6 +839,24 @@ func (app *App) handleViewSubmission(payload slack.InteractionCallback) (events.
 	callbackID := payload.View.CallbackID
 	values := payload.View.State.Values
 
+	// 게시 전 확인(미리보기) 뷰는 작성 내용이 메타데이터에 있으므로 따로 처리
+	if callbackID == CallbackCoolingOff {
+		return app.handleCoolingOffSubmission(payload)
+	}
+	// 빠른 한마디는 입력칸이 하나뿐인 모달
+	if callbackID == CallbackQuickReply {
+		return app.handleQuickReplySubmission(payload)
+	}
+	// 글 수정은 본문 입력칸만 있는 모달
+	if callbackID == CallbackEditPost {
+		return app.handleEditSubmission(payload)
+	}
+
+	// 자동 입력 방지 칸 (HONEYPOT)
+	if app.honeypotFilled(values) {
+		return rejectHoneypot(callbackID)
+	}
+
 	// 메시지 추출
 	message := ""
 	if msgBlock, ok := values[BlockIDMessage]; ok {
@@ -527,9 +864,6 @@ func (app *App) handleViewSubmission(payload slack.InteractionCallback) (events.
 			message = msgInput.Value
 		}
 	}
-	if message == "" {
-		return respondWithError("메시지를 입력해주세요")
-	}
 
 	// 닉네임 추출
 	nickname := ""
@@ -547,6 +881,9 @@ func (app *App) handleViewSubmission(payload slack.InteractionCallback) (events.
 		}
 	}
 
+	// 멘션 끄기 (DISABLE_MENTIONS): 검증 전에 지워 멘션만 적은 글은 빈 메시지로 안내
+	message, mentions = app.applyMentionPolicy(message, mentions)
+
 	// 카테고리 추출 (새 글에서만)
 	category := ""
 	if catBlock, ok := values[BlockIDCategory]; ok {
@@ -557,8 +894,8 @@ func (app *App) handleViewSubmission(payload slack.InteractionCallback) (events.
 		}
 	}
 
-	// 긴급도 추출 (새 글에서만, 기본값: normal)
-	urgency := "normal"
+	// 긴급도 추출 (새 글 기본값: normal, 답글은 고르지 않으면 표시 안 함)
+	urgency := ""
 	if urgBlock, ok := values[BlockIDUrgency]; ok {
 		if urgInput, ok := urgBlock[ActionIDUrgency]; ok {
 			if urgInput.SelectedOption.Value != "" {
@@ -566,6 +903,12 @@ func (app *App) handleViewSubmission(payload slack.InteractionCallback) (events.
 			}
 		}
 	}
+	if callbackID == CallbackNewPost && urgency == "" {
+		urgency = "normal"
+	}
+
+	// 오늘의 기분 (MOOD_TRACKING, 선택)
+	mood := extractMood(values)
 
 	// 체크박스 확인
 	confirmed := false
@@ -574,18 +917,55 @@ func (app *App) handleViewSubmission(payload slack.InteractionCallback) (events.
 			confirmed = len(confirmInput.SelectedOptions) > 0
 		}
 	}
+
+	// 입력 검증 (누락된 항목을 한 번에 모두 표시)
+	errs := map[string]string{}
+	if message == "" {
+		errs[BlockIDMessage] = "메시지를 입력해주세요"
+	}
+	if lengthErr := app.validateMessageLength(message); lengthErr != "" {
+		errs[BlockIDMessage] = lengthErr
+	}
+	if app.containsBannedWord(message) {
+		errs[BlockIDMessage] = bannedWordMessage
+	}
+	if callbackID == CallbackNewPost && category == "" {
+		errs[BlockIDCategory] = "카테고리를 선택해주세요"
+	}
+	if callbackID == CallbackNewPost && category != "" && !app.canPostInCategory(payload.User.ID, category) {
+		errs[BlockIDCategory] = categoryNotAllowedMessage
+	}
+	if callbackID == CallbackNewThread && app.cfg.CategorizeReplies && category == "" {
+		errs[BlockIDCategory] = "답글 종류를 선택해주세요"
+	}
+	nickname, nicknameErr := app.validateNickname(nickname)
+	if nicknameErr != "" {
+		errs[BlockIDName] = nicknameErr
+	}
+	if limit := app.maxMentions(); len(mentions) > limit {
+		errs[BlockIDMention] = fmt.Sprintf("멘션은 최대 %d명까지 선택할 수 있어요", limit)
+	}
 	if !confirmed {
-		return respondWithError("확인 체크박스를 선택해주세요")
+		errs[BlockIDConfirm] = "확인 체크박스를 선택해주세요"
+	}
+	if len(errs) > 0 {
+		return respondWithErrors(errs)
 	}
 
 	switch callbackID {
 	case CallbackNewPost:
-		if category == "" {
-			return respondWithError("카테고리를 선택해주세요")
+		if notice := app.checkRateLimit(context.Background(), payload.User.ID, time.Now()); notice != "" {
+			return respondWithError(notice)
+		}
+		if app.isDuplicatePost(context.Background(), message, time.Now()) {
+			return respondWithError(duplicatePostMessage)
+		}
+		if app.needsCoolingOff(category, urgency) {
+			return app.pushCoolingOffView(payload.User.ID, message, nickname, mentions, category, urgency, mood)
 		}
-		return app.postNewMessage(message, nickname, mentions, category, urgency)
+		return app.postNewMessage(payload.User.ID, message, nickname, mentions, category, urgency, mood)
 	case CallbackNewThread:
-		return app.postThreadReply(payload.View.PrivateMetadata, message, nickname, mentions)
+		return app.postThreadReply(payload.User.ID, payload.View.PrivateMetadata, message, nickname, mentions, category, urgency)
 	default:
 		return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
 	}
@@ -593,32 +973,159 @@ func (app *App) handleViewSubmission(payload slack.InteractionCallback) (events.
 
 // ─────────────────────────────────────
 // 새 메시지 게시
-func (app *App) postNewMessage(message, nickname string, mentions []string, category, urgency string) (events.LambdaFunctionURLResponse, error) {
-	blocks := buildNewPostBlocks(message, nickname, mentions, category, urgency)
+// submitterID는 익명성 검사에만 쓰이며 게시 내용에는 포함되지 않는다
+func (app *App) postNewMessage(submitterID, message, nickname string, mentions []string, category, urgency, mood string) (events.LambdaFunctionURLResponse, error) {
+	fingerprint := contentFingerprint(message)
+	message, mentions = scrubSubmitter(submitterID, message, mentions)
+	blocks := buildNewPostBlocks(message, nickname, mentions, category, urgency, mood)
+	if err := app.checkAnonymity(submitterID, blocks); err != nil {
+		return respondWithError(anonymityErrorMessage)
+	}
 
-	_, _, err := app.slack.PostMessage(
-		TargetChannelID,
-		slack.MsgOptionBlocks(blocks...),
+	if dm, ok := app.dryRunTarget(submitterID); ok {
+		if _, _, err := app.slack.PostMessage(dm, slack.MsgOptionBlocks(withDryRunNotice(blocks)...)); err != nil {
+			log.Printf("[에러] 테스트 모드 미리보기 전송 실패: %v", err)
+			return respondWithError("미리보기 전송에 실패했습니다. 잠시 후 다시 시도해주세요.")
+		}
+		return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+	}
+
+	if app.needsModeration(category, urgency) {
+		review := pendingReview{Category: category, Urgency: urgency, HasNickname: nickname != "", MentionCount: len(mentions), Mood: mood, Fingerprint: fingerprint}
+		return app.holdForReview(context.Background(), submitterID, message, blocks, review)
+	}
+
+	if app.cfg.MultiReactionSelect {
+		blocks = withMultiReactionSelect(blocks)
+	}
+	if app.cfg.QuickReply {
+		blocks = withQuickReplyButton(blocks)
+	}
+	delay := app.postDelay()
+	if app.cfg.ShowTimestamp {
+		blocks = withPostTimestamp(blocks, time.Now().Add(delay))
+	}
+
+	// 카테고리별 게시량 집계를 위해 모든 글을 posts 탭에 기록 (본문과 사용자 ID는 남기지 않음)
+	sentiment := ""
+	if app.cfg.SentimentTagging {
+		sentiment = sentimentLabel(message)
+	}
+
+	// 예약 게시: ts를 알 수 없으므로 posts 탭에는 예약 시각과 빈 ts로 기록하고,
+	// 현황판을 지금 고치면 게시 시각이 다시 드러나므로 현황판 갱신은 하지 않는다
+	fanout := app.urgentFanoutChannels(urgency)
+	if delay > 0 {
+		postAt, err := app.scheduleMessage(app.targetChannel(), delay, slack.MsgOptionBlocks(blocks...))
+		if err != nil {
+			log.Printf("[에러] 메시지 예약 실패: %v", err)
+			return respondWithError("메시지 게시에 실패했습니다. 잠시 후 다시 시도해주세요.")
+		}
+		app.fanOutUrgentPost(fanout, delay, blocks)
+		app.mirrorPost(delay, blocks)
+		app.alertScheduledUrgentPost(context.Background(), app.targetChannel(), category, urgency)
+		app.recordRateLimitPost(context.Background(), submitterID, time.Now())
+		if app.sheets != nil {
+			if err := app.recordPost(context.Background(), postAt, "", category, urgency, nickname != "", len(mentions), mood, fingerprint, sentiment, ""); err != nil {
+				log.Printf("[경고] 게시글 기록 실패: %v", err)
+			}
+		}
+		log.Printf("[성공] 익명 메시지 예약 완료 (post_at=%s, category=%s, urgency=%s)", postAt.Format(time.RFC3339), category, urgency)
+		return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+	}
+
+	// 수정 버튼은 작성자 해시를 기록하는 대상 채널 글에만 단다 (사본은 ts가 달라 수정할 수 없음)
+	postBlocks := blocks
+	if app.cfg.EditGraceMinutes > 0 && app.sheets != nil {
+		postBlocks = withEditButton(postBlocks)
+	}
+	bump := app.bumpAfter() > 0 && app.sheets != nil
+	if bump {
+		postBlocks = withBumpButton(postBlocks)
+	}
+	_, messageTS, err := app.slack.PostMessage(
+		app.targetChannel(),
+		slack.MsgOptionBlocks(postBlocks...),
 	)
 	if err != nil {
 		log.Printf("[에러] 메시지 게시 실패: %v", err)
 		return respondWithError("메시지 게시에 실패했습니다. 잠시 후 다시 시도해주세요.")
 	}
+	// 대상 채널에 올라갔으면 사본 일부가 실패해도 게시 성공으로 처리
+	copies := app.fanOutUrgentPost(fanout, 0, blocks).Posted
+	mirror := map[string]string{}
+	if ts := app.mirrorPost(0, blocks); ts != "" {
+		mirror[app.mirrorChannel()] = ts
+		copies[app.mirrorChannel()] = ts
+	}
+	if app.cfg.EditGraceMinutes > 0 && app.sheets != nil {
+		if err := app.recordEditGrant(context.Background(), submitterID, messageTS, time.Now(), mirror); err != nil {
+			log.Printf("[경고] 수정 권한 기록 실패: %v", err)
+		}
+	}
+	if app.cfg.DeleteTokens && app.sheets != nil {
+		if err := app.issueDeleteToken(context.Background(), submitterID, messageTS, copies); err != nil {
+			log.Printf("[경고] 삭제 토큰 발급 실패: %v", err)
+		}
+	}
+	app.alertUrgentPost(context.Background(), app.targetChannel(), messageTS, category, urgency)
+
+	relay := app.isGratitudeRelayPost(category, time.Now())
+	// 작성자 해시는 다시 올리기와 처리 완료 권한 확인에 함께 쓴다
+	authorCheck := bump || app.restrictComplete()
+	bumpAuthor := ""
+	if authorCheck {
+		bumpAuthor = app.bumpAuthorHash(submitterID, messageTS)
+	}
+	if app.sheets != nil {
+		if err := app.recordPost(context.Background(), time.Now(), messageTS, category, urgency, nickname != "", len(mentions), mood, fingerprint, sentiment, bumpAuthor); err != nil {
+			log.Printf("[경고] 게시글 기록 실패: %v", err)
+		}
+	}
+	if relay {
+		app.relayGratitudePost(messageTS)
+	}
 
+	if app.cfg.Dashboard && app.sheets != nil {
+		if err := app.updateDashboard(context.Background(), app.targetChannel(), category, urgency); err != nil {
+			log.Printf("[경고] 현황판 갱신 실패: %v", err)
+		}
+	}
+
+	app.forwardToWebhook(context.Background(), messageTS, message, category, urgency, time.Now())
+
+	app.recordRateLimitPost(context.Background(), submitterID, time.Now())
 	log.Printf("[성공] 익명 메시지 게시 완료 (nickname=%s, category=%s, urgency=%s)", nickname, category, urgency)
 	return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
 }
 
 // ─────────────────────────────────────
 // 스레드 답글 게시
-func (app *App) postThreadReply(metadata, message, nickname string, mentions []string) (events.LambdaFunctionURLResponse, error) {
+func (app *App) postThreadReply(submitterID, metadata, message, nickname string, mentions []string, category, urgency string) (events.LambdaFunctionURLResponse, error) {
 	parts := strings.Split(metadata, "|")
 	if len(parts) != 2 {
 		return respondWithError("잘못된 요청입니다")
 	}
 	channelID, threadTS := parts[0], parts[1]
 
-	blocks := buildThreadReplyBlocks(message, nickname, mentions)
+	message, mentions = scrubSubmitter(submitterID, message, mentions)
+	blocks := buildThreadReplyBlocks(message, nickname, mentions, category, urgency)
+	if err := app.checkAnonymity(submitterID, blocks); err != nil {
+		return respondWithError(anonymityErrorMessage)
+	}
+
+	// 테스트 모드: DM에는 원래 스레드가 없으므로 단독 메시지로 보낸다
+	if dm, ok := app.dryRunTarget(submitterID); ok {
+		if _, _, err := app.slack.PostMessage(dm, slack.MsgOptionBlocks(withDryRunNotice(blocks)...)); err != nil {
+			log.Printf("[에러] 테스트 모드 미리보기 전송 실패: %v", err)
+			return respondWithError("미리보기 전송에 실패했습니다. 잠시 후 다시 시도해주세요.")
+		}
+		return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+	}
+
+	if app.cfg.QuickReply {
+		blocks = withQuickReplyButton(blocks)
+	}
 
 	_, _, err := app.slack.PostMessage(
 		channelID,
@@ -629,6 +1136,10 @@ func (app *App) postThreadReply(metadata, message, nickname string, mentions []s
 		log.Printf("[에러] 스레드 답글 게시 실패: %v", err)
 		return respondWithError("답글 게시에 실패했습니다. 잠시 후 다시 시도해주세요.")
 	}
+	// 답글은 이미 올라갔으므로 원글 답글 수 갱신 실패는 로그만 남긴다
+	if err := app.refreshReplyCount(context.Background(), channelID, threadTS); err != nil {
+		log.Printf("[경고] 답글 수 갱신 실패 (thread=%s): %v", threadTS, err)
+	}
 
 	log.Printf("[성공] 익명 스레드 답글 게시 완료 (channel=%s, thread=%s)", channelID, threadTS)
 	return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
@@ -647,7 +1158,13 @@ func (app *App) handleBlockAction(ctx context.Context, payload slack.Interaction
 				threadTS = payload.Message.Timestamp
 			}
 
-			modal := buildThreadModal(channelID, threadTS)
+			modal := buildThreadModal(channelID, threadTS, app.cfg.CategorizeReplies)
+			if app.cfg.DisableMentions {
+				modal = withoutMentionBlock(modal)
+			}
+			if app.cfg.Honeypot {
+				modal = withHoneypotBlock(modal)
+			}
 			_, err := app.slack.OpenView(payload.TriggerID, modal)
 			if err != nil {
 				log.Printf("[에러] 스레드 모달 열기 실패: %v", err)
@@ -655,54 +1172,45 @@ func (app *App) handleBlockAction(ctx context.Context, payload slack.Interaction
 			}
 			log.Printf("[성공] 스레드 답글 모달 열기 완료 (channel=%s, thread=%s)", channelID, threadTS)
 
+		case ActionBumpButton:
+			// 반응 없는 글 다시 올리기 (작성자, 한 번만)
+			return app.handleBumpButton(ctx, payload)
+
+		case ActionEditButton:
+			// 게시 직후 본문 수정 (작성자 + 수정 가능 시간 확인)
+			return app.handleEditButton(ctx, payload)
+
+		case ActionQuickReplyButton:
+			// 빠른 한마디 모달 열기
+			channelID := payload.Channel.ID
+			threadTS := payload.Message.ThreadTimestamp
+			if threadTS == "" {
+				threadTS = payload.Message.Timestamp
+			}
+
+			if _, err := app.slack.OpenView(payload.TriggerID, buildQuickReplyModal(channelID, threadTS)); err != nil {
+				log.Printf("[에러] 빠른 한마디 모달 열기 실패: %v", err)
+				return respondWithSlackError("모달을 열 수 없습니다. 잠시 후 다시 시도해주세요.")
+			}
+			log.Printf("[성공] 빠른 한마디 모달 열기 완료 (channel=%s, thread=%s)", channelID, threadTS)
+
 		case ActionCompleteButton:
 			// 처리 완료 표시
 			channelID := payload.Channel.ID
 			messageTS := payload.Message.Timestamp
 			userID := payload.User.ID
 
-			// 기존 블록 수정: 헤더에 처리완료 추가, 버튼 변경
-			var newBlocks []slack.Block
-			for _, block := range payload.Message.Blocks.BlockSet {
-				switch b := block.(type) {
-				case *slack.ContextBlock:
-					// emoji_counts 블록은 그대로 유지
-					if b.BlockID == "emoji_counts" {
-						newBlocks = append(newBlocks, block)
-						continue
-					}
-					// 헤더에 처리완료 표시 추가
-					if len(b.ContextElements.Elements) > 0 {
-						if textObj, ok := b.ContextElements.Elements[0].(*slack.TextBlockObject); ok {
-							newText := textObj.Text + fmt.Sprintf(" │ ✅ 처리됨 (<@%s>)", userID)
-							newBlocks = append(newBlocks, slack.NewContextBlock(
-								"",
-								slack.NewTextBlockObject("mrkdwn", newText, false, false),
-							))
-							continue
-						}
-					}
-					newBlocks = append(newBlocks, block)
-				case *slack.ActionBlock:
-					// emoji_actions 블록은 그대로 유지
-					if b.BlockID == "emoji_actions" {
-						newBlocks = append(newBlocks, block)
-						continue
-					}
-					// 처리완료 버튼 제거, 답글 버튼만 유지
-					newBlocks = append(newBlocks, slack.NewActionBlock(
-						"",
-						slack.NewButtonBlockElement(
-							ActionReplyButton,
-							"reply",
-							slack.NewTextBlockObject("plain_text", "💬 익명 답글 달기", false, false),
-						),
-					))
-				default:
-					newBlocks = append(newBlocks, block)
-				}
+			// 관리자/작성자만 처리 완료 가능 (COMPLETE_ADMIN_USER_IDS)
+			if !app.canComplete(ctx, userID, messageTS) {
+				log.Printf("[스킵] 처리완료 권한 없음 (ts=%s)", messageTS)
+				app.rejectComplete(channelID, userID)
+				return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
 			}
 
+			// 기존 블록 수정: 헤더에 처리완료 추가, 처리완료 버튼을 취소 버튼으로 교체
+			completer := app.completerFor(userID)
+			newBlocks := completedBlocks(payload.Message.Blocks.BlockSet, completer)
+
 			_, _, _, err := app.slack.UpdateMessage(
 				channelID,
 				messageTS,
@@ -712,11 +1220,31 @@ func (app *App) handleBlockAction(ctx context.Context, payload slack.Interaction
 				log.Printf("[에러] 처리완료 업데이트 실패: %v", err)
 				return respondWithSlackError("처리완료 표시에 실패했습니다. 잠시 후 다시 시도해주세요.")
 			}
-			log.Printf("[성공] 처리완료 표시 (channel=%s, ts=%s, by=%s)", channelID, messageTS, userID)
+			log.Printf("[성공] 처리완료 표시 (channel=%s, ts=%s, by=%s)", channelID, messageTS, completer)
+
+			if app.sheets != nil {
+				if err := app.markPostCompleted(ctx, messageTS); err != nil {
+					log.Printf("[경고] 게시글 처리완료 기록 실패: %v", err)
+				}
+			}
 
-		case ActionEmojiThumbsUp, ActionEmojiThumbsDown, ActionEmojiHug, ActionEmojiFlex:
-			// 이모지 리액션 처리
-			return app.handleEmojiReaction(ctx, payload, action.ActionID, action.Value)
+		case ActionUncompleteButton:
+			// 처리 완료 취소 (잘못 누른 경우)
+			return app.handleUncompleteButton(ctx, payload)
+
+		case ActionModerationApprove, ActionModerationReject:
+			// 검토 대기 글 승인/반려 (해당 단계 이상의 검토자)
+			return app.handleModerationAction(ctx, payload, action.ActionID)
+
+		case ActionEmojiMulti:
+			// 여러 이모지 한 번에 선택
+			return app.handleEmojiReactions(ctx, payload, selectedEmojis(action))
+
+		default:
+			// 이모지 리액션 처리 (기본 이모지, REACTION_SET, CUSTOM_REACTION_EMOJIS)
+			if isEmojiButtonAction(action.ActionID) {
+				return app.handleEmojiReaction(ctx, payload, action.ActionID, action.Value)
+			}
 		}
 	}
 
@@ -725,10 +1253,95 @@ func (app *App) handleBlockAction(ctx context.Context, payload slack.Interaction
 
 // ─────────────────────────────────────
 // 이모지 리액션 처리
+// 이모지 버튼은 토글: 이미 남긴 반응을 다시 누르면 그 반응 행을 비워 취소한다.
+//
+// 빠르게 두 번 누르면 두 요청이 모두 첫 번째 기록이 끝나기 전에 조회해 같은 해시가 두 번 추가될 수 있다.
+// 카운트는 행 수가 아니라 해시 종류로 세므로 이런 중복 행이 있어도 한 번으로 보이고,
+// 취소할 때는 같은 해시의 행을 모두 비우므로 다음 클릭 한 번으로 항상 원래 상태로 돌아간다.
+// 추가와 취소가 동시에 들어오면 나중에 끝난 요청의 결과가 남으며, 카운트는 매번 Sheets에서 다시 계산된다.
 func (app *App) handleEmojiReaction(ctx context.Context, payload slack.InteractionCallback, actionID, emoji string) (events.LambdaFunctionURLResponse, error) {
-	// Sheets 서비스가 없으면 무시 (기능 비활성화)
-	if app.sheets == nil {
-		log.Println("[정보] Sheets 서비스 없음, 이모지 리액션 무시")
+	store := app.reactionStore()
+	if store == nil || !isReactionEmoji(emoji) {
+		return app.handleEmojiReactions(ctx, payload, []string{emoji})
+	}
+
+	messageTS := payload.Message.Timestamp
+	if app.inReactionCooldown(payload.User.ID, messageTS, emoji, time.Now()) {
+		return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+	}
+	hash := app.generateReactionHash(payload.User.ID, messageTS, emoji)
+	existing, err := store.CheckDuplicate(ctx, []string{hash})
+	if err != nil {
+		log.Printf("[경고] 기존 반응 조회 실패: %v", err)
+		return app.handleEmojiReactions(ctx, payload, []string{emoji})
+	}
+	if !existing[hash] {
+		return app.handleEmojiReactions(ctx, payload, []string{emoji})
+	}
+
+	// 이미 남긴 반응이면 취소
+	if removed, err := store.Remove(ctx, hash); err != nil || removed == 0 {
+		log.Printf("[에러] 리액션 취소 실패: %v", err)
+		return respondWithSlackError("리액션 취소에 실패했습니다.")
+	}
+	app.countCache.invalidate(messageTS)
+	if err := app.updateEmojiCounts(ctx, payload); err != nil {
+		log.Printf("[에러] 메시지 업데이트 실패: %v", err)
+		return respondWithSlackError("리액션 업데이트에 실패했습니다.")
+	}
+	log.Printf("[성공] 이모지 리액션 취소 (emoji=%s, ts=%s)", emoji, messageTS)
+	return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+}
+
+// 카운트 갱신 최대 시도 횟수 (같은 글에 동시에 반응이 몰릴 때)
+const maxEmojiCountUpdates = 3
+
+// 현재 기록으로 카운트를 다시 계산해 누른 메시지의 카운트 블록 교체
+//
+// 기록 → 조회 → 업데이트가 각각 따로라서, 두 사람이 거의 동시에 누르면 먼저 조회한 쪽의 오래된 카운트가
+// 나중에 업데이트되어 표시가 실제보다 작아질 수 있다. Slack에는 조건부 업데이트가 없으므로
+// 업데이트 직후 Sheets를 다시 읽어 그 사이 카운트가 바뀌었으면 새 값으로 다시 업데이트한다.
+// 마지막으로 다시 읽은 뒤에 추가된 반응은 그 반응을 기록한 요청이 자기 업데이트에서 반영한다.
+func (app *App) updateEmojiCounts(ctx context.Context, payload slack.InteractionCallback) error {
+	counts, err := app.getEmojiCounts(ctx, payload.Message.Timestamp)
+	if err != nil {
+		log.Printf("[경고] 카운트 조회 실패: %v", err)
+	}
+	blocks := payload.Message.Blocks.BlockSet
+	for attempt := 1; ; attempt++ {
+		// 카테고리 반응 기준에 처음 닿으면 헤더 표시(와 처리 완료)도 함께 (REACTION_THRESHOLDS)
+		var th ReactionThreshold
+		var reached bool
+		blocks, th, reached = app.applyReactionThreshold(app.withEmojiCounts(blocks, counts), counts)
+		_, _, _, err = app.slack.UpdateMessage(
+			payload.Channel.ID,
+			payload.Message.Timestamp,
+			slack.MsgOptionBlocks(blocks...),
+		)
+		if err == nil && reached {
+			app.onReactionThreshold(ctx, payload.Message.Timestamp, th)
+		}
+		if err != nil || attempt >= maxEmojiCountUpdates {
+			return err
+		}
+
+		// 다른 요청이 그 사이 남긴 반응을 보려면 캐시가 아니라 저장소를 다시 읽어야 한다
+		app.countCache.invalidate(payload.Message.Timestamp)
+		latest, err := app.getEmojiCounts(ctx, payload.Message.Timestamp)
+		if err != nil || maps.Equal(latest, counts) {
+			return nil
+		}
+		log.Printf("[정보] 갱신 중 반응 카운트 변경, 다시 갱신 (ts=%s, attempt=%d)", payload.Message.Timestamp, attempt)
+		counts = latest
+	}
+}
+
+// 여러 이모지를 한 번에 처리 (기록은 한 번에 추가, 카운트 조회와 메시지 업데이트도 한 번만)
+func (app *App) handleEmojiReactions(ctx context.Context, payload slack.InteractionCallback, emojis []string) (events.LambdaFunctionURLResponse, error) {
+	// 반응 저장소가 없으면 무시 (기능 비활성화)
+	store := app.reactionStore()
+	if store == nil {
+		log.Println("[정보] 반응 저장소 없음, 이모지 리액션 무시")
 		return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
 	}
 
@@ -736,160 +1349,226 @@ func (app *App) handleEmojiReaction(ctx context.Context, payload slack.Interacti
 	messageTS := payload.Message.Timestamp
 	userID := payload.User.ID
 
-	// 중복 체크용 해시 생성
-	hash := generateReactionHash(userID, messageTS, emoji)
-
-	// 중복 체크
-	isDuplicate, err := app.checkDuplicateReaction(ctx, hash)
+	// 이 사람이 이 글에 남길 수 있는 반응 해시 중 이미 기록된 것 (중복 체크 + 사용자별 개수 제한에 함께 사용)
+	existing, err := store.CheckDuplicate(ctx, app.userReactionHashes(userID, messageTS))
 	if err != nil {
 		log.Printf("[경고] 중복 체크 실패: %v", err)
 		// 에러가 나도 진행 (사용자 경험 우선)
+		existing = map[string]bool{}
 	}
+	used := app.userReactionCount(existing, userID, messageTS)
 
-	if isDuplicate {
-		log.Printf("[정보] 중복 리액션 무시 (user=%s, emoji=%s)", userID[:8], emoji)
+	// 새로 남길 반응만 추림 (알 수 없는 이모지, 같은 선택 안의 중복, 이미 남긴 반응 제외)
+	var pending []pendingReaction
+	for _, emoji := range emojis {
+		if !isReactionEmoji(emoji) {
+			continue
+		}
+		hash := app.generateReactionHash(userID, messageTS, emoji)
+		if existing[hash] {
+			log.Printf("[정보] 중복 리액션 무시 (user=%s, emoji=%s)", userID[:min(8, len(userID))], emoji)
+			continue
+		}
+		existing[hash] = true
+		pending = append(pending, pendingReaction{Hash: hash, Emoji: emoji})
+	}
+	if len(pending) == 0 {
 		return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
 	}
 
+	// 사용자당 반응 개수 제한 (남은 개수만큼만 기록)
+	if limit := app.cfg.MaxReactionsPerUser; limit > 0 {
+		remaining := limit - used
+		if remaining < len(pending) {
+			log.Printf("[정보] 반응 개수 제한 초과 (ts=%s, max=%d)", messageTS, limit)
+			_, err := app.slack.PostEphemeral(channelID, userID,
+				slack.MsgOptionText(fmt.Sprintf("이 글에는 이모지 반응을 최대 %d개까지 남길 수 있어요", limit), false))
+			if err != nil {
+				log.Printf("[경고] 반응 제한 안내 전송 실패: %v", err)
+			}
+			if remaining <= 0 {
+				return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+			}
+			pending = pending[:remaining]
+		}
+	}
+
 	// 리액션 기록
-	if err := app.recordReaction(ctx, hash, messageTS, emoji); err != nil {
+	if err := store.Record(ctx, messageTS, pending); err != nil {
 		log.Printf("[에러] 리액션 기록 실패: %v", err)
 		return respondWithSlackError("리액션 저장에 실패했습니다.")
 	}
+	app.countCache.invalidate(messageTS)
 
-	// 새 카운트 조회
-	counts, err := app.getEmojiCounts(ctx, messageTS)
-	if err != nil {
-		log.Printf("[경고] 카운트 조회 실패: %v", err)
-	}
-
-	// 메시지 블록 업데이트
-	var newBlocks []slack.Block
-	for _, block := range payload.Message.Blocks.BlockSet {
-		switch b := block.(type) {
-		case *slack.ContextBlock:
-			if b.BlockID == "emoji_counts" {
-				// 이모지 카운트 업데이트
-				newBlocks = append(newBlocks, slack.NewContextBlock(
-					"emoji_counts",
-					slack.NewTextBlockObject("mrkdwn", formatEmojiCounts(counts), false, false),
-				))
-				continue
-			}
-			newBlocks = append(newBlocks, block)
-		default:
-			newBlocks = append(newBlocks, block)
-		}
-	}
-
-	_, _, _, err = app.slack.UpdateMessage(
-		channelID,
-		messageTS,
-		slack.MsgOptionBlocks(newBlocks...),
-	)
-	if err != nil {
+	// 새 카운트로 메시지 블록 업데이트
+	if err := app.updateEmojiCounts(ctx, payload); err != nil {
 		log.Printf("[에러] 메시지 업데이트 실패: %v", err)
 		return respondWithSlackError("리액션 업데이트에 실패했습니다.")
 	}
 
-	log.Printf("[성공] 이모지 리액션 추가 (emoji=%s, ts=%s)", emoji, messageTS)
+	log.Printf("[성공] 이모지 리액션 추가 (emoji=%d개, ts=%s)", len(pending), messageTS)
 	return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
 }
 
 // ─────────────────────────────────────
 // 이모지 관련 헬퍼 함수
 
-// 익명 해시 생성: hash(userID + messageTS + emoji)
-func generateReactionHash(userID, messageTS, emoji string) string {
-	data := userID + "|" + messageTS + "|" + emoji
-	hash := sha256.Sum256([]byte(data))
-	return hex.EncodeToString(hash[:16]) // 32자 해시
+// 익명 해시 생성: HMAC-SHA256(salt, userID + messageTS + emoji)
+//
+// 사용자 ID는 경우의 수가 적고 메시지 ts는 공개되어 있으므로, 비밀 값 없이 해시하면 시트를 본 사람이
+// 사용자 목록으로 해시를 다시 만들어 누가 반응했는지 알아낼 수 있다. 서버만 아는 salt
+// (REACTION_HASH_SALT, 없으면 서명 시크릿)를 키로 쓰고, 바쁜 채널에서도 충돌하지 않도록 32바이트를 모두 남긴다.
+// salt를 바꾸면 기존 행과 해시가 달라져 이미 남긴 반응의 중복 확인/취소가 되지 않는다 (카운트는 유지).
+func (app *App) generateReactionHash(userID, messageTS, emoji string) string {
+	salt := app.cfg.ReactionHashSalt
+	if salt == "" {
+		salt = app.cfg.SlackSigningSecret
+	}
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(userID + "|" + messageTS + "|" + emoji))
+	return hex.EncodeToString(mac.Sum(nil)) // 64자 해시
 }
 
-// Google Sheets에서 중복 체크 (이미 리액션했는지)
-func (app *App) checkDuplicateReaction(ctx context.Context, hash string) (bool, error) {
-	if app.sheets == nil {
-		return false, fmt.Errorf("Sheets 서비스 없음")
-	}
+// 반응 해시를 만든 salt의 버전 표시 (reactions 탭 E열, salt 자체는 알 수 없는 8자)
+// 떠난 사용자 정리는 지금 salt로 만든 행만 지우므로, salt를 바꾸거나 예전 형식으로 기록된 행은 건드리지 않는다.
+func (app *App) reactionSaltVersion() string {
+	return app.generateReactionHash("", "", "salt-version")[:8]
+}
 
-	// A열에서 해시 검색
-	resp, err := app.sheets.Spreadsheets.Values.Get(app.cfg.SheetsID, "reactions!A:A").Context(ctx).Do()
-	if err != nil {
-		return false, fmt.Errorf("Sheets 조회 실패: %w", err)
-	}
+// 기록할 리액션 (익명 해시 + 이모지)
+type pendingReaction struct {
+	Hash  string
+	Emoji string
+}
 
-	for _, row := range resp.Values {
-		if len(row) > 0 && row[0].(string) == hash {
-			return true, nil // 중복
-		}
+// 사용자가 특정 메시지에 남길 수 있는 반응 해시 (이모지 종류마다 하나)
+func (app *App) userReactionHashes(userID, messageTS string) []string {
+	hashes := make([]string, 0, len(reactionEmojis))
+	for _, emoji := range reactionEmojis {
+		hashes = append(hashes, app.generateReactionHash(userID, messageTS, emoji.Name))
 	}
-	return false, nil
+	return hashes
 }
 
-// Google Sheets에 리액션 기록
-func (app *App) recordReaction(ctx context.Context, hash, messageTS, emoji string) error {
-	if app.sheets == nil {
-		return fmt.Errorf("Sheets 서비스 없음")
+// 사용자가 특정 메시지에 이미 남긴 이모지 종류 수 (이모지별 해시로 조회)
+func (app *App) userReactionCount(hashes map[string]bool, userID, messageTS string) int {
+	count := 0
+	for _, emoji := range reactionEmojis {
+		if hashes[app.generateReactionHash(userID, messageTS, emoji.Name)] {
+			count++
+		}
 	}
+	return count
+}
 
-	values := [][]interface{}{
-		{hash, messageTS, emoji, time.Now().Format(time.RFC3339)},
+func isReactionEmoji(name string) bool {
+	for _, emoji := range reactionEmojis {
+		if emoji.Name == name {
+			return true
+		}
 	}
-
-	_, err := app.sheets.Spreadsheets.Values.Append(
-		app.cfg.SheetsID,
-		"reactions!A:D",
-		&sheets.ValueRange{Values: values},
-	).ValueInputOption("RAW").Context(ctx).Do()
-
-	return err
+	return false
 }
 
-// 특정 메시지의 이모지 카운트 조회
+// 특정 메시지의 이모지 카운트 조회 (같은 사람의 같은 반응이 중복 기록돼도 한 번으로 센다)
 func (app *App) getEmojiCounts(ctx context.Context, messageTS string) (map[string]int, error) {
-	counts := map[string]int{
-		"thumbsup":   0,
-		"thumbsdown": 0,
-		"hug":        0,
-		"flex":       0,
+	counts := map[string]int{}
+	for _, emoji := range reactionEmojis {
+		counts[emoji.Name] = 0
 	}
 
-	if app.sheets == nil {
+	store := app.reactionStore()
+	if store == nil {
 		return counts, nil
 	}
 
-	resp, err := app.sheets.Spreadsheets.Values.Get(app.cfg.SheetsID, "reactions!A:C").Context(ctx).Do()
-	if err != nil {
-		return counts, fmt.Errorf("Sheets 조회 실패: %w", err)
+	recorded, ok := app.countCache.get(messageTS, time.Now())
+	if ok {
+		log.Printf("[디버그] 반응 카운트 캐시 사용 (ts=%s)", messageTS)
+	} else {
+		var err error
+		if recorded, err = store.Counts(ctx, messageTS); err != nil {
+			return counts, err
+		}
+		app.countCache.put(messageTS, recorded, time.Now())
+	}
+	for emoji, n := range recorded {
+		counts[emoji] += n
 	}
+	return counts, nil
+}
 
-	for _, row := range resp.Values {
-		if len(row) >= 3 {
-			ts, ok1 := row[1].(string)
-			emoji, ok2 := row[2].(string)
-			if ok1 && ok2 && ts == messageTS {
-				counts[emoji]++
-			}
+// 메시지 블록의 이모지 카운트(emoji_counts)를 새 카운트로 교체
+func (app *App) withEmojiCounts(blocks []slack.Block, counts map[string]int) []slack.Block {
+	var newBlocks []slack.Block
+	for _, block := range blocks {
+		if b, ok := block.(*slack.ContextBlock); ok && b.BlockID == "emoji_counts" {
+			newBlocks = append(newBlocks, slack.NewContextBlock(
+				"emoji_counts",
+				slack.NewTextBlockObject("mrkdwn", app.formatReactionSummary(counts), false, false),
+			))
+			continue
 		}
+		newBlocks = append(newBlocks, block)
 	}
-
-	return counts, nil
+	return newBlocks
 }
 
 // 이모지 카운트 텍스트 생성
 func formatEmojiCounts(counts map[string]int) string {
-	return fmt.Sprintf("👍 %d │ 👎 %d │ 🤗 %d │ 💪 %d",
-		counts["thumbsup"], counts["thumbsdown"], counts["hug"], counts["flex"])
+	parts := make([]string, 0, len(reactionEmojis))
+	for _, emoji := range reactionEmojis {
+		parts = append(parts, fmt.Sprintf("%s %d", emoji.Icon, counts[emoji.Name]))
+	}
+	return strings.Join(parts, " │ ")
+}
+
+// 가중치를 적용한 반응 점수 (설정에 없는 이모지는 기본 가중치 사용)
+func reactionScore(counts map[string]int, weights map[string]int) int {
+	score := 0
+	for _, emoji := range reactionEmojis {
+		weight := emoji.Weight
+		if w, ok := weights[emoji.Name]; ok {
+			weight = w
+		}
+		score += weight * counts[emoji.Name]
+	}
+	return score
+}
+
+// 카운트 + 반응 점수 (REACTION_WEIGHTS 설정 시에만 점수 표시)
+func (app *App) formatReactionSummary(counts map[string]int) string {
+	summary := formatEmojiCounts(counts)
+	if len(app.cfg.ReactionWeights) > 0 {
+		summary += fmt.Sprintf(" │ 📊 반응 점수 %d", reactionScore(counts, app.cfg.ReactionWeights))
+	}
+	return summary
+}
+
+// 이모지 버튼 목록 생성
+func emojiButtons() []slack.BlockElement {
+	buttons := make([]slack.BlockElement, 0, len(reactionEmojis))
+	for _, emoji := range reactionEmojis {
+		buttons = append(buttons, slack.NewButtonBlockElement(
+			emoji.ActionID,
+			emoji.Name,
+			slack.NewTextBlockObject("plain_text", emoji.Icon, true, false),
+		))
+	}
+	return buttons
 }
 
 // ─────────────────────────────────────
-// 에러 응답 (모달에 에러 표시)
+// 에러 응답 (모달의 메시지 블록에 에러 표시)
 func respondWithError(message string) (events.LambdaFunctionURLResponse, error) {
+	return respondWithErrors(map[string]string{BlockIDMessage: message})
+}
+
+// 여러 블록에 동시에 에러 표시 (blockID → 에러 메시지)
+func respondWithErrors(errs map[string]string) (events.LambdaFunctionURLResponse, error) {
 	response := map[string]interface{}{
 		"response_action": "errors",
-		"errors": map[string]string{
-			BlockIDMessage: message,
-		},
+		"errors":          errs,
 	}
 	body, _ := json.Marshal(response)
 	return events.LambdaFunctionURLResponse{
@@ -955,7 +1634,7 @@ func (app *App) handler(ctx context.Context, event events.LambdaFunctionURLReque
 	// Slash Command인지 Interactive Component인지 구분
 	if strings.Contains(bodyStr, "command=%2Fbamboo") || strings.Contains(bodyStr, "command=/bamboo") {
 		log.Println("[요청] Slash Command 처리")
-		return app.handleSlashCommand(bodyStr)
+		return app.handleSlashCommand(ctx, bodyStr)
 	}
 
 	if strings.Contains(bodyStr, "payload=") {
@@ -975,9 +1654,10 @@ func min(a, b int) int {
 }
 
 // 전역 앱 인스턴스
+// 초기화는 main에서 수행 (테스트 바이너리에서 시크릿 로드가 실행되지 않도록)
 var app *App
 
-func init() {
+func main() {
 	ctx := context.Background()
 	cfg, err := LoadConfigFromSecrets(ctx)
 	if err != nil {
@@ -987,8 +1667,5 @@ func init() {
 	if err != nil {
 		log.Fatalf("[치명적] 앱 초기화 실패: %v", err)
 	}
-}
-
-func main() {
-	lambda.Start(app.handler)
+	lambda.Start(app.invoke)
 }
@@ -0,0 +1,622 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+// 모달 제출 payload 생성 헬퍼
+func viewSubmission(callbackID, metadata string, values map[string]map[string]slack.BlockAction) slack.InteractionCallback {
+	var payload slack.InteractionCallback
+	payload.Type = slack.InteractionTypeViewSubmission
+	payload.View.CallbackID = callbackID
+	payload.View.PrivateMetadata = metadata
+	payload.View.State = &slack.ViewState{Values: values}
+	return payload
+}
+
+// response_action: errors 응답에서 blockID → 에러 메시지 추출
+func responseErrors(t *testing.T, body string) map[string]string {
+	t.Helper()
+	var resp struct {
+		ResponseAction string            `json:"response_action"`
+		Errors         map[string]string `json:"errors"`
+	}
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("응답 파싱 실패: %v (body=%s)", err, body)
+	}
+	if resp.ResponseAction != "errors" {
+		t.Fatalf("response_action = %q, want errors", resp.ResponseAction)
+	}
+	return resp.Errors
+}
+
+func TestRespondWithErrors(t *testing.T) {
+	resp, _ := respondWithErrors(map[string]string{
+		BlockIDCategory: "카테고리를 선택해주세요",
+		BlockIDConfirm:  "확인 체크박스를 선택해주세요",
+	})
+	errs := responseErrors(t, resp.Body)
+	if len(errs) != 2 || errs[BlockIDCategory] == "" || errs[BlockIDConfirm] == "" {
+		t.Errorf("errors = %v, want category+confirm", errs)
+	}
+}
+
+func TestRespondWithErrorTargetsMessageBlock(t *testing.T) {
+	resp, _ := respondWithError("메시지를 입력해주세요")
+	errs := responseErrors(t, resp.Body)
+	if len(errs) != 1 || errs[BlockIDMessage] != "메시지를 입력해주세요" {
+		t.Errorf("errors = %v", errs)
+	}
+}
+
+func TestHandleViewSubmissionShowsAllMissingFields(t *testing.T) {
+	app := &App{cfg: &Config{}}
+	payload := viewSubmission(CallbackNewPost, "", map[string]map[string]slack.BlockAction{
+		BlockIDMessage: {ActionIDMessage: {Value: "안녕하세요"}},
+	})
+
+	resp, _ := app.handleViewSubmission(payload)
+	errs := responseErrors(t, resp.Body)
+	if _, ok := errs[BlockIDCategory]; !ok {
+		t.Errorf("카테고리 에러 누락: %v", errs)
+	}
+	if _, ok := errs[BlockIDConfirm]; !ok {
+		t.Errorf("확인 체크박스 에러 누락: %v", errs)
+	}
+	if _, ok := errs[BlockIDMessage]; ok {
+		t.Errorf("입력된 메시지에 에러 표시됨: %v", errs)
+	}
+}
+
+func TestHandleViewSubmissionThreadSkipsCategory(t *testing.T) {
+	app := &App{cfg: &Config{}}
+	payload := viewSubmission(CallbackNewThread, "C1|1.0", map[string]map[string]slack.BlockAction{})
+
+	resp, _ := app.handleViewSubmission(payload)
+	errs := responseErrors(t, resp.Body)
+	if _, ok := errs[BlockIDCategory]; ok {
+		t.Errorf("스레드 답글에 카테고리 에러 표시됨: %v", errs)
+	}
+	if len(errs) != 2 {
+		t.Errorf("errors = %v, want message+confirm", errs)
+	}
+}
+
+// 이모지 버튼 클릭 payload 생성 헬퍼
+// salt 없는 테스트 App이 기록하는 반응 해시
+func reactionHashFor(userID, messageTS, emoji string) string {
+	return (&App{cfg: &Config{}}).generateReactionHash(userID, messageTS, emoji)
+}
+
+func editHashFor(userID, messageTS string) string {
+	return (&App{cfg: &Config{}}).editAuthorHash(userID, messageTS)
+}
+
+func bumpHashFor(userID, messageTS string) string {
+	return (&App{cfg: &Config{}}).bumpAuthorHash(userID, messageTS)
+}
+
+func emojiClick(channelID, messageTS, userID string, blocks ...slack.Block) slack.InteractionCallback {
+	var payload slack.InteractionCallback
+	payload.Type = slack.InteractionTypeBlockActions
+	payload.Channel.ID = channelID
+	payload.Message.Timestamp = messageTS
+	payload.User.ID = userID
+	payload.Message.Blocks = slack.Blocks{BlockSet: blocks}
+	return payload
+}
+
+func TestHandleEmojiReactionUnderCap(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	sh.seed("reactions", []string{reactionHashFor("U1", "1.0", "thumbsup"), "1.0", "thumbsup", "t"})
+	app := &App{cfg: &Config{SheetsID: "sheet", MaxReactionsPerUser: 2}, slack: client, sheets: svc}
+
+	app.handleEmojiReaction(context.Background(), emojiClick("C1", "1.0", "U1"), ActionEmojiHug, "hug")
+
+	if got := len(sh.rows("reactions")); got != 2 {
+		t.Errorf("reactions 행 수 = %d, want 2", got)
+	}
+	if n := len(fs.callsTo("chat.postEphemeral")); n != 0 {
+		t.Errorf("제한 미만인데 안내 메시지 전송됨 (%d회)", n)
+	}
+}
+
+func TestHandleEmojiReactionOverCap(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	sh.seed("reactions",
+		[]string{reactionHashFor("U1", "1.0", "thumbsup"), "1.0", "thumbsup", "t"},
+		[]string{reactionHashFor("U1", "1.0", "hug"), "1.0", "hug", "t"},
+		[]string{reactionHashFor("U2", "1.0", "flex"), "1.0", "flex", "t"},
+	)
+	app := &App{cfg: &Config{SheetsID: "sheet", MaxReactionsPerUser: 2}, slack: client, sheets: svc}
+
+	app.handleEmojiReaction(context.Background(), emojiClick("C1", "1.0", "U1"), ActionEmojiFlex, "flex")
+
+	if got := len(sh.rows("reactions")); got != 3 {
+		t.Errorf("제한 초과 반응이 기록됨: 행 수 = %d, want 3", got)
+	}
+	if n := len(fs.callsTo("chat.postEphemeral")); n != 1 {
+		t.Errorf("안내 메시지 전송 수 = %d, want 1", n)
+	}
+	if n := len(fs.callsTo("chat.update")); n != 0 {
+		t.Errorf("제한 초과인데 메시지 업데이트됨 (%d회)", n)
+	}
+}
+
+func TestHandleEmojiReactionsMultiSelect(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	sh.seed("reactions", []string{reactionHashFor("U1", "1.0", "thumbsup"), "1.0", "thumbsup", "t"})
+	app := &App{cfg: &Config{SheetsID: "sheet", MultiReactionSelect: true}, slack: client, sheets: svc}
+
+	counts := slack.NewContextBlock("emoji_counts", slack.NewTextBlockObject("mrkdwn", formatEmojiCounts(nil), false, false))
+	payload := emojiClick("C1", "1.0", "U1", counts)
+	payload.ActionCallback.BlockActions = []*slack.BlockAction{{
+		ActionID: ActionEmojiMulti,
+		// 이미 남긴 👍는 중복으로 건너뛰고, 같은 선택 안의 중복도 한 번만 기록
+		SelectedOptions: []slack.OptionBlockObject{{Value: "thumbsup"}, {Value: "hug"}, {Value: "flex"}, {Value: "hug"}},
+	}}
+
+	if _, err := app.handleBlockAction(context.Background(), payload); err != nil {
+		t.Fatalf("handleBlockAction: %v", err)
+	}
+
+	rows := sh.rows("reactions")
+	if len(rows) != 3 || rows[1][2] != "hug" || rows[2][2] != "flex" {
+		t.Fatalf("reactions 탭 = %v, want thumbsup+hug+flex", rows)
+	}
+	updates := fs.callsTo("chat.update")
+	if len(updates) != 1 {
+		t.Fatalf("chat.update 호출 수 = %d, want 1", len(updates))
+	}
+	if text := blocksText(t, updates[0].Form.Get("blocks")); !strings.Contains(text, "👍 1 │ 👎 0 │ 🤗 1 │ 💪 1") {
+		t.Errorf("카운트 = %q", text)
+	}
+}
+
+func TestHandleEmojiReactionsMultiSelectRespectsCap(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	sh.seed("reactions", []string{reactionHashFor("U1", "1.0", "thumbsup"), "1.0", "thumbsup", "t"})
+	app := &App{cfg: &Config{SheetsID: "sheet", MaxReactionsPerUser: 2}, slack: client, sheets: svc}
+
+	app.handleEmojiReactions(context.Background(), emojiClick("C1", "1.0", "U1"), []string{"hug", "flex"})
+
+	if got := len(sh.rows("reactions")); got != 2 {
+		t.Errorf("reactions 행 수 = %d, want 2 (남은 1개만 기록)", got)
+	}
+	if n := len(fs.callsTo("chat.postEphemeral")); n != 1 {
+		t.Errorf("안내 메시지 전송 수 = %d, want 1", n)
+	}
+}
+
+func TestWithMultiReactionSelect(t *testing.T) {
+	blocks := withMultiReactionSelect(buildNewPostBlocks("본문", "", nil, "other", "low", ""))
+	for _, block := range blocks {
+		b, ok := block.(*slack.ActionBlock)
+		if !ok || b.BlockID != "emoji_actions" {
+			continue
+		}
+		elements := b.Elements.ElementSet
+		if len(elements) != len(reactionEmojis)+1 {
+			t.Fatalf("emoji_actions 요소 수 = %d, want %d", len(elements), len(reactionEmojis)+1)
+		}
+		if m, ok := elements[len(elements)-1].(*slack.MultiSelectBlockElement); !ok || m.ActionID != ActionEmojiMulti {
+			t.Errorf("마지막 요소 = %#v, want 다중 선택 메뉴", elements[len(elements)-1])
+		}
+		return
+	}
+	t.Fatal("emoji_actions 블록 없음")
+}
+
+// 멘션 인원만 다른 새 글 제출 payload
+func mentionSubmission(users ...string) slack.InteractionCallback {
+	payload := viewSubmission(CallbackNewPost, "", map[string]map[string]slack.BlockAction{
+		BlockIDMessage:  {ActionIDMessage: {Value: "멘션 테스트"}},
+		BlockIDMention:  {ActionIDMention: {SelectedUsers: users}},
+		BlockIDCategory: {ActionIDCategory: {SelectedOption: slack.OptionBlockObject{Value: "question"}}},
+		BlockIDConfirm:  {ActionIDConfirm: {SelectedOptions: []slack.OptionBlockObject{{Value: "confirm"}}}},
+	})
+	payload.User.ID = "U0"
+	return payload
+}
+
+func TestHandleViewSubmissionMentionLimit(t *testing.T) {
+	tests := []struct {
+		name      string
+		max       int
+		users     []string
+		wantError bool
+	}{
+		{"under limit", 3, []string{"U1", "U2"}, false},
+		{"at limit", 3, []string{"U1", "U2", "U3"}, false},
+		{"over limit", 3, []string{"U1", "U2", "U3", "U4"}, true},
+		{"default limit", 0, []string{"U1", "U2", "U3", "U4", "U5", "U6"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: &Config{MaxMentions: tt.max}, slack: client}
+
+			resp, _ := app.handleViewSubmission(mentionSubmission(tt.users...))
+
+			posts := len(fs.callsTo("chat.postMessage"))
+			if !tt.wantError {
+				if posts != 1 {
+					t.Errorf("chat.postMessage 호출 수 = %d, want 1 (body=%s)", posts, resp.Body)
+				}
+				return
+			}
+			errs := responseErrors(t, resp.Body)
+			if !strings.Contains(errs[BlockIDMention], "최대") {
+				t.Errorf("멘션 블록 에러 = %v", errs)
+			}
+			if posts != 0 {
+				t.Errorf("제한 초과인데 게시됨 (%d회)", posts)
+			}
+		})
+	}
+}
+
+// 스레드 답글 제출 payload
+func replySubmission(category string) slack.InteractionCallback {
+	values := map[string]map[string]slack.BlockAction{
+		BlockIDMessage: {ActionIDMessage: {Value: "저도 같은 생각이에요"}},
+		BlockIDConfirm: {ActionIDConfirm: {SelectedOptions: []slack.OptionBlockObject{{Value: "confirmed"}}}},
+	}
+	if category != "" {
+		values[BlockIDCategory] = map[string]slack.BlockAction{ActionIDCategory: {SelectedOption: slack.OptionBlockObject{Value: category}}}
+	}
+	payload := viewSubmission(CallbackNewThread, "C1|1.0", values)
+	payload.User.ID = "U0"
+	return payload
+}
+
+func TestCategorizedReplies(t *testing.T) {
+	tests := []struct {
+		name       string
+		categorize bool
+		category   string
+		wantError  bool
+		wantHeader string
+	}{
+		{"uncategorized", false, "", false, "🎋 *익명*"},
+		{"categorized", true, "opinion", false, "🎋 *익명* │ ➕ 추가 의견"},
+		{"category missing", true, "", true, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: &Config{CategorizeReplies: tt.categorize}, slack: client}
+
+			resp, _ := app.handleViewSubmission(replySubmission(tt.category))
+
+			posts := fs.callsTo("chat.postMessage")
+			if tt.wantError {
+				if errs := responseErrors(t, resp.Body); errs[BlockIDCategory] == "" {
+					t.Errorf("답글 종류 에러 누락: %v", errs)
+				}
+				if len(posts) != 0 {
+					t.Errorf("검증 실패인데 게시됨 (%d회)", len(posts))
+				}
+				return
+			}
+			if len(posts) != 1 {
+				t.Fatalf("chat.postMessage 호출 수 = %d, want 1 (body=%s)", len(posts), resp.Body)
+			}
+			header := strings.SplitN(blocksText(t, posts[0].Form.Get("blocks")), "\n", 2)[0]
+			if header != tt.wantHeader {
+				t.Errorf("헤더 = %q, want %q", header, tt.wantHeader)
+			}
+		})
+	}
+}
+
+func TestBuildThreadModalCategorySelect(t *testing.T) {
+	hasCategory := func(m slack.ModalViewRequest) bool {
+		for _, b := range m.Blocks.BlockSet {
+			if in, ok := b.(*slack.InputBlock); ok && in.BlockID == BlockIDCategory {
+				return true
+			}
+		}
+		return false
+	}
+	if hasCategory(buildThreadModal("C1", "1.0", false)) {
+		t.Error("CATEGORIZE_REPLIES 꺼짐인데 답글 종류 선택이 있음")
+	}
+	if !hasCategory(buildThreadModal("C1", "1.0", true)) {
+		t.Error("CATEGORIZE_REPLIES 켜짐인데 답글 종류 선택이 없음")
+	}
+}
+
+func TestReplyUrgency(t *testing.T) {
+	tests := []struct {
+		name       string
+		categorize bool
+		category   string
+		urgency    string
+		wantHeader string
+	}{
+		{"no urgency selected", false, "", "", "🎋 *익명*"},
+		{"urgent follow-up", false, "", "urgent", "🎋 *익명* │ 🔴 긴급"},
+		{"with reply category", true, "followup", "low", "🎋 *익명* │ ❓ 추가 질문 │ 🟢 여유"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: &Config{CategorizeReplies: tt.categorize}, slack: client}
+
+			payload := replySubmission(tt.category)
+			if tt.urgency != "" {
+				payload.View.State.Values[BlockIDUrgency] = map[string]slack.BlockAction{ActionIDUrgency: {SelectedOption: slack.OptionBlockObject{Value: tt.urgency}}}
+			}
+			resp, _ := app.handleViewSubmission(payload)
+
+			posts := fs.callsTo("chat.postMessage")
+			if len(posts) != 1 {
+				t.Fatalf("chat.postMessage 호출 수 = %d, want 1 (body=%s)", len(posts), resp.Body)
+			}
+			header := strings.SplitN(blocksText(t, posts[0].Form.Get("blocks")), "\n", 2)[0]
+			if header != tt.wantHeader {
+				t.Errorf("헤더 = %q, want %q", header, tt.wantHeader)
+			}
+		})
+	}
+}
+
+func TestBuildThreadModalUrgencyOptional(t *testing.T) {
+	for _, b := range buildThreadModal("C1", "1.0", false).Blocks.BlockSet {
+		if in, ok := b.(*slack.InputBlock); ok && in.BlockID == BlockIDUrgency {
+			if !in.Optional {
+				t.Error("답글 긴급도 선택이 필수로 되어 있음")
+			}
+			return
+		}
+	}
+	t.Error("답글 모달에 긴급도 선택이 없음")
+}
+
+func TestHandleEmojiReactionToggle(t *testing.T) {
+	tests := []struct {
+		name       string
+		seed       [][]string
+		wantRows   int
+		wantCounts string
+	}{
+		{
+			"first click adds",
+			nil,
+			1, "👍 1 │ 👎 0 │ 🤗 0 │ 💪 0",
+		},
+		{
+			"second click removes",
+			[][]string{{reactionHashFor("U1", "1.0", "thumbsup"), "1.0", "thumbsup", "t"}},
+			0, "👍 0 │ 👎 0 │ 🤗 0 │ 💪 0",
+		},
+		{
+			"duplicate rows from rapid clicks are all removed",
+			[][]string{
+				{reactionHashFor("U1", "1.0", "thumbsup"), "1.0", "thumbsup", "t"},
+				{reactionHashFor("U2", "1.0", "thumbsup"), "1.0", "thumbsup", "t"},
+				{reactionHashFor("U1", "1.0", "thumbsup"), "1.0", "thumbsup", "t"},
+			},
+			1, "👍 1 │ 👎 0 │ 🤗 0 │ 💪 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			sh, svc := newFakeSheets(t)
+			sh.seed("reactions", tt.seed...)
+			app := &App{cfg: &Config{SheetsID: "sheet"}, slack: client, sheets: svc}
+
+			counts := slack.NewContextBlock("emoji_counts", slack.NewTextBlockObject("mrkdwn", formatEmojiCounts(nil), false, false))
+			app.handleEmojiReaction(context.Background(), emojiClick("C1", "1.0", "U1", counts), ActionEmojiThumbsUp, "thumbsup")
+
+			if got := len(sh.rows("reactions")); got != tt.wantRows {
+				t.Errorf("reactions 행 수 = %d, want %d", got, tt.wantRows)
+			}
+			updates := fs.callsTo("chat.update")
+			if len(updates) != 1 {
+				t.Fatalf("chat.update 호출 수 = %d, want 1", len(updates))
+			}
+			if text := blocksText(t, updates[0].Form.Get("blocks")); !strings.Contains(text, tt.wantCounts) {
+				t.Errorf("카운트 = %q, want %q", text, tt.wantCounts)
+			}
+		})
+	}
+}
+
+func TestGetEmojiCountsIgnoresDuplicateRows(t *testing.T) {
+	sh, svc := newFakeSheets(t)
+	hash := reactionHashFor("U1", "1.0", "hug")
+	sh.seed("reactions",
+		[]string{hash, "1.0", "hug", "t"},
+		[]string{hash, "1.0", "hug", "t"},
+		[]string{reactionHashFor("U2", "1.0", "hug"), "1.0", "hug", "t"},
+	)
+	app := &App{cfg: &Config{SheetsID: "sheet"}, sheets: svc}
+
+	counts, err := app.getEmojiCounts(context.Background(), "1.0")
+	if err != nil {
+		t.Fatalf("getEmojiCounts: %v", err)
+	}
+	if counts["hug"] != 2 {
+		t.Errorf("hug = %d, want 2 (중복 행은 한 번으로)", counts["hug"])
+	}
+}
+
+func TestEmojiCountsRecheckedAfterConcurrentReaction(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	app := &App{cfg: &Config{SheetsID: "sheet"}, slack: client, sheets: svc}
+
+	// 첫 번째 업데이트가 처리되는 사이 다른 사람의 반응이 기록된다
+	var once sync.Once
+	fs.onCall = func(method string) {
+		if method == "chat.update" {
+			once.Do(func() {
+				sh.seed("reactions", []string{reactionHashFor("U2", "1.0", "thumbsup"), "1.0", "thumbsup", "t"})
+			})
+		}
+	}
+
+	counts := slack.NewContextBlock("emoji_counts", slack.NewTextBlockObject("mrkdwn", formatEmojiCounts(nil), false, false))
+	app.handleEmojiReaction(context.Background(), emojiClick("C1", "1.0", "U1", counts), ActionEmojiThumbsUp, "thumbsup")
+
+	updates := fs.callsTo("chat.update")
+	if len(updates) != 2 {
+		t.Fatalf("chat.update 호출 수 = %d, want 2 (변경 감지 후 재갱신)", len(updates))
+	}
+	if text := blocksText(t, updates[1].Form.Get("blocks")); !strings.Contains(text, "👍 2") {
+		t.Errorf("최종 카운트 = %q, want 👍 2", text)
+	}
+}
+
+func TestEmojiCountsConcurrentClicks(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	_, svc := newFakeSheets(t)
+	app := &App{cfg: &Config{SheetsID: "sheet"}, slack: client, sheets: svc}
+
+	counts := slack.NewContextBlock("emoji_counts", slack.NewTextBlockObject("mrkdwn", formatEmojiCounts(nil), false, false))
+	users := []string{"U1", "U2", "U3"}
+	var wg sync.WaitGroup
+	for _, user := range users {
+		wg.Add(1)
+		go func(user string) {
+			defer wg.Done()
+			app.handleEmojiReaction(context.Background(), emojiClick("C1", "1.0", user, counts), ActionEmojiHug, "hug")
+		}(user)
+	}
+	wg.Wait()
+
+	// 마지막으로 반영된 업데이트가 실제 기록 수와 같아야 한다
+	updates := fs.callsTo("chat.update")
+	if len(updates) == 0 {
+		t.Fatal("chat.update 호출 없음")
+	}
+	if text := blocksText(t, updates[len(updates)-1].Form.Get("blocks")); !strings.Contains(text, "🤗 3") {
+		t.Errorf("최종 카운트 = %q, want 🤗 3", text)
+	}
+}
+
+func TestNewAppTargetChannel(t *testing.T) {
+	tests := []struct {
+		name    string
+		channel string
+		want    string
+		wantErr bool
+	}{
+		{"falls back to default", "", DefaultTargetChannelID, false},
+		{"configured channel", " C0123ABCD ", "C0123ABCD", false},
+		{"private channel id", "G0123ABCD", "G0123ABCD", false},
+		{"invalid id", "#bamboo", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, _ := newFakeSlack(t)
+			slackOptions = []slack.Option{slack.OptionAPIURL(fs.url)}
+			t.Cleanup(func() { slackOptions = nil })
+
+			app, err := NewApp(context.Background(), &Config{SlackBotToken: "xoxb-test", SlackSigningSecret: "secret", BambooChannelID: tt.channel})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("잘못된 채널 ID인데 에러 없음")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewApp: %v", err)
+			}
+			if got := app.targetChannel(); got != tt.want {
+				t.Errorf("targetChannel = %q, want %q", got, tt.want)
+			}
+
+			app.handleViewSubmission(viewSubmission(CallbackNewPost, "", newPostValues("question", "normal")))
+			posts := fs.callsTo("chat.postMessage")
+			if len(posts) != 1 || posts[0].Form.Get("channel") != tt.want {
+				t.Errorf("게시 채널 = %v, want %q", posts, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateReactionHashSalted(t *testing.T) {
+	a := &App{cfg: &Config{ReactionHashSalt: "salt-a"}}
+	b := &App{cfg: &Config{ReactionHashSalt: "salt-b"}}
+	fallback := &App{cfg: &Config{SlackSigningSecret: "salt-a"}}
+
+	hash := a.generateReactionHash("U1", "1.0", "thumbsup")
+	if len(hash) != 64 {
+		t.Errorf("해시 길이 = %d, want 64 (32바이트 전체)", len(hash))
+	}
+	unsalted := sha256.Sum256([]byte("U1|1.0|thumbsup"))
+	if hash == hex.EncodeToString(unsalted[:]) {
+		t.Error("salt 없이 만든 해시와 같음")
+	}
+	if hash == b.generateReactionHash("U1", "1.0", "thumbsup") {
+		t.Error("salt가 달라도 해시가 같음")
+	}
+	if hash != fallback.generateReactionHash("U1", "1.0", "thumbsup") {
+		t.Error("REACTION_HASH_SALT가 없으면 서명 시크릿을 salt로 써야 함")
+	}
+	if hash == a.generateReactionHash("U2", "1.0", "thumbsup") {
+		t.Error("사용자가 달라도 해시가 같음")
+	}
+}
+
+func TestSlashCommandPrefillsCategory(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"category key", "question", "question"},
+		{"case and spaces", "  Concern ", "concern"},
+		{"unknown value", "hello", ""},
+		{"empty", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: &Config{}, slack: client}
+
+			body := url.Values{"command": {"/bamboo"}, "user_id": {"U1"}, "trigger_id": {"T1"}, "text": {tt.text}}.Encode()
+			if resp, _ := app.handleSlashCommand(context.Background(), body); resp.StatusCode != 200 || resp.Body != "" {
+				t.Fatalf("응답 = %d %s", resp.StatusCode, resp.Body)
+			}
+			opens := fs.callsTo("views.open")
+			if len(opens) != 1 {
+				t.Fatalf("views.open 호출 수 = %d, want 1", len(opens))
+			}
+			var req struct {
+				View slack.ModalViewRequest `json:"view"`
+			}
+			if err := json.Unmarshal([]byte(opens[0].Body), &req); err != nil {
+				t.Fatalf("views.open 본문 파싱 실패: %v", err)
+			}
+			got := ""
+			for _, block := range req.View.Blocks.BlockSet {
+				if b, ok := block.(*slack.InputBlock); ok && b.BlockID == BlockIDCategory {
+					if sel := b.Element.(*slack.SelectBlockElement); sel.InitialOption != nil {
+						got = sel.InitialOption.Value
+					}
+				}
+			}
+			if got != tt.want {
+				t.Errorf("미리 선택된 카테고리 = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 멘션 끄기 (DISABLE_MENTIONS, opt-in)
+// 익명 글이 특정 사람을 부르는 것 자체를 막고 싶은 팀을 위한 설정.
+// 새 글/답글 모달에서 멘션 입력칸을 빼고, 본문에 직접 적은 멘션 문법(<@U…>, <!subteam^…>, @here 등)도 지운다.
+
+var mentionTokenRegex = regexp.MustCompile(`<@[UW][A-Z0-9]+(\|[^>]*)?>|<!subteam\^[^>]*>`)
+
+// 멘션 입력칸을 뺀 모달
+func withoutMentionBlock(modal slack.ModalViewRequest) slack.ModalViewRequest {
+	blocks := make([]slack.Block, 0, len(modal.Blocks.BlockSet))
+	for _, block := range modal.Blocks.BlockSet {
+		if input, ok := block.(*slack.InputBlock); ok && input.BlockID == BlockIDMention {
+			continue
+		}
+		blocks = append(blocks, block)
+	}
+	modal.Blocks.BlockSet = blocks
+	return modal
+}
+
+// 본문의 사용자/그룹 멘션과 전체 알림 토큰을 지운 텍스트 (줄 구성은 유지)
+func stripMentions(message string) string {
+	stripped := broadcastTokenRegex.ReplaceAllString(mentionTokenRegex.ReplaceAllString(message, ""), "")
+	lines := strings.Split(stripped, "\n")
+	for i, line := range lines {
+		lines[i] = strings.Join(strings.Fields(line), " ")
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// DISABLE_MENTIONS일 때 멘션 목록을 비우고 본문의 멘션 문법을 지움
+func (app *App) applyMentionPolicy(message string, mentions []string) (string, []string) {
+	if !app.cfg.DisableMentions {
+		return message, mentions
+	}
+	stripped := stripMentions(message)
+	if stripped != strings.TrimSpace(message) || len(mentions) > 0 {
+		log.Println("[정보] 멘션 비활성화 설정으로 본문/선택한 멘션 제거")
+	}
+	return stripped, nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+func TestStripMentions(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"<@U123> 확인 부탁드려요", "확인 부탁드려요"},
+		{"<@U123|kim> 님과 <@W456> 님", "님과 님"},
+		{"<!subteam^S123|@dev> 모두 <!here> 봐주세요 @channel", "모두 봐주세요"},
+		{"첫 줄 <@U1>\n\n둘째 줄", "첫 줄\n\n둘째 줄"},
+		{"이메일 me@example.com 은 그대로", "이메일 me@example.com 은 그대로"},
+		{"<@U1>", ""},
+	}
+	for _, tt := range tests {
+		if got := stripMentions(tt.in); got != tt.want {
+			t.Errorf("stripMentions(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestWithoutMentionBlock(t *testing.T) {
+	modals := map[string]slack.ModalViewRequest{
+		"new post": buildNewPostModal(true),
+		"thread":   buildThreadModal("C1", "1.0", true),
+	}
+	for name, modal := range modals {
+		t.Run(name, func(t *testing.T) {
+			before := len(modal.Blocks.BlockSet)
+			got := withoutMentionBlock(modal)
+			if len(got.Blocks.BlockSet) != before-1 {
+				t.Errorf("블록 수 = %d, want %d", len(got.Blocks.BlockSet), before-1)
+			}
+			for _, block := range got.Blocks.BlockSet {
+				if input, ok := block.(*slack.InputBlock); ok && input.BlockID == BlockIDMention {
+					t.Error("멘션 입력칸이 남아 있음")
+				}
+			}
+		})
+	}
+}
+
+func TestDisableMentionsSubmission(t *testing.T) {
+	tests := []struct {
+		name    string
+		disable bool
+		want    bool // 게시된 글에 멘션이 남는지
+	}{
+		{"enabled", false, true},
+		{"disabled", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: &Config{DisableMentions: tt.disable}, slack: client}
+
+			payload := mentionSubmission("U1", "U2")
+			payload.View.State.Values[BlockIDMessage] = map[string]slack.BlockAction{ActionIDMessage: {Value: "<@U3> <!here> 회의록 공유해 주세요"}}
+			resp, _ := app.handleViewSubmission(payload)
+
+			posts := fs.callsTo("chat.postMessage")
+			if len(posts) != 1 {
+				t.Fatalf("chat.postMessage 호출 수 = %d, want 1 (body=%s)", len(posts), resp.Body)
+			}
+			blocks := posts[0].Form.Get("blocks")
+			if got := strings.Contains(blocks, "\\u003c@") || strings.Contains(blocks, "\\u003c!here"); got != tt.want {
+				t.Errorf("멘션 포함 = %v, want %v (blocks=%s)", got, tt.want, blocks)
+			}
+			if !strings.Contains(blocks, "회의록 공유해 주세요") {
+				t.Errorf("본문이 사라짐 (blocks=%s)", blocks)
+			}
+		})
+	}
+}
+
+func TestDisableMentionsOnlyMentionBody(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{DisableMentions: true}, slack: client}
+
+	payload := mentionSubmission()
+	payload.View.State.Values[BlockIDMessage] = map[string]slack.BlockAction{ActionIDMessage: {Value: "<@U3>"}}
+	resp, _ := app.handleViewSubmission(payload)
+
+	if errs := responseErrors(t, resp.Body); errs[BlockIDMessage] == "" {
+		t.Errorf("멘션만 적은 글에 메시지 에러가 없음: %v", errs)
+	}
+	if n := len(fs.callsTo("chat.postMessage")); n != 0 {
+		t.Errorf("chat.postMessage 호출 수 = %d, want 0", n)
+	}
+}
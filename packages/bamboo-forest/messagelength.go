@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// ─────────────────────────────────────
+// 본문 길이 제한 (MAX_MESSAGE_LENGTH)
+// 아주 긴 글은 Slack 섹션 블록 한도(3000자)에 걸려 게시가 깨지고 Sheets 행도 읽기 어려워진다.
+// 제출할 때 글자 수(바이트가 아닌 문자 수)를 세어 넘으면 모달에 에러를 표시해, 쓴 내용을 잃지 않고 줄일 수 있게 한다.
+
+const defaultMaxMessageLength = 2000
+
+func (app *App) maxMessageLength() int {
+	if app.cfg.MaxMessageLength > 0 {
+		return app.cfg.MaxMessageLength
+	}
+	return defaultMaxMessageLength
+}
+
+// 본문 블록에 표시할 에러 (문제 없으면 "")
+func (app *App) validateMessageLength(message string) string {
+	limit := app.maxMessageLength()
+	if n := utf8.RuneCountInString(message); n > limit {
+		return fmt.Sprintf("메시지는 %d자까지 쓸 수 있어요 (지금 %d자)", limit, n)
+	}
+	return ""
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+func TestMessageLengthLimit(t *testing.T) {
+	tests := []struct {
+		name      string
+		max       int
+		message   string
+		wantError string
+	}{
+		// 한글은 글자당 3바이트라 바이트로 세면 2000자도 한도를 넘음
+		{"korean at default limit", 0, strings.Repeat("가", 2000), ""},
+		{"korean over default limit", 0, strings.Repeat("가", 2001), "2000자까지"},
+		{"configured limit", 10, "열한 글자짜리 메시지", "10자까지"},
+		{"emoji counted as runes", 3, "🎋🎋🎋", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: &Config{MaxMessageLength: tt.max}, slack: client}
+
+			values := newPostValues("question", "normal")
+			values[BlockIDMessage] = map[string]slack.BlockAction{ActionIDMessage: {Value: tt.message}}
+			payload := viewSubmission(CallbackNewPost, "", values)
+			payload.User.ID = "U0"
+			resp, _ := app.handleViewSubmission(payload)
+
+			posts := len(fs.callsTo("chat.postMessage"))
+			if tt.wantError == "" {
+				if posts != 1 {
+					t.Errorf("chat.postMessage 호출 수 = %d, want 1 (body=%s)", posts, resp.Body)
+				}
+				return
+			}
+			if errs := responseErrors(t, resp.Body); !strings.Contains(errs[BlockIDMessage], tt.wantError) {
+				t.Errorf("메시지 에러 = %v, want %q 포함", errs, tt.wantError)
+			}
+			if posts != 0 {
+				t.Errorf("길이 초과인데 게시됨 (%d회)", posts)
+			}
+		})
+	}
+}
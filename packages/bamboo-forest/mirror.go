@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 열람 전용 채널 (MIRROR_CHANNEL)
+// 더 넓은 범위의 사람들이 글만 볼 수 있도록 새 글의 내용(헤더와 본문)만 다른 채널에 함께 올린다.
+// 답글·반응·처리 완료 버튼과 반응 카운트는 빼므로 사본에서는 아무것도 누를 수 없고, 참여는 원래 채널에서만 한다.
+// 즉시 게시한 글의 사본 ts는 수정 권한(edits 탭 D열)과 삭제 토큰(deletes 탭 E열) 행에 남겨, 작성자가 원글을 고치거나 지우면 사본에도 반영한다.
+// 처리 완료 표시와 반응은 사본에 반영하지 않는다.
+
+// 글 블록에서 내용(헤더 컨텍스트, 본문 섹션)만 남기고 열람 전용 안내를 붙인 블록
+func buildReadOnlyBlocks(blocks []slack.Block, sourceChannel string) []slack.Block {
+	var out []slack.Block
+	for _, block := range blocks {
+		switch b := block.(type) {
+		case *slack.ContextBlock:
+			if b.BlockID != "emoji_counts" {
+				out = append(out, b)
+			}
+		case *slack.SectionBlock:
+			out = append(out, b)
+		}
+	}
+	return append(out, slack.NewContextBlock("",
+		slack.NewTextBlockObject("mrkdwn", "🔒 열람 전용 사본이에요. 답글과 반응은 <#"+sourceChannel+">에서 남길 수 있어요.", false, false),
+	))
+}
+
+// 열람 전용 사본을 올릴 채널 (미설정이거나 대상 채널과 같으면 "")
+func (app *App) mirrorChannel() string {
+	if ch := app.cfg.MirrorChannel; ch != app.targetChannel() {
+		return ch
+	}
+	return ""
+}
+
+// 열람 전용 사본 게시 (delay가 있으면 대상 채널과 같은 시각으로 예약, 실패해도 원글 게시는 성공으로 둔다)
+// 바로 게시한 사본의 ts를 반환한다 (예약했거나 실패하면 "")
+func (app *App) mirrorPost(delay time.Duration, blocks []slack.Block) string {
+	ch := app.mirrorChannel()
+	if ch == "" {
+		return ""
+	}
+	readOnly := slack.MsgOptionBlocks(buildReadOnlyBlocks(blocks, app.targetChannel())...)
+	var ts string
+	var err error
+	if delay > 0 {
+		_, err = app.scheduleMessage(ch, delay, readOnly)
+	} else {
+		_, ts, err = app.slack.PostMessage(ch, readOnly)
+	}
+	if err != nil {
+		log.Printf("[경고] 열람 전용 사본 게시 실패 (channel=%s): %v", ch, err)
+		return ""
+	}
+	log.Printf("[정보] 열람 전용 사본 게시 (channel=%s)", ch)
+	return ts
+}
+
+// 원글을 수정한 뒤 열람 전용 사본도 같은 내용으로 갱신 (실패해도 원글 수정은 성공으로 둔다)
+func (app *App) updateMirror(ctx context.Context, copies map[string]string, blocks []slack.Block) {
+	ch := app.mirrorChannel()
+	ts := copies[ch]
+	if ch == "" || ts == "" {
+		return
+	}
+	readOnly := slack.MsgOptionBlocks(buildReadOnlyBlocks(blocks, app.targetChannel())...)
+	if _, _, _, err := app.slack.UpdateMessageContext(ctx, ch, ts, readOnly); err != nil {
+		log.Printf("[경고] 열람 전용 사본 수정 실패 (channel=%s, ts=%s): %v", ch, ts, err)
+		return
+	}
+	log.Printf("[정보] 열람 전용 사본 수정 (channel=%s)", ch)
+}
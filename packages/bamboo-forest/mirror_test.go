@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+func TestBuildReadOnlyBlocks(t *testing.T) {
+	post := withQuickReplyButton(withMultiReactionSelect(buildNewPostBlocks("요즘 너무 힘들어요", "신입", []string{"U2"}, "concern", "urgent", "")))
+
+	got := buildReadOnlyBlocks(post, "CBAMBOO")
+	for _, block := range got {
+		switch b := block.(type) {
+		case *slack.ActionBlock:
+			t.Errorf("버튼 블록이 남음: %s", b.BlockID)
+		case *slack.ContextBlock:
+			if b.BlockID == "emoji_counts" {
+				t.Error("반응 카운트 블록이 남음")
+			}
+		case *slack.SectionBlock:
+			if b.Accessory != nil {
+				t.Error("섹션에 상호작용 요소가 남음")
+			}
+		}
+	}
+
+	raw, _ := json.Marshal(got)
+	text := blocksText(t, string(raw))
+	for _, want := range []string{"신입", "💭 고민", "<@U2>", "요즘 너무 힘들어요", "<#CBAMBOO>"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("사본에 %q 없음: %s", want, text)
+		}
+	}
+	if strings.Contains(string(raw), `"action_id"`) {
+		t.Errorf("사본에 action_id가 남음: %s", raw)
+	}
+}
+
+func TestPostNewMessageMirror(t *testing.T) {
+	tests := []struct {
+		name       string
+		mirror     string
+		wantMirror bool
+	}{
+		{"mirror channel", "CMIRROR", true},
+		{"same as target channel", "CBAMBOO", false},
+		{"not configured", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: &Config{BambooChannelID: "CBAMBOO", MirrorChannel: tt.mirror, QuickReply: true}, slack: client}
+
+			if resp, _ := app.postNewMessage("U1", "요즘 너무 힘들어요", "", nil, "concern", "normal", ""); resp.Body != "" {
+				t.Fatalf("게시 실패: %s", resp.Body)
+			}
+
+			posts := map[string]string{}
+			for _, c := range fs.callsTo("chat.postMessage") {
+				posts[c.Form.Get("channel")] = c.Form.Get("blocks")
+			}
+			if !strings.Contains(posts["CBAMBOO"], ActionReplyButton) {
+				t.Errorf("원래 채널 글에 답글 버튼이 없음")
+			}
+			mirrored, ok := posts["CMIRROR"]
+			if ok != tt.wantMirror || len(posts) != map[bool]int{true: 2, false: 1}[tt.wantMirror] {
+				t.Fatalf("게시 채널 = %v, want mirror=%v", posts, tt.wantMirror)
+			}
+			if !tt.wantMirror {
+				return
+			}
+			if strings.Contains(mirrored, `"type":"actions"`) || strings.Contains(mirrored, "emoji_counts") {
+				t.Errorf("사본에 상호작용 블록이 남음: %s", mirrored)
+			}
+			if !strings.Contains(blocksText(t, mirrored), "요즘 너무 힘들어요") {
+				t.Errorf("사본에 본문이 없음: %s", mirrored)
+			}
+		})
+	}
+}
+
+func TestMirrorFollowsEditAndDelete(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	app := &App{cfg: &Config{SheetsID: "sheet", MirrorChannel: "CMIRROR", EditGraceMinutes: 5, DeleteTokens: true}, slack: client, sheets: svc}
+
+	payload := viewSubmission(CallbackNewPost, "", newPostValues("concern", "normal"))
+	payload.User.ID = "U0"
+	app.handleViewSubmission(payload)
+
+	mirrorRef := "CMIRROR:1700000000.000100"
+	if rows := sh.rows("edits"); len(rows) != 1 || len(rows[0]) < 4 || rows[0][3] != mirrorRef {
+		t.Fatalf("edits 행 = %v, want D열 %q", rows, mirrorRef)
+	}
+	if rows := sh.rows("deletes"); len(rows) != 1 || len(rows[0]) < 5 || rows[0][4] != mirrorRef {
+		t.Fatalf("deletes 행 = %v, want E열 %q", rows, mirrorRef)
+	}
+
+	// 수정하면 사본도 같은 본문으로 갱신
+	fs.responses["conversations.history"] = `{"ok":true,"messages":[{"ts":"1700000000.000100","blocks":` + fs.callsTo("chat.postMessage")[0].Form.Get("blocks") + `}]}`
+	edit := viewSubmission(CallbackEditPost, DefaultTargetChannelID+"|1700000000.000100", map[string]map[string]slack.BlockAction{
+		BlockIDMessage: {ActionIDMessage: {Value: "고친 본문"}},
+	})
+	edit.User.ID = "U0"
+	app.handleViewSubmission(edit)
+
+	updated := map[string]string{}
+	for _, c := range fs.callsTo("chat.update") {
+		updated[c.Form.Get("channel")] = c.Form.Get("blocks")
+	}
+	if !strings.Contains(blocksText(t, updated["CMIRROR"]), "고친 본문") || strings.Contains(updated["CMIRROR"], `"type":"actions"`) {
+		t.Errorf("사본 수정 = %q, want 고친 본문의 열람 전용 블록", updated["CMIRROR"])
+	}
+
+	// 삭제하면 사본도 함께 삭제
+	var token string
+	for _, c := range fs.callsTo("chat.postMessage") {
+		if m := deleteTokenInDM.FindStringSubmatch(c.Form.Get("text")); m != nil {
+			token = m[1]
+		}
+	}
+	app.handleSlashCommand(context.Background(), deleteCommand(token).Encode())
+	deleted := map[string]bool{}
+	for _, c := range fs.callsTo("chat.delete") {
+		deleted[c.Form.Get("channel")] = true
+	}
+	if !deleted["CMIRROR"] || !deleted[DefaultTargetChannelID] {
+		t.Errorf("삭제된 채널 = %v, want 원글과 사본", deleted)
+	}
+}
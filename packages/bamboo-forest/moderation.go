@@ -0,0 +1,455 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/slack-go/slack"
+	"google.golang.org/api/sheets/v4"
+)
+
+// ─────────────────────────────────────
+// 단계별 검토 (MODERATED_CATEGORIES, MODERATION_TIERS)
+// MODERATED_CATEGORIES에 포함된 카테고리/긴급도의 새 글은 바로 게시하지 않고 1차 검토 채널에 올린다.
+// 검토자가 "승인"하면 대상 채널에 게시되고, "반려"하면 게시하지 않는다. 나머지 글은 지금처럼 바로 게시된다.
+// 단계의 escalate_after(예: "4h")가 지나도록 처리되지 않은 글은 스케줄 실행(handleScheduled)에서 다음 단계 채널로 넘긴다.
+//
+// 검토 대기 글은 pending 탭에 기록한다.
+// 열: A 접수 시각 | B 카테고리 | C 긴급도 | D 검토 단계(1부터) | E 상태 | F 상태 변경 시각 (단계가 바뀐 시각) | G 검토 채널 | H 검토 메시지 ts
+//     I 닉네임 사용 | J 멘션 수 | K 기분 | L 본문 지문 | M 감정 라벨
+// 본문은 검토 메시지에만 있고 시트에는 남기지 않는다. 작성자도 기록하지 않으므로 승인된 글에는
+// 작성자 확인이 필요한 수정·삭제 토큰·다시 올리기 버튼이 붙지 않는다.
+
+const (
+	ActionModerationApprove = "moderation_approve"
+	ActionModerationReject  = "moderation_reject"
+
+	moderationHeaderBlock  = "moderation_header"
+	moderationActionsBlock = "moderation_actions"
+
+	ReviewStatusApproved = "approved"
+	ReviewStatusRejected = "rejected"
+
+	moderationHeldMessage       = "🛡️ 보내주신 글은 검토 후 게시돼요. 검토 결과는 따로 알려드리지 않아요."
+	moderationNotAllowedMessage = "이 검토 단계 또는 상위 단계의 검토자만 승인하거나 반려할 수 있어요."
+)
+
+// 검토 단계 하나 (검토 채널, 검토자, 다음 단계로 넘기기까지 기다릴 시간)
+type ModerationTier struct {
+	Channel       string   `json:"channel"`
+	UserIDs       []string `json:"user_ids"`
+	EscalateAfter string   `json:"escalate_after"`
+}
+
+type pendingReview struct {
+	Row           int // 시트 행 번호 (1부터)
+	CreatedAt     time.Time
+	Category      string
+	Urgency       string
+	Tier          int
+	Status        string
+	TierSince     time.Time
+	ReviewChannel string
+	ReviewTS      string
+	HasNickname   bool
+	MentionCount  int
+	Mood          string
+	Fingerprint   string
+	Sentiment     string
+}
+
+// 검토자가 없는 단계는 아무나 승인할 수 있게 되므로 시작할 때 막는다
+func validateModerationTiers(tiers []ModerationTier) error {
+	for i, t := range tiers {
+		if len(t.UserIDs) == 0 {
+			return fmt.Errorf("MODERATION_TIERS[%d].user_ids가 비어 있음 (단계마다 검토자 필요)", i)
+		}
+	}
+	return nil
+}
+
+func (app *App) needsModeration(category, urgency string) bool {
+	if len(app.cfg.ModerationTiers) == 0 || app.sheets == nil {
+		return false
+	}
+	for _, c := range app.cfg.ModeratedCategories {
+		if c == category || c == urgency {
+			return true
+		}
+	}
+	return false
+}
+
+// tier 단계(1부터)에서 다음 단계로 넘기기까지 기다릴 시간 (마지막 단계, 미설정/잘못된 값이면 0)
+func (app *App) moderationEscalateAfter(tier int) time.Duration {
+	tiers := app.cfg.ModerationTiers
+	if tier < 1 || tier >= len(tiers) || tiers[tier-1].EscalateAfter == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(tiers[tier-1].EscalateAfter)
+	if err != nil || d <= 0 {
+		log.Printf("[경고] MODERATION_TIERS[%d].escalate_after 값이 잘못됨: %q", tier-1, tiers[tier-1].EscalateAfter)
+		return 0
+	}
+	return d
+}
+
+// tier 단계 글을 처리할 수 있는 사용자인지 (그 단계 또는 상위 단계 검토자)
+func (app *App) canReview(userID string, tier int) bool {
+	tiers := app.cfg.ModerationTiers
+	if tier < 1 || tier > len(tiers) {
+		return false
+	}
+	for _, t := range tiers[tier-1:] {
+		for _, id := range t.UserIDs {
+			if id == userID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// 검토 메시지 블록: 단계 안내 + 글 미리보기(헤더, 본문) + 승인/반려 버튼
+func buildModerationBlocks(tier int, note string, preview []slack.Block) []slack.Block {
+	header := fmt.Sprintf("🛡️ *%d차 검토 대기* │ 승인하면 대나무숲에 게시됩니다", tier)
+	if note != "" {
+		header += "\n" + note
+	}
+	headerBlock := slack.NewSectionBlock(slack.NewTextBlockObject("mrkdwn", header, false, false), nil, nil)
+	headerBlock.BlockID = moderationHeaderBlock
+	blocks := []slack.Block{headerBlock, slack.NewDividerBlock()}
+	blocks = append(blocks, preview...)
+	return append(blocks,
+		slack.NewActionBlock(moderationActionsBlock,
+			slack.NewButtonBlockElement(ActionModerationApprove, "approve", slack.NewTextBlockObject("plain_text", "✅ 승인", false, false)).WithStyle(slack.StylePrimary),
+			slack.NewButtonBlockElement(ActionModerationReject, "reject", slack.NewTextBlockObject("plain_text", "🚫 반려", false, false)).WithStyle(slack.StyleDanger),
+		),
+	)
+}
+
+// 처리가 끝난 검토 메시지 (미리보기와 버튼을 지우고 결과만 남김)
+func moderationClosedBlocks(text string) []slack.Block {
+	return []slack.Block{
+		slack.NewSectionBlock(slack.NewTextBlockObject("mrkdwn", text, false, false), nil, nil),
+	}
+}
+
+// 검토 메시지에서 글 미리보기(헤더 컨텍스트와 본문 섹션) 블록만 꺼낸다
+func moderationPreview(blocks []slack.Block) []slack.Block {
+	var preview []slack.Block
+	for _, block := range blocks {
+		switch b := block.(type) {
+		case *slack.ContextBlock:
+			preview = append(preview, b)
+		case *slack.SectionBlock:
+			if b.BlockID != moderationHeaderBlock && len(preview) > 0 {
+				preview = append(preview, b)
+			}
+		}
+	}
+	return preview
+}
+
+// ─────────────────────────────────────
+// 검토 대기 등록
+
+// 글을 1차 검토 채널에 올리고 pending 탭에 기록 (postNewMessage에서 익명성 검사를 마친 블록으로 호출)
+func (app *App) holdForReview(ctx context.Context, submitterID, message string, blocks []slack.Block, review pendingReview) (events.LambdaFunctionURLResponse, error) {
+	tier := app.cfg.ModerationTiers[0]
+	_, reviewTS, err := app.slack.PostMessageContext(ctx, tier.Channel,
+		slack.MsgOptionText("🛡️ 검토 대기 글", false),
+		slack.MsgOptionBlocks(buildModerationBlocks(1, "", blocks[:2])...),
+	)
+	if err != nil {
+		log.Printf("[에러] 검토 메시지 게시 실패: %v", err)
+		return respondWithError("메시지 게시에 실패했습니다. 잠시 후 다시 시도해주세요.")
+	}
+
+	now := time.Now()
+	if app.cfg.SentimentTagging {
+		review.Sentiment = sentimentLabel(message)
+	}
+	values := [][]interface{}{
+		{now.Format(time.RFC3339), review.Category, review.Urgency, 1, "", now.Format(time.RFC3339), tier.Channel, reviewTS,
+			review.HasNickname, review.MentionCount, review.Mood, review.Fingerprint, review.Sentiment},
+	}
+	_, err = app.sheets.Spreadsheets.Values.Append(
+		app.cfg.SheetsID,
+		"pending!A:M",
+		&sheets.ValueRange{Values: values},
+	).ValueInputOption("RAW").Context(ctx).Do()
+	if err != nil {
+		// 기록 없이 남은 검토 메시지는 승인할 수 없으므로 지운다
+		log.Printf("[에러] 검토 대기 기록 실패: %v", err)
+		if _, _, err := app.slack.DeleteMessageContext(ctx, tier.Channel, reviewTS); err != nil {
+			log.Printf("[경고] 검토 메시지 삭제 실패 (ts=%s): %v", reviewTS, err)
+		}
+		return respondWithError("메시지 게시에 실패했습니다. 잠시 후 다시 시도해주세요.")
+	}
+
+	if _, _, err := app.slack.PostMessageContext(ctx, submitterID, slack.MsgOptionText(moderationHeldMessage, false)); err != nil {
+		log.Printf("[경고] 검토 대기 안내 전송 실패: %v", err)
+	}
+	app.recordRateLimitPost(ctx, submitterID, now)
+	log.Printf("[정보] 새 글 검토 대기 (category=%s, urgency=%s, review_ts=%s)", review.Category, review.Urgency, reviewTS)
+	return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+}
+
+// pending 탭 전체 조회
+func (app *App) loadPendingReviews(ctx context.Context) ([]pendingReview, error) {
+	if app.sheets == nil {
+		return nil, fmt.Errorf("Sheets 서비스 없음")
+	}
+
+	resp, err := app.sheets.Spreadsheets.Values.Get(app.cfg.SheetsID, "pending!A:M").Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("Sheets 조회 실패: %w", err)
+	}
+
+	cell := func(row []interface{}, i int) string {
+		if i < len(row) {
+			if s, ok := row[i].(string); ok {
+				return s
+			}
+		}
+		return ""
+	}
+
+	var reviews []pendingReview
+	for i, row := range resp.Values {
+		createdAt, err := time.Parse(time.RFC3339, cell(row, 0))
+		if err != nil {
+			continue // 헤더 행 또는 잘못된 행
+		}
+		tier, _ := strconv.Atoi(cell(row, 3))
+		since, err := time.Parse(time.RFC3339, cell(row, 5))
+		if err != nil {
+			since = createdAt
+		}
+		mentionCount, _ := strconv.Atoi(cell(row, 9))
+		reviews = append(reviews, pendingReview{
+			Row:           i + 1,
+			CreatedAt:     createdAt,
+			Category:      cell(row, 1),
+			Urgency:       cell(row, 2),
+			Tier:          max(tier, 1),
+			Status:        cell(row, 4),
+			TierSince:     since,
+			ReviewChannel: cell(row, 6),
+			ReviewTS:      cell(row, 7),
+			HasNickname:   cell(row, 8) == "TRUE" || cell(row, 8) == "true",
+			MentionCount:  mentionCount,
+			Mood:          cell(row, 10),
+			Fingerprint:   cell(row, 11),
+			Sentiment:     cell(row, 12),
+		})
+	}
+	return reviews, nil
+}
+
+// 검토 메시지 ts로 아직 처리되지 않은 검토 대기 글 찾기
+func (app *App) findPendingReview(ctx context.Context, reviewTS string) (pendingReview, bool, error) {
+	reviews, err := app.loadPendingReviews(ctx)
+	if err != nil {
+		return pendingReview{}, false, err
+	}
+	for _, r := range reviews {
+		if r.ReviewTS == reviewTS && r.Status == "" {
+			return r, true, nil
+		}
+	}
+	return pendingReview{}, false, nil
+}
+
+func (app *App) setReviewStatus(ctx context.Context, row int, status string) error {
+	_, err := app.sheets.Spreadsheets.Values.Update(
+		app.cfg.SheetsID,
+		fmt.Sprintf("pending!E%d:F%d", row, row),
+		&sheets.ValueRange{Values: [][]interface{}{{status, time.Now().Format(time.RFC3339)}}},
+	).ValueInputOption("RAW").Context(ctx).Do()
+	return err
+}
+
+// ─────────────────────────────────────
+// 승인/반려 버튼
+
+func (app *App) handleModerationAction(ctx context.Context, payload slack.InteractionCallback, actionID string) (events.LambdaFunctionURLResponse, error) {
+	channelID, userID := payload.Channel.ID, payload.User.ID
+	reviewTS := payload.Message.Timestamp
+
+	notify := func(text string) (events.LambdaFunctionURLResponse, error) {
+		if _, err := app.slack.PostEphemeral(channelID, userID, slack.MsgOptionText(text, false)); err != nil {
+			log.Printf("[경고] 검토 안내 전송 실패: %v", err)
+		}
+		return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+	}
+
+	if app.sheets == nil {
+		return notify("검토 기능이 꺼져 있어요.")
+	}
+	review, ok, err := app.findPendingReview(ctx, reviewTS)
+	if err != nil {
+		log.Printf("[에러] 검토 대기 글 조회 실패: %v", err)
+		return notify("처리하지 못했어요. 잠시 후 다시 시도해주세요.")
+	}
+	if !ok {
+		return notify("이미 처리되었거나 다음 단계로 넘어간 글이에요.")
+	}
+	if !app.canReview(userID, review.Tier) {
+		log.Printf("[스킵] 검토 권한 없음 (review_ts=%s, tier=%d)", reviewTS, review.Tier)
+		return notify(moderationNotAllowedMessage)
+	}
+
+	status, result := ReviewStatusRejected, fmt.Sprintf("🚫 <@%s> 님이 반려했어요 (%d차 검토)", userID, review.Tier)
+	if actionID == ActionModerationApprove {
+		status, result = ReviewStatusApproved, fmt.Sprintf("✅ <@%s> 님이 승인해 게시했어요 (%d차 검토)", userID, review.Tier)
+	}
+
+	// 두 번 눌러도 한 번만 게시되도록 먼저 상태 기록
+	if err := app.setReviewStatus(ctx, review.Row, status); err != nil {
+		log.Printf("[에러] 검토 상태 기록 실패: %v", err)
+		return notify("처리하지 못했어요. 잠시 후 다시 시도해주세요.")
+	}
+	if status == ReviewStatusApproved {
+		if err := app.publishReviewedPost(ctx, review, moderationPreview(payload.Message.Blocks.BlockSet)); err != nil {
+			log.Printf("[에러] 승인된 글 게시 실패: %v", err)
+			if err := app.setReviewStatus(ctx, review.Row, ""); err != nil {
+				log.Printf("[경고] 검토 상태 되돌리기 실패: %v", err)
+			}
+			return notify("게시하지 못했어요. 잠시 후 다시 시도해주세요.")
+		}
+	}
+
+	if _, _, _, err := app.slack.UpdateMessageContext(ctx, channelID, reviewTS,
+		slack.MsgOptionText(result, false),
+		slack.MsgOptionBlocks(moderationClosedBlocks(result)...),
+	); err != nil {
+		log.Printf("[경고] 검토 메시지 갱신 실패 (ts=%s): %v", reviewTS, err)
+	}
+	log.Printf("[성공] 검토 %s (review_ts=%s, tier=%d)", status, reviewTS, review.Tier)
+	return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+}
+
+// 승인된 글을 대상 채널에 게시하고 posts 탭에 기록
+// 예약 게시는 하지 않는다 (게시 시각이 작성 시각이 아니라 승인 시각이므로)
+func (app *App) publishReviewedPost(ctx context.Context, review pendingReview, preview []slack.Block) error {
+	if len(preview) < 2 {
+		return fmt.Errorf("검토 메시지에 글 미리보기가 없음")
+	}
+	blocks := append(append([]slack.Block{}, preview...), buildNewPostBlocks("", "", nil, review.Category, review.Urgency, "")[2:]...)
+	if app.cfg.MultiReactionSelect {
+		blocks = withMultiReactionSelect(blocks)
+	}
+	if app.cfg.QuickReply {
+		blocks = withQuickReplyButton(blocks)
+	}
+	if app.cfg.ShowTimestamp {
+		blocks = withPostTimestamp(blocks, time.Now())
+	}
+
+	_, messageTS, err := app.slack.PostMessageContext(ctx, app.targetChannel(), slack.MsgOptionBlocks(blocks...))
+	if err != nil {
+		return err
+	}
+	app.fanOutUrgentPost(app.urgentFanoutChannels(review.Urgency), 0, blocks)
+	app.mirrorPost(0, blocks)
+	app.alertUrgentPost(ctx, app.targetChannel(), messageTS, review.Category, review.Urgency)
+
+	if err := app.recordPost(ctx, time.Now(), messageTS, review.Category, review.Urgency, review.HasNickname, review.MentionCount, review.Mood, review.Fingerprint, review.Sentiment, ""); err != nil {
+		log.Printf("[경고] 게시글 기록 실패: %v", err)
+	}
+	if app.isGratitudeRelayPost(review.Category, time.Now()) {
+		app.relayGratitudePost(messageTS)
+	}
+	if app.cfg.Dashboard {
+		if err := app.updateDashboard(ctx, app.targetChannel(), review.Category, review.Urgency); err != nil {
+			log.Printf("[경고] 현황판 갱신 실패: %v", err)
+		}
+	}
+
+	message := ""
+	if body := postBodyBlock(preview); body != nil {
+		_, message = splitMentionPrefix(body.Text.Text)
+	}
+	app.forwardToWebhook(ctx, messageTS, message, review.Category, review.Urgency, time.Now())
+	return nil
+}
+
+// ─────────────────────────────────────
+// 미처리 검토 글 다음 단계로 넘기기 (스케줄 실행)
+
+// 단계별 기다릴 시간이 지난 검토 대기 글을 다음 단계 채널로 넘기고 넘긴 글 수를 반환
+func (app *App) escalatePendingReviews(ctx context.Context, now time.Time) (int, error) {
+	tiers := app.cfg.ModerationTiers
+	if len(tiers) < 2 || app.sheets == nil {
+		return 0, nil
+	}
+
+	reviews, err := app.loadPendingReviews(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	escalated := 0
+	for _, r := range reviews {
+		after := app.moderationEscalateAfter(r.Tier)
+		if r.Status != "" || after == 0 || now.Sub(r.TierSince) < after {
+			continue
+		}
+
+		history, err := app.slack.GetConversationHistoryContext(ctx, &slack.GetConversationHistoryParameters{
+			ChannelID: r.ReviewChannel,
+			Latest:    r.ReviewTS,
+			Inclusive: true,
+			Limit:     1,
+		})
+		if err != nil || len(history.Messages) == 0 || history.Messages[0].Timestamp != r.ReviewTS {
+			log.Printf("[에러] 검토 메시지 조회 실패 (ts=%s): %v", r.ReviewTS, err)
+			continue
+		}
+		preview := moderationPreview(history.Messages[0].Blocks.BlockSet)
+
+		next := r.Tier + 1
+		note := fmt.Sprintf("⏫ %d차 검토에서 %s 동안 처리되지 않아 넘어온 글이에요", r.Tier, formatElapsed(after))
+		channel := tiers[next-1].Channel
+		_, ts, err := app.slack.PostMessageContext(ctx, channel,
+			slack.MsgOptionText("🛡️ 검토 대기 글", false),
+			slack.MsgOptionBlocks(buildModerationBlocks(next, note, preview)...),
+		)
+		if err != nil {
+			log.Printf("[에러] %d차 검토 메시지 게시 실패 (ts=%s): %v", next, r.ReviewTS, err)
+			continue
+		}
+
+		_, err = app.sheets.Spreadsheets.Values.Update(
+			app.cfg.SheetsID,
+			fmt.Sprintf("pending!D%d:H%d", r.Row, r.Row),
+			&sheets.ValueRange{Values: [][]interface{}{{next, "", now.Format(time.RFC3339), channel, ts}}},
+		).ValueInputOption("RAW").Context(ctx).Do()
+		if err != nil {
+			// 기록이 없으면 다음 실행이 같은 글을 또 올리므로, 새 메시지를 지우고 이전 단계는 그대로 둔다
+			log.Printf("[에러] 검토 단계 기록 실패, 다음 실행에서 다시 시도 (ts=%s): %v", r.ReviewTS, err)
+			if _, _, err := app.slack.DeleteMessageContext(ctx, channel, ts); err != nil {
+				log.Printf("[경고] %d차 검토 메시지 삭제 실패 (ts=%s): %v", next, ts, err)
+			}
+			continue
+		}
+
+		closed := fmt.Sprintf("⏫ %s 동안 처리되지 않아 %d차 검토로 넘어갔어요", formatElapsed(after), next)
+		if _, _, _, err := app.slack.UpdateMessageContext(ctx, r.ReviewChannel, r.ReviewTS,
+			slack.MsgOptionText(closed, false),
+			slack.MsgOptionBlocks(moderationClosedBlocks(closed)...),
+		); err != nil {
+			log.Printf("[경고] 이전 검토 메시지 갱신 실패 (ts=%s): %v", r.ReviewTS, err)
+		}
+		escalated++
+	}
+
+	log.Printf("[정보] 미처리 검토 글 상위 단계 이관 %d건", escalated)
+	return escalated, nil
+}
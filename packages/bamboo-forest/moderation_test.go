@@ -0,0 +1,255 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+func moderationConfig() *Config {
+	return &Config{
+		SheetsID:            "sheet",
+		BambooChannelID:     "CBAMBOO",
+		ModeratedCategories: []string{"concern"},
+		ModerationTiers: []ModerationTier{
+			{Channel: "CT1", UserIDs: []string{"UMOD1"}, EscalateAfter: "4h"},
+			{Channel: "CT2", UserIDs: []string{"UMOD2"}},
+		},
+	}
+}
+
+// 검토 메시지 (1차 검토 채널에 올라간 블록 그대로)
+func moderationMessage(tier int) []slack.Block {
+	return buildModerationBlocks(tier, "", buildNewPostBlocks("요즘 너무 힘들어요", "", nil, "concern", "normal", "")[:2])
+}
+
+func moderationHistory(t *testing.T, ts string, blocks []slack.Block) string {
+	t.Helper()
+	raw, err := json.Marshal(map[string]interface{}{
+		"ok":       true,
+		"messages": []map[string]interface{}{{"ts": ts, "text": "🛡️ 검토 대기 글", "blocks": blocks}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(raw)
+}
+
+func TestModerationHoldsModeratedCategories(t *testing.T) {
+	tests := []struct {
+		name     string
+		category string
+		wantHeld bool
+	}{
+		{"moderated category goes to tier one", "concern", true},
+		{"low-risk category posts immediately", "question", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			sh, svc := newFakeSheets(t)
+			app := &App{cfg: moderationConfig(), slack: client, sheets: svc}
+
+			payload := viewSubmission(CallbackNewPost, "", newPostValues(tt.category, "normal"))
+			payload.User.ID = "U1"
+			if resp, _ := app.handleViewSubmission(payload); resp.Body != "" {
+				t.Fatalf("제출 실패: %s", resp.Body)
+			}
+
+			channels := map[string]int{}
+			for _, c := range fs.callsTo("chat.postMessage") {
+				channels[c.Form.Get("channel")]++
+			}
+			pending := sh.rows("pending")
+			if !tt.wantHeld {
+				if channels["CBAMBOO"] != 1 || channels["CT1"] != 0 || len(pending) != 0 {
+					t.Errorf("바로 게시되어야 함: channels=%v pending=%v", channels, pending)
+				}
+				return
+			}
+			if channels["CBAMBOO"] != 0 {
+				t.Errorf("검토 전에 대상 채널에 게시됨")
+			}
+			if channels["CT1"] != 1 || channels["U1"] != 1 {
+				t.Errorf("1차 검토 메시지/작성자 안내 = %v", channels)
+			}
+			if len(pending) != 1 || pending[0][3] != "1" || pending[0][6] != "CT1" || pending[0][7] != "1700000000.000100" {
+				t.Fatalf("pending 행 = %v", pending)
+			}
+			if row := strings.Join(pending[0], "|"); strings.Contains(row, "U1") || strings.Contains(row, "힘들어요") {
+				t.Errorf("pending 행에 작성자나 본문이 기록됨: %v", pending[0])
+			}
+		})
+	}
+}
+
+func TestModerationEscalationPromotesToTierTwo(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name         string
+		tier         string
+		status       string
+		since        time.Duration // 현재 단계로 들어온 시점 (now 기준)
+		wantEscalate bool
+	}{
+		{"tier one past timeout", "1", "", -5 * time.Hour, true},
+		{"tier one within timeout", "1", "", -1 * time.Hour, false},
+		{"already approved", "1", ReviewStatusApproved, -5 * time.Hour, false},
+		{"last tier never escalates", "2", "", -48 * time.Hour, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			sh, svc := newFakeSheets(t)
+			app := &App{cfg: moderationConfig(), slack: client, sheets: svc}
+			fs.responses["conversations.history"] = moderationHistory(t, "1.0", moderationMessage(1))
+			fs.responses["chat.postMessage"] = `{"ok":true,"channel":"CT2","ts":"2.0"}`
+			since := now.Add(tt.since).Format(time.RFC3339)
+			sh.seed("pending", []string{since, "concern", "normal", tt.tier, tt.status, since, "CT1", "1.0", "false", "0", "", "fp", ""})
+
+			n, err := app.escalatePendingReviews(context.Background(), now)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			row := sh.rows("pending")[0]
+			if !tt.wantEscalate {
+				if n != 0 || row[3] != tt.tier || len(fs.callsTo("chat.postMessage")) != 0 {
+					t.Errorf("넘기지 않아야 함: n=%d row=%v", n, row)
+				}
+				return
+			}
+			if n != 1 {
+				t.Fatalf("넘긴 글 수 = %d, want 1", n)
+			}
+			if row[3] != "2" || row[4] != "" || row[5] != now.Format(time.RFC3339) || row[6] != "CT2" || row[7] != "2.0" {
+				t.Errorf("pending 행 = %v, want 2단계 CT2/2.0", row)
+			}
+			posts := fs.callsTo("chat.postMessage")
+			if len(posts) != 1 || posts[0].Form.Get("channel") != "CT2" {
+				t.Fatalf("2차 검토 메시지 = %v", posts)
+			}
+			text := blocksText(t, posts[0].Form.Get("blocks"))
+			if !strings.Contains(text, "2차 검토 대기") || !strings.Contains(text, "요즘 너무 힘들어요") {
+				t.Errorf("2차 검토 메시지 = %s", text)
+			}
+			updates := fs.callsTo("chat.update")
+			if len(updates) != 1 || updates[0].Form.Get("ts") != "1.0" || strings.Contains(updates[0].Form.Get("blocks"), ActionModerationApprove) {
+				t.Errorf("1차 검토 메시지에서 버튼이 빠져야 함: %v", updates)
+			}
+		})
+	}
+}
+
+// 단계 기록에 실패하면 새 검토 메시지를 지우고 1차 검토는 그대로 둔다
+func TestModerationEscalationKeepsTierOneWhenRecordFails(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	sh.failUpdates = true
+	app := &App{cfg: moderationConfig(), slack: client, sheets: svc}
+	fs.responses["conversations.history"] = moderationHistory(t, "1.0", moderationMessage(1))
+	fs.responses["chat.postMessage"] = `{"ok":true,"channel":"CT2","ts":"2.0"}`
+	since := now.Add(-5 * time.Hour).Format(time.RFC3339)
+	sh.seed("pending", []string{since, "concern", "normal", "1", "", since, "CT1", "1.0", "false", "0", "", "fp", ""})
+
+	n, err := app.escalatePendingReviews(context.Background(), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 {
+		t.Errorf("넘긴 글 수 = %d, want 0", n)
+	}
+	deletes := fs.callsTo("chat.delete")
+	if len(deletes) != 1 || deletes[0].Form.Get("channel") != "CT2" || deletes[0].Form.Get("ts") != "2.0" {
+		t.Errorf("2차 검토 메시지 삭제 = %v, want CT2/2.0", deletes)
+	}
+	if updates := fs.callsTo("chat.update"); len(updates) != 0 {
+		t.Errorf("1차 검토 메시지가 닫힘: %v", updates)
+	}
+	if row := sh.rows("pending")[0]; row[3] != "1" || row[7] != "1.0" {
+		t.Errorf("pending 행 = %v, want 1단계 그대로", row)
+	}
+}
+
+func TestModerationAction(t *testing.T) {
+	tests := []struct {
+		name        string
+		actionID    string
+		tier        string
+		user        string
+		wantStatus  string
+		wantPublish bool
+	}{
+		{"tier one moderator approves", ActionModerationApprove, "1", "UMOD1", ReviewStatusApproved, true},
+		{"higher tier can step in", ActionModerationApprove, "1", "UMOD2", ReviewStatusApproved, true},
+		{"reject does not publish", ActionModerationReject, "1", "UMOD1", ReviewStatusRejected, false},
+		{"tier one moderator cannot act on tier two", ActionModerationApprove, "2", "UMOD1", "", false},
+		{"non-moderator", ActionModerationApprove, "1", "U9", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			sh, svc := newFakeSheets(t)
+			app := &App{cfg: moderationConfig(), slack: client, sheets: svc}
+			sh.seed("pending", []string{time.Now().Format(time.RFC3339), "concern", "normal", tt.tier, "", "", "CT1", "1.0", "false", "0", "", "fp", ""})
+
+			app.handleModerationAction(context.Background(), emojiClick("CT1", "1.0", tt.user, moderationMessage(1)...), tt.actionID)
+
+			if got := sh.rows("pending")[0][4]; got != tt.wantStatus {
+				t.Errorf("상태 = %q, want %q", got, tt.wantStatus)
+			}
+			if tt.wantStatus == "" {
+				eph := fs.callsTo("chat.postEphemeral")
+				if len(eph) != 1 || eph[0].Form.Get("text") != moderationNotAllowedMessage {
+					t.Errorf("권한 안내 = %v, want %q", eph, moderationNotAllowedMessage)
+				}
+			}
+			var published []fakeSlackCall
+			for _, c := range fs.callsTo("chat.postMessage") {
+				if c.Form.Get("channel") == "CBAMBOO" {
+					published = append(published, c)
+				}
+			}
+			if !tt.wantPublish {
+				if len(published) != 0 || len(sh.rows("posts")) != 0 {
+					t.Errorf("게시되지 않아야 함")
+				}
+				return
+			}
+			if len(published) != 1 {
+				t.Fatalf("대상 채널 게시 수 = %d, want 1", len(published))
+			}
+			blocks := published[0].Form.Get("blocks")
+			if !strings.Contains(blocksText(t, blocks), "요즘 너무 힘들어요") || strings.Contains(blocks, ActionModerationApprove) || !strings.Contains(blocks, ActionReplyButton) {
+				t.Errorf("게시된 블록 = %s", blocks)
+			}
+			if posts := sh.rows("posts"); len(posts) != 1 || posts[0][2] != "concern" || posts[0][9] != "fp" {
+				t.Errorf("posts 행 = %v", posts)
+			}
+		})
+	}
+}
+
+func TestValidateModerationTiers(t *testing.T) {
+	tests := []struct {
+		name    string
+		tiers   []ModerationTier
+		wantErr bool
+	}{
+		{"not configured", nil, false},
+		{"reviewers on every tier", moderationConfig().ModerationTiers, false},
+		{"tier without reviewers", []ModerationTier{{Channel: "CT1", UserIDs: []string{"UMOD1"}}, {Channel: "CT2"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateModerationTiers(tt.tiers); (err != nil) != tt.wantErr {
+				t.Errorf("validateModerationTiers err = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
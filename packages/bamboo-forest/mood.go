@@ -0,0 +1,45 @@
+package main
+
+import (
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 오늘의 기분 (MOOD_TRACKING)
+// 새 글 모달 맨 위에 기분 선택(선택사항)을 붙이고, 고른 기분은 헤더 끝에 작은 이모지로 표시한다.
+// Sheets가 있으면 posts 탭 I열에 기록해 기분 추이를 집계할 수 있게 한다.
+
+const (
+	BlockIDMood  = "mood_block"
+	ActionIDMood = "mood_input"
+)
+
+var moodOptions = []*slack.OptionBlockObject{
+	slack.NewOptionBlockObject("good", slack.NewTextBlockObject("plain_text", "😀 좋아요", false, false), nil),
+	slack.NewOptionBlockObject("okay", slack.NewTextBlockObject("plain_text", "😐 그저 그래요", false, false), nil),
+	slack.NewOptionBlockObject("bad", slack.NewTextBlockObject("plain_text", "😞 힘들어요", false, false), nil),
+}
+
+var moodGlyphs = map[string]string{
+	"good": "😀",
+	"okay": "😐",
+	"bad":  "😞",
+}
+
+func moodBlock() slack.Block {
+	return slack.NewInputBlock(
+		BlockIDMood,
+		slack.NewTextBlockObject("plain_text", "오늘의 기분 (선택사항)", false, false),
+		slack.NewTextBlockObject("plain_text", "팀 분위기 집계에만 쓰이고 누가 골랐는지는 남지 않아요", false, false),
+		slack.NewRadioButtonsBlockElement(ActionIDMood, moodOptions...),
+	).WithOptional(true)
+}
+
+// 제출값에서 기분 추출 (알 수 없는 값은 "")
+func extractMood(values map[string]map[string]slack.BlockAction) string {
+	mood := values[BlockIDMood][ActionIDMood].SelectedOption.Value
+	if _, ok := moodGlyphs[mood]; !ok {
+		return ""
+	}
+	return mood
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+func TestExtractMood(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"good", "good", "good"},
+		{"bad", "bad", "bad"},
+		{"not selected", "", ""},
+		{"unknown", "ecstatic", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := map[string]map[string]slack.BlockAction{
+				BlockIDMood: {ActionIDMood: {SelectedOption: slack.OptionBlockObject{Value: tt.value}}},
+			}
+			if got := extractMood(values); got != tt.want {
+				t.Errorf("extractMood = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	if got := extractMood(map[string]map[string]slack.BlockAction{}); got != "" {
+		t.Errorf("기분 블록 없는 제출 = %q", got)
+	}
+}
+
+func TestBuildNewPostModalMood(t *testing.T) {
+	if first := buildNewPostModal(true).Blocks.BlockSet[0].(*slack.InputBlock); first.BlockID != BlockIDMood || !first.Optional {
+		t.Errorf("첫 블록 = %q (optional=%t), want 선택사항 기분 블록", first.BlockID, first.Optional)
+	}
+	for _, block := range buildNewPostModal(false).Blocks.BlockSet {
+		if b, ok := block.(*slack.InputBlock); ok && b.BlockID == BlockIDMood {
+			t.Error("MOOD_TRACKING 꺼져 있는데 기분 블록이 있음")
+		}
+	}
+}
+
+func moodSubmission(mood string) slack.InteractionCallback {
+	payload := viewSubmission(CallbackNewPost, "", map[string]map[string]slack.BlockAction{
+		BlockIDMood:     {ActionIDMood: {SelectedOption: slack.OptionBlockObject{Value: mood}}},
+		BlockIDMessage:  {ActionIDMessage: {Value: "월요일이라 피곤해요"}},
+		BlockIDCategory: {ActionIDCategory: {SelectedOption: slack.OptionBlockObject{Value: "concern"}}},
+		BlockIDConfirm:  {ActionIDConfirm: {SelectedOptions: []slack.OptionBlockObject{{Value: "confirmed"}}}},
+	})
+	payload.User.ID = "U0"
+	return payload
+}
+
+func TestMoodRenderedAndRecorded(t *testing.T) {
+	tests := []struct {
+		name       string
+		mood       string
+		wantHeader string
+		wantRows   int
+	}{
+		{"bad mood", "bad", "🎋 *익명* │ 💭 고민 │ 🟡 보통 │ 😞", 1},
+		{"no mood", "", "🎋 *익명* │ 💭 고민 │ 🟡 보통", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			sh, svc := newFakeSheets(t)
+			app := &App{cfg: &Config{SheetsID: "sheet", MoodTracking: true}, slack: client, sheets: svc}
+
+			app.handleViewSubmission(moodSubmission(tt.mood))
+
+			posts := fs.callsTo("chat.postMessage")
+			if len(posts) != 1 {
+				t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+			}
+			header := strings.SplitN(blocksText(t, posts[0].Form.Get("blocks")), "\n", 2)[0]
+			if header != tt.wantHeader {
+				t.Errorf("헤더 = %q, want %q", header, tt.wantHeader)
+			}
+
+			rows := sh.rows("posts")
+			if len(rows) != tt.wantRows {
+				t.Fatalf("posts 행 = %v, want %d행", rows, tt.wantRows)
+			}
+			if tt.wantRows > 0 && (len(rows[0]) < 9 || rows[0][8] != tt.mood) {
+				t.Errorf("기분 열 = %v", rows[0])
+			}
+		})
+	}
+}
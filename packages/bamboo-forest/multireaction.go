@@ -0,0 +1,47 @@
+package main
+
+import (
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 여러 이모지 한 번에 선택 (MULTI_REACTION_SELECT)
+// 이모지 버튼 줄 끝에 다중 선택 메뉴를 붙인다. 고른 이모지는 handleEmojiReactions에서
+// 중복 제거 후 한 번에 기록되고, 카운트 갱신도 한 번만 일어난다.
+
+// 이모지 버튼 블록(emoji_actions)에 다중 선택 메뉴 추가
+func withMultiReactionSelect(blocks []slack.Block) []slack.Block {
+	options := make([]*slack.OptionBlockObject, 0, len(reactionEmojis))
+	for _, emoji := range reactionEmojis {
+		options = append(options, slack.NewOptionBlockObject(
+			emoji.Name,
+			slack.NewTextBlockObject("plain_text", emoji.Icon, true, false),
+			nil,
+		))
+	}
+	selectMenu := slack.NewOptionsMultiSelectBlockElement(
+		slack.MultiOptTypeStatic,
+		slack.NewTextBlockObject("plain_text", "여러 개 고르기", false, false),
+		ActionEmojiMulti,
+		options...,
+	)
+
+	out := make([]slack.Block, 0, len(blocks))
+	for _, block := range blocks {
+		if b, ok := block.(*slack.ActionBlock); ok && b.BlockID == "emoji_actions" {
+			elements := append(append([]slack.BlockElement{}, b.Elements.ElementSet...), selectMenu)
+			block = slack.NewActionBlock(b.BlockID, elements...)
+		}
+		out = append(out, block)
+	}
+	return out
+}
+
+// 다중 선택 메뉴에서 고른 이모지 이름 목록
+func selectedEmojis(action *slack.BlockAction) []string {
+	names := make([]string, 0, len(action.SelectedOptions))
+	for _, opt := range action.SelectedOptions {
+		names = append(names, opt.Value)
+	}
+	return names
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ─────────────────────────────────────
+// 닉네임 정리/길이 제한
+// 닉네임은 헤더의 굵은 글씨(*닉네임*) 안에 들어가므로 길거나 마크다운 기호가 섞이면 레이아웃이 깨진다.
+// 제어 문자, 전체 알림 토큰(@here 등), 마크다운/링크 기호는 지우고, 남은 길이가 제한을 넘으면 모달에 에러를 표시한다.
+
+const defaultMaxNicknameLength = 30
+
+var (
+	broadcastTokenRegex = regexp.MustCompile(`(?i)<!(channel|here|everyone)[^>]*>|@(channel|here|everyone)\b`)
+	nicknameMarkupChars = "*_~`<>|"
+)
+
+func (app *App) maxNicknameLength() int {
+	if app.cfg.MaxNicknameLength > 0 {
+		return app.cfg.MaxNicknameLength
+	}
+	return defaultMaxNicknameLength
+}
+
+// 허용하지 않는 문자를 지운 닉네임 (연속 공백은 하나로)
+func cleanNickname(nickname string) string {
+	nickname = broadcastTokenRegex.ReplaceAllString(nickname, " ")
+	nickname = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return ' '
+		}
+		if strings.ContainsRune(nicknameMarkupChars, r) {
+			return -1
+		}
+		return r
+	}, nickname)
+	return strings.Join(strings.Fields(nickname), " ")
+}
+
+// 정리한 닉네임과 닉네임 블록에 표시할 에러 (문제 없으면 "")
+func (app *App) validateNickname(raw string) (string, string) {
+	nickname := cleanNickname(raw)
+	if nickname == "" && strings.TrimSpace(raw) != "" {
+		return "", "닉네임에 쓸 수 없는 문자만 들어있어요"
+	}
+	if limit := app.maxNicknameLength(); utf8.RuneCountInString(nickname) > limit {
+		return nickname, fmt.Sprintf("닉네임은 %d자까지 쓸 수 있어요", limit)
+	}
+	return nickname, ""
+}
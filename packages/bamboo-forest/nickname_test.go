@@ -0,0 +1,82 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+func TestCleanNickname(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"3년차 개발자", "3년차 개발자"},
+		{"*굵게* _기울임_ ~취소~", "굵게 기울임 취소"},
+		{"알림 <!here> 유도", "알림 유도"},
+		{"@channel 모두", "모두"},
+		{"줄\n바꿈\t탭", "줄 바꿈 탭"},
+		{"<https://evil.example|링크>", "https://evil.example링크"},
+		{"👩‍💻 개발자", "👩‍💻 개발자"},
+	}
+	for _, tt := range tests {
+		if got := cleanNickname(tt.in); got != tt.want {
+			t.Errorf("cleanNickname(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func nicknameSubmission(nickname string) slack.InteractionCallback {
+	payload := viewSubmission(CallbackNewPost, "", map[string]map[string]slack.BlockAction{
+		BlockIDMessage:  {ActionIDMessage: {Value: "오늘 회의 너무 길었어요"}},
+		BlockIDName:     {ActionIDName: {Value: nickname}},
+		BlockIDCategory: {ActionIDCategory: {SelectedOption: slack.OptionBlockObject{Value: "concern"}}},
+		BlockIDConfirm:  {ActionIDConfirm: {SelectedOptions: []slack.OptionBlockObject{{Value: "confirmed"}}}},
+	})
+	payload.User.ID = "U0"
+	return payload
+}
+
+func TestHandleViewSubmissionNickname(t *testing.T) {
+	tests := []struct {
+		name       string
+		max        int
+		nickname   string
+		wantError  string
+		wantHeader string
+	}{
+		{"plain", 0, "3년차 개발자", "", "🎋 *3년차 개발자*"},
+		{"markup stripped", 0, "*<!channel> 신입*", "", "🎋 *신입*"},
+		{"over default length", 0, strings.Repeat("가", 31), "30자까지", ""},
+		{"over configured length", 5, "여섯글자닉네", "5자까지", ""},
+		{"only disallowed", 0, "@here ***", "쓸 수 없는 문자", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: &Config{MaxNicknameLength: tt.max}, slack: client}
+
+			resp, _ := app.handleViewSubmission(nicknameSubmission(tt.nickname))
+
+			posts := fs.callsTo("chat.postMessage")
+			if tt.wantError != "" {
+				errs := responseErrors(t, resp.Body)
+				if !strings.Contains(errs[BlockIDName], tt.wantError) {
+					t.Errorf("닉네임 에러 = %v, want %q 포함", errs, tt.wantError)
+				}
+				if len(posts) != 0 {
+					t.Errorf("검증 실패인데 게시됨 (%d회)", len(posts))
+				}
+				return
+			}
+			if len(posts) != 1 {
+				t.Fatalf("chat.postMessage 호출 수 = %d, want 1 (body=%s)", len(posts), resp.Body)
+			}
+			header := strings.SplitN(blocksText(t, posts[0].Form.Get("blocks")), "\n", 2)[0]
+			if !strings.HasPrefix(header, tt.wantHeader+" │") {
+				t.Errorf("헤더 = %q, want %q로 시작", header, tt.wantHeader)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package main
+
+// ─────────────────────────────────────
+// 카테고리별 작성 권한 (CATEGORY_ALLOWED_USERS)
+// 카테고리 값 → 작성할 수 있는 사용자 ID 목록. 목록이 있는 카테고리는 해당 사용자만 새 글을 쓸 수 있다.
+// 제출자 ID는 비교에만 쓰고 기록하거나 게시 내용에 넣지 않으므로, 허용된 사용자의 글도 다른 글과 똑같이 익명이다.
+
+const categoryNotAllowedMessage = "이 카테고리는 지정된 사람만 글을 쓸 수 있어요"
+
+// 제출자가 카테고리에 글을 쓸 수 있는지 (목록이 없는 카테고리는 누구나)
+func (app *App) canPostInCategory(userID, category string) bool {
+	allowed, ok := app.cfg.CategoryAllowedUsers[category]
+	if !ok {
+		return true
+	}
+	for _, id := range allowed {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
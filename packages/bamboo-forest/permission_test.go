@@ -0,0 +1,48 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCategoryPermission(t *testing.T) {
+	allowed := map[string][]string{"other": {"UADMIN"}}
+	tests := []struct {
+		name      string
+		user      string
+		category  string
+		wantError bool
+	}{
+		{"permitted user in restricted category", "UADMIN", "other", false},
+		{"denied user in restricted category", "U1", "other", true},
+		{"open category", "U1", "question", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: &Config{CategoryAllowedUsers: allowed}, slack: client}
+
+			payload := viewSubmission(CallbackNewPost, "", newPostValues(tt.category, "normal"))
+			payload.User.ID = tt.user
+			resp, _ := app.handleViewSubmission(payload)
+
+			posts := fs.callsTo("chat.postMessage")
+			if tt.wantError {
+				if errs := responseErrors(t, resp.Body); errs[BlockIDCategory] != categoryNotAllowedMessage {
+					t.Errorf("에러 = %v, want 카테고리 권한 에러", errs)
+				}
+				if len(posts) != 0 {
+					t.Errorf("권한 없는 글이 게시됨 (%d회)", len(posts))
+				}
+				return
+			}
+			if len(posts) != 1 {
+				t.Fatalf("chat.postMessage 호출 수 = %d, want 1 (body=%s)", len(posts), resp.Body)
+			}
+			// 허용된 사용자의 글도 익명
+			if strings.Contains(posts[0].Form.Get("blocks"), tt.user) {
+				t.Error("게시 내용에 작성자 ID가 포함됨")
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/slack-go/slack"
+	"google.golang.org/api/sheets/v4"
+)
+
+// ─────────────────────────────────────
+// 사용법 메시지 다시 게시 + 고정 (`/bamboo-admin pin-help`, 모더레이터 전용)
+// 채널 안내(GUIDE_LOCALE/GUIDE_MARKDOWN) 본문을 메시지로 올려 고정하고, 이전에 고정한 사용법 메시지는 고정을 푼다.
+// 이전 메시지 ts는 meta 탭(A 키 | B 값)의 help_pin_ts 행에 기록한다. Sheets가 없으면 이전 고정을 풀지 못한다.
+
+const (
+	AdminCommand      = "/bamboo-admin"
+	metaKeyHelpPinTS  = "help_pin_ts"
+	helpFallbackText  = "🎋 대나무숲 사용법"
+	pinLimitErrorCode = "too_many_pins"
+)
+
+// `/bamboo-admin` 처리
+func (app *App) handleAdminCommand(ctx context.Context, values url.Values) (events.LambdaFunctionURLResponse, error) {
+	if !app.isModerator(values.Get("user_id")) {
+		return respondWithSlackError("모더레이터만 사용할 수 있는 명령어입니다.")
+	}
+
+	switch strings.TrimSpace(values.Get("text")) {
+	case "pin-help":
+		result, err := app.repostHelpPin(ctx)
+		if err != nil {
+			log.Printf("[에러] 사용법 고정 실패: %v", err)
+			return respondWithSlackError(err.Error())
+		}
+		return events.LambdaFunctionURLResponse{
+			StatusCode: 200,
+			Headers:    map[string]string{"Content-Type": "text/plain; charset=utf-8"},
+			Body:       result,
+		}, nil
+	default:
+		return respondWithSlackError("사용법: `/bamboo-admin pin-help`")
+	}
+}
+
+// 안내 마크다운을 Slack mrkdwn으로 변환 (# 제목은 굵게)
+func guideMessageText(markdown string) string {
+	lines := strings.Split(strings.TrimSpace(markdown), "\n")
+	for i, line := range lines {
+		if trimmed := strings.TrimLeft(line, "#"); trimmed != line {
+			lines[i] = "*" + strings.TrimSpace(trimmed) + "*"
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// 사용법 메시지를 새로 게시해 고정하고 이전 사용법 고정을 해제한 뒤 결과 문구를 반환
+func (app *App) repostHelpPin(ctx context.Context) (string, error) {
+	_, ts, err := app.slack.PostMessageContext(ctx, app.targetChannel(),
+		slack.MsgOptionText(helpFallbackText, false),
+		slack.MsgOptionBlocks(slack.NewSectionBlock(
+			slack.NewTextBlockObject("mrkdwn", guideMessageText(app.guideContent()), false, false), nil, nil,
+		)),
+	)
+	if err != nil {
+		return "", fmt.Errorf("사용법 게시 실패: %w", err)
+	}
+
+	// 고정 한도를 넘지 않도록 이전 고정부터 푼다
+	row, prevTS := 0, ""
+	if app.sheets != nil {
+		if row, prevTS, err = app.getMeta(ctx, metaKeyHelpPinTS); err != nil {
+			log.Printf("[경고] 이전 사용법 고정 조회 실패: %v", err)
+		}
+	}
+	if prevTS != "" && prevTS != ts {
+		if err := app.slack.RemovePinContext(ctx, app.targetChannel(), slack.ItemRef{Channel: app.targetChannel(), Timestamp: prevTS}); err != nil {
+			log.Printf("[경고] 이전 사용법 고정 해제 실패 (ts=%s): %v", prevTS, err)
+		}
+	}
+
+	if err := app.slack.AddPinContext(ctx, app.targetChannel(), slack.ItemRef{Channel: app.targetChannel(), Timestamp: ts}); err != nil {
+		if strings.Contains(err.Error(), pinLimitErrorCode) {
+			return "⚠️ 사용법을 게시했지만 채널 고정 한도에 도달해 고정하지 못했습니다. 오래된 고정 항목을 정리한 뒤 다시 실행해주세요.", nil
+		}
+		return "", fmt.Errorf("사용법 고정 실패 (pins:write 스코프 확인): %w", err)
+	}
+
+	if app.sheets != nil {
+		if err := app.setMeta(ctx, row, metaKeyHelpPinTS, ts); err != nil {
+			log.Printf("[경고] 사용법 고정 기록 실패: %v", err)
+		}
+	}
+	log.Printf("[성공] 사용법 메시지 고정 (ts=%s, 이전=%s)", ts, prevTS)
+	return "✅ 사용법 메시지를 다시 게시하고 고정했습니다.", nil
+}
+
+// meta 탭에서 키의 행 번호(1부터, 없으면 0)와 값 조회
+func (app *App) getMeta(ctx context.Context, key string) (int, string, error) {
+	resp, err := app.sheets.Spreadsheets.Values.Get(app.cfg.SheetsID, "meta!A:B").Context(ctx).Do()
+	if err != nil {
+		return 0, "", fmt.Errorf("Sheets 조회 실패: %w", err)
+	}
+	for i, row := range resp.Values {
+		if len(row) == 0 || fmt.Sprint(row[0]) != key {
+			continue
+		}
+		if len(row) > 1 {
+			return i + 1, fmt.Sprint(row[1]), nil
+		}
+		return i + 1, "", nil
+	}
+	return 0, "", nil
+}
+
+// meta 탭에 키 값 기록 (row가 0이면 새 행 추가)
+func (app *App) setMeta(ctx context.Context, row int, key, value string) error {
+	values := [][]interface{}{{key, value}}
+	if row == 0 {
+		_, err := app.sheets.Spreadsheets.Values.Append(
+			app.cfg.SheetsID,
+			"meta!A:B",
+			&sheets.ValueRange{Values: values},
+		).ValueInputOption("RAW").Context(ctx).Do()
+		return err
+	}
+	_, err := app.sheets.Spreadsheets.Values.Update(
+		app.cfg.SheetsID,
+		fmt.Sprintf("meta!A%d:B%d", row, row),
+		&sheets.ValueRange{Values: values},
+	).ValueInputOption("RAW").Context(ctx).Do()
+	return err
+}
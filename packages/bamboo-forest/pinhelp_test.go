@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func adminCommand(userID, text string) url.Values {
+	return url.Values{"command": {AdminCommand}, "user_id": {userID}, "text": {text}}
+}
+
+func TestPinHelpReplacesPriorPin(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	sh.seed("meta", []string{"key", "value"}, []string{metaKeyHelpPinTS, "1600000000.000100"})
+	app := &App{cfg: &Config{SheetsID: "sheet", ModeratorUserIDs: []string{"UMOD"}, GuideLocale: "ja"}, slack: client, sheets: svc}
+
+	resp, _ := app.handleAdminCommand(context.Background(), adminCommand("UMOD", "pin-help"))
+	if !strings.Contains(resp.Body, "✅") {
+		t.Fatalf("응답 = %q", resp.Body)
+	}
+
+	posts := fs.callsTo("chat.postMessage")
+	if len(posts) != 1 {
+		t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+	}
+	if text := blocksText(t, posts[0].Form.Get("blocks")); !strings.HasPrefix(text, "*🎋 竹林") {
+		t.Errorf("사용법 본문이 설정한 언어가 아님: %q", text)
+	}
+
+	removes := fs.callsTo("pins.remove")
+	if len(removes) != 1 || removes[0].Form.Get("timestamp") != "1600000000.000100" {
+		t.Errorf("이전 고정 해제 = %v", removes)
+	}
+	adds := fs.callsTo("pins.add")
+	if len(adds) != 1 || adds[0].Form.Get("timestamp") != "1700000000.000100" {
+		t.Errorf("새 고정 = %v", adds)
+	}
+
+	rows := sh.rows("meta")
+	if len(rows) != 2 || rows[1][1] != "1700000000.000100" {
+		t.Errorf("meta 탭 = %v, want 새 ts로 교체", rows)
+	}
+}
+
+func TestPinHelp(t *testing.T) {
+	tests := []struct {
+		name       string
+		user       string
+		pinResp    string
+		wantBody   string
+		wantPosts  int
+		wantRecord bool
+	}{
+		{"first pin", "UMOD", "", "✅", 1, true},
+		{"not moderator", "U1", "", "모더레이터만", 0, false},
+		{"pin limit", "UMOD", `{"ok":false,"error":"too_many_pins"}`, "고정 한도", 1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			if tt.pinResp != "" {
+				fs.responses["pins.add"] = tt.pinResp
+			}
+			sh, svc := newFakeSheets(t)
+			app := &App{cfg: &Config{SheetsID: "sheet", ModeratorUserIDs: []string{"UMOD"}}, slack: client, sheets: svc}
+
+			resp, _ := app.handleAdminCommand(context.Background(), adminCommand(tt.user, "pin-help"))
+			if !strings.Contains(resp.Body, tt.wantBody) {
+				t.Errorf("응답 = %q, want %q 포함", resp.Body, tt.wantBody)
+			}
+			if n := len(fs.callsTo("chat.postMessage")); n != tt.wantPosts {
+				t.Errorf("chat.postMessage 호출 수 = %d, want %d", n, tt.wantPosts)
+			}
+			if n := len(fs.callsTo("pins.remove")); n != 0 {
+				t.Errorf("이전 고정이 없는데 pins.remove 호출 (%d회)", n)
+			}
+			if got := len(sh.rows("meta")) > 0; got != tt.wantRecord {
+				t.Errorf("meta 기록 = %v, want %v", sh.rows("meta"), tt.wantRecord)
+			}
+		})
+	}
+}
+
+func TestGuideMessageText(t *testing.T) {
+	got := guideMessageText("# 제목\n\n## 소제목\n- 항목")
+	if want := "*제목*\n\n*소제목*\n- 항목"; got != want {
+		t.Errorf("guideMessageText = %q, want %q", got, want)
+	}
+}
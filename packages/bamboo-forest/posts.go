@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+// ─────────────────────────────────────
+// 게시글 기록 (posts 탭)
+// 열: A 게시 시각 | B 메시지 ts | C 카테고리 | D 긴급도 | E 닉네임 사용 | F 멘션 수 | G 상태 | H 상태 변경 시각 | I 기분 | J 본문 지문 | K 감정 라벨
+//     L 작성자 해시 (다시 올리기·처리 완료 권한 확인용) | M 다시 올린 시각
+// 익명성 유지를 위해 본문과 사용자 식별 정보는 남기지 않는다 (작성자는 hash(userID + messageTS)로만 확인).
+// 예약 게시한 글은 예약 시각을 게시 시각으로, 메시지 ts와 작성자 해시는 비운 채 기록한다 (ts가 필요한 점검 작업은 건너뜀).
+
+const (
+	PostStatusCompleted = "completed" // 처리완료 버튼으로 완료됨
+	PostStatusNudged    = "nudged"    // 긴급 글 미처리 알림을 보냄
+)
+
+type postRecord struct {
+	Row         int // 시트 행 번호 (1부터)
+	CreatedAt   time.Time
+	MessageTS   string
+	Category    string
+	Urgency     string
+	Status      string
+	Mood        string
+	Fingerprint string
+	Sentiment   string
+	BumpAuthor  string
+	BumpedAt    string
+}
+
+func (app *App) recordPost(ctx context.Context, postedAt time.Time, messageTS, category, urgency string, hasNickname bool, mentionCount int, mood, fingerprint, sentiment, bumpAuthor string) error {
+	if app.sheets == nil {
+		return fmt.Errorf("Sheets 서비스 없음")
+	}
+
+	values := [][]interface{}{
+		{postedAt.Format(time.RFC3339), messageTS, category, urgency, hasNickname, mentionCount, "", "", mood, fingerprint, sentiment, bumpAuthor, ""},
+	}
+
+	_, err := app.sheets.Spreadsheets.Values.Append(
+		app.cfg.SheetsID,
+		"posts!A:M",
+		&sheets.ValueRange{Values: values},
+	).ValueInputOption("RAW").Context(ctx).Do()
+
+	return err
+}
+
+// posts 탭 전체 조회
+func (app *App) loadPosts(ctx context.Context) ([]postRecord, error) {
+	if app.sheets == nil {
+		return nil, fmt.Errorf("Sheets 서비스 없음")
+	}
+
+	resp, err := app.sheets.Spreadsheets.Values.Get(app.cfg.SheetsID, "posts!A:M").Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("Sheets 조회 실패: %w", err)
+	}
+
+	cell := func(row []interface{}, i int) string {
+		if i < len(row) {
+			if s, ok := row[i].(string); ok {
+				return s
+			}
+		}
+		return ""
+	}
+
+	var posts []postRecord
+	for i, row := range resp.Values {
+		createdAt, err := time.Parse(time.RFC3339, cell(row, 0))
+		if err != nil {
+			continue // 헤더 행 또는 잘못된 행
+		}
+		posts = append(posts, postRecord{
+			Row:         i + 1,
+			CreatedAt:   createdAt,
+			MessageTS:   cell(row, 1),
+			Category:    cell(row, 2),
+			Urgency:     cell(row, 3),
+			Status:      cell(row, 6),
+			Mood:        cell(row, 8),
+			Fingerprint: cell(row, 9),
+			Sentiment:   cell(row, 10),
+			BumpAuthor:  cell(row, 11),
+			BumpedAt:    cell(row, 12),
+		})
+	}
+	return posts, nil
+}
+
+func (app *App) setPostStatus(ctx context.Context, row int, status string) error {
+	_, err := app.sheets.Spreadsheets.Values.Update(
+		app.cfg.SheetsID,
+		fmt.Sprintf("posts!G%d:H%d", row, row),
+		&sheets.ValueRange{Values: [][]interface{}{{status, time.Now().Format(time.RFC3339)}}},
+	).ValueInputOption("RAW").Context(ctx).Do()
+	return err
+}
+
+// 처리완료 버튼 클릭 시 게시글 상태 갱신 (기록되지 않은 글이면 무시)
+func (app *App) markPostCompleted(ctx context.Context, messageTS string) error {
+	posts, err := app.loadPosts(ctx)
+	if err != nil {
+		return err
+	}
+	for _, p := range posts {
+		if p.MessageTS == messageTS {
+			return app.setPostStatus(ctx, p.Row, PostStatusCompleted)
+		}
+	}
+	return nil
+}
+
+// 처리완료 취소 시 게시글 상태를 비움 (처리완료 상태가 아니면 무시)
+func (app *App) markPostReopened(ctx context.Context, messageTS string) error {
+	posts, err := app.loadPosts(ctx)
+	if err != nil {
+		return err
+	}
+	for _, p := range posts {
+		if p.MessageTS == messageTS && p.Status == PostStatusCompleted {
+			return app.setPostStatus(ctx, p.Row, "")
+		}
+	}
+	return nil
+}
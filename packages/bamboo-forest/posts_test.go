@@ -0,0 +1,41 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEveryPostRecorded(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	app := &App{cfg: &Config{SheetsID: "sheet"}, slack: client, sheets: svc}
+
+	payload := mentionSubmission("U1", "U2")
+	app.handleViewSubmission(payload)
+
+	if n := len(fs.callsTo("chat.postMessage")); n != 1 {
+		t.Fatalf("chat.postMessage 호출 수 = %d, want 1", n)
+	}
+	rows := sh.rows("posts")
+	if len(rows) != 1 {
+		t.Fatalf("posts 행 = %v, want 1행", rows)
+	}
+	row := rows[0]
+	if row[1] != "1700000000.000100" || row[2] != "question" || row[3] != "normal" || row[4] != "false" || row[5] != "2" {
+		t.Errorf("posts 행 = %v, want ts/카테고리/긴급도/닉네임 여부/멘션 수", row)
+	}
+	joined := strings.Join(row, "|")
+	if strings.Contains(joined, "멘션 테스트") || strings.Contains(joined, "U0") || strings.Contains(joined, "U1") {
+		t.Errorf("posts 행에 본문 또는 사용자 ID가 기록됨: %v", row)
+	}
+}
+
+func TestPostWithoutSheets(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{}, slack: client}
+
+	resp, _ := app.handleViewSubmission(mentionSubmission())
+	if n := len(fs.callsTo("chat.postMessage")); n != 1 {
+		t.Errorf("Sheets 없이 게시 실패: chat.postMessage 호출 수 = %d (body=%s)", n, resp.Body)
+	}
+}
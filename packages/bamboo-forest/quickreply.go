@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 빠른 한마디 (QUICK_REPLY)
+// 이모지로는 부족하지만 답글 모달을 열기는 부담스러울 때 쓰는 한 줄 익명 답글.
+// 입력칸 하나짜리 모달로 받아 postThreadReply로 스레드에 게시한다 (닉네임·멘션 없음).
+
+const (
+	ActionQuickReplyButton = "bamboo_quick_reply"
+	CallbackQuickReply     = "bamboo_quick_reply_modal"
+	maxQuickReplyLength    = 100
+)
+
+func buildQuickReplyModal(channelID, threadTS string) slack.ModalViewRequest {
+	return slack.ModalViewRequest{
+		Type:            slack.ViewType("modal"),
+		CallbackID:      CallbackQuickReply,
+		PrivateMetadata: fmt.Sprintf("%s|%s", channelID, threadTS),
+		Title:           slack.NewTextBlockObject("plain_text", "⚡ 빠른 한마디", false, false),
+		Submit:          slack.NewTextBlockObject("plain_text", "남기기", false, false),
+		Close:           slack.NewTextBlockObject("plain_text", "취소", false, false),
+		Blocks: slack.Blocks{
+			BlockSet: []slack.Block{
+				slack.NewInputBlock(
+					BlockIDMessage,
+					slack.NewTextBlockObject("plain_text", "한마디", false, false),
+					slack.NewTextBlockObject("plain_text", fmt.Sprintf("스레드에 익명으로 남깁니다 (최대 %d자, 게시 후 수정/삭제 불가)", maxQuickReplyLength), false, false),
+					slack.NewPlainTextInputBlockElement(
+						slack.NewTextBlockObject("plain_text", "예: 저도 같은 생각이에요!", false, false),
+						ActionIDMessage,
+					).WithMaxLength(maxQuickReplyLength),
+				),
+			},
+		},
+	}
+}
+
+// 글 하단 버튼 줄(답글 버튼이 있는 블록)에 빠른 한마디 버튼 추가
+func withQuickReplyButton(blocks []slack.Block) []slack.Block {
+	button := slack.NewButtonBlockElement(
+		ActionQuickReplyButton,
+		"quick_reply",
+		slack.NewTextBlockObject("plain_text", "⚡ 빠른 한마디", false, false),
+	)
+
+	out := make([]slack.Block, 0, len(blocks))
+	for _, block := range blocks {
+		if b, ok := block.(*slack.ActionBlock); ok && hasReplyButton(b) {
+			elements := append([]slack.BlockElement{}, b.Elements.ElementSet[0], button)
+			elements = append(elements, b.Elements.ElementSet[1:]...)
+			block = slack.NewActionBlock(b.BlockID, elements...)
+		}
+		out = append(out, block)
+	}
+	return out
+}
+
+func hasReplyButton(b *slack.ActionBlock) bool {
+	if len(b.Elements.ElementSet) == 0 {
+		return false
+	}
+	button, ok := b.Elements.ElementSet[0].(*slack.ButtonBlockElement)
+	return ok && button.ActionID == ActionReplyButton
+}
+
+// 빠른 한마디 제출 처리 (한 줄, 길이 제한 검사 후 스레드 답글로 게시)
+func (app *App) handleQuickReplySubmission(payload slack.InteractionCallback) (events.LambdaFunctionURLResponse, error) {
+	message := ""
+	if msgBlock, ok := payload.View.State.Values[BlockIDMessage]; ok {
+		message = strings.TrimSpace(msgBlock[ActionIDMessage].Value)
+	}
+	message, _ = app.applyMentionPolicy(message, nil)
+
+	switch {
+	case message == "":
+		return respondWithErrors(map[string]string{BlockIDMessage: "한마디를 입력해주세요"})
+	case utf8.RuneCountInString(message) > maxQuickReplyLength:
+		return respondWithErrors(map[string]string{BlockIDMessage: fmt.Sprintf("빠른 한마디는 %d자까지 쓸 수 있어요", maxQuickReplyLength)})
+	case app.containsBannedWord(message):
+		return respondWithError(bannedWordMessage)
+	}
+
+	return app.postThreadReply(payload.User.ID, payload.View.PrivateMetadata, message, "", nil, "", "")
+}
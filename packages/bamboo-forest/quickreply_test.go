@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+func quickReplySubmission(message string) slack.InteractionCallback {
+	payload := viewSubmission(CallbackQuickReply, "C1|1.0", map[string]map[string]slack.BlockAction{
+		BlockIDMessage: {ActionIDMessage: {Value: message}},
+	})
+	payload.User.ID = "U0"
+	return payload
+}
+
+func TestQuickReplySubmission(t *testing.T) {
+	tests := []struct {
+		name      string
+		message   string
+		wantError string
+	}{
+		{"posts to thread", "  저도 같은 생각이에요!  ", ""},
+		{"empty", "   ", "입력해주세요"},
+		{"too long", strings.Repeat("가", maxQuickReplyLength+1), "100자까지"},
+		{"at limit", strings.Repeat("가", maxQuickReplyLength), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: &Config{QuickReply: true}, slack: client}
+
+			resp, _ := app.handleViewSubmission(quickReplySubmission(tt.message))
+
+			posts := fs.callsTo("chat.postMessage")
+			if tt.wantError != "" {
+				if errs := responseErrors(t, resp.Body); !strings.Contains(errs[BlockIDMessage], tt.wantError) {
+					t.Errorf("에러 = %v, want %q 포함", errs, tt.wantError)
+				}
+				if len(posts) != 0 {
+					t.Errorf("검증 실패인데 게시됨 (%d회)", len(posts))
+				}
+				return
+			}
+			if len(posts) != 1 {
+				t.Fatalf("chat.postMessage 호출 수 = %d, want 1 (body=%s)", len(posts), resp.Body)
+			}
+			form := posts[0].Form
+			if form.Get("channel") != "C1" || form.Get("thread_ts") != "1.0" {
+				t.Errorf("channel=%q thread_ts=%q", form.Get("channel"), form.Get("thread_ts"))
+			}
+			text := blocksText(t, form.Get("blocks"))
+			if !strings.HasPrefix(text, "🎋 *익명*\n"+strings.TrimSpace(tt.message)) {
+				t.Errorf("답글 블록 = %q", text)
+			}
+			if strings.Contains(form.Get("blocks"), "U0") {
+				t.Error("답글에 작성자 ID가 포함됨")
+			}
+		})
+	}
+}
+
+func TestQuickReplyButtonOpensModal(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{QuickReply: true}, slack: client}
+
+	payload := emojiClick("C1", "1.0", "U1")
+	payload.TriggerID = "trigger"
+	payload.ActionCallback.BlockActions = []*slack.BlockAction{{ActionID: ActionQuickReplyButton}}
+	app.handleBlockAction(context.Background(), payload)
+
+	opens := fs.callsTo("views.open")
+	if len(opens) != 1 {
+		t.Fatalf("views.open 호출 수 = %d, want 1", len(opens))
+	}
+	// views.open은 form이 아니라 JSON 본문으로 호출된다
+	var req struct {
+		View slack.ModalViewRequest `json:"view"`
+	}
+	if err := json.Unmarshal([]byte(opens[0].Body), &req); err != nil {
+		t.Fatalf("views.open 본문 파싱 실패: %v", err)
+	}
+	view := req.View
+	if view.CallbackID != CallbackQuickReply || view.PrivateMetadata != "C1|1.0" {
+		t.Errorf("callback=%q metadata=%q", view.CallbackID, view.PrivateMetadata)
+	}
+	if n := len(view.Blocks.BlockSet); n != 1 {
+		t.Errorf("모달 블록 수 = %d, want 1 (입력칸 하나)", n)
+	}
+}
+
+func TestWithQuickReplyButton(t *testing.T) {
+	blocks := withQuickReplyButton(buildNewPostBlocks("본문", "", nil, "question", "normal", ""))
+
+	var actionIDs []string
+	for _, block := range blocks {
+		if b, ok := block.(*slack.ActionBlock); ok && b.BlockID != "emoji_actions" {
+			for _, el := range b.Elements.ElementSet {
+				actionIDs = append(actionIDs, el.(*slack.ButtonBlockElement).ActionID)
+			}
+		}
+	}
+	want := []string{ActionReplyButton, ActionQuickReplyButton, ActionCompleteButton}
+	if strings.Join(actionIDs, ",") != strings.Join(want, ",") {
+		t.Errorf("버튼 순서 = %v, want %v", actionIDs, want)
+	}
+}
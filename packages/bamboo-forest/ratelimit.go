@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+// ─────────────────────────────────────
+// 사용자별 새 글 제한 (RATE_LIMIT_POSTS, RATE_LIMIT_WINDOW_MINUTES)
+// 한 사람이 최근 N분 동안 올린 새 글이 한도에 닿으면 /bamboo 모달을 열지 않고 본인에게만 안내한다.
+// 모달을 미리 열어 둔 경우에 대비해 제출할 때도 한 번 더 확인한다.
+// Lambda는 상태가 없으므로 ratelimit 탭(A 사용자 해시 | B 게시 시각)에 글마다 한 행을 남긴다.
+// 사용자 ID는 목록을 알면 해시를 다시 만들 수 있으므로, 비밀 값(RATE_LIMIT_SALT, 없으면 서명 시크릿)으로
+// HMAC을 만들어 기록한다. 메시지 ts는 남기지 않아 어떤 글을 누가 썼는지 이어 붙일 수 없다.
+
+const defaultRateLimitWindowMinutes = 60
+
+func (app *App) rateLimitWindow() time.Duration {
+	if app.cfg.RateLimitWindowMinutes > 0 {
+		return time.Duration(app.cfg.RateLimitWindowMinutes) * time.Minute
+	}
+	return defaultRateLimitWindowMinutes * time.Minute
+}
+
+func (app *App) rateLimitEnabled() bool {
+	return app.cfg.RateLimitPosts > 0 && app.sheets != nil
+}
+
+// 사용자 해시: HMAC-SHA256(salt, userID)
+func (app *App) rateLimitHash(userID string) string {
+	salt := app.cfg.RateLimitSalt
+	if salt == "" {
+		salt = app.cfg.SlackSigningSecret
+	}
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte("ratelimit|" + userID))
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
+
+// 한도에 닿았으면 안내 문구, 아니면 "" (조회에 실패하면 막지 않음)
+func (app *App) checkRateLimit(ctx context.Context, userID string, now time.Time) string {
+	if !app.rateLimitEnabled() {
+		return ""
+	}
+
+	resp, err := app.sheets.Spreadsheets.Values.Get(app.cfg.SheetsID, "ratelimit!A:B").Context(ctx).Do()
+	if err != nil {
+		log.Printf("[경고] 게시 제한 조회 실패, 확인 없이 진행: %v", err)
+		return ""
+	}
+
+	hash := app.rateLimitHash(userID)
+	since := now.Add(-app.rateLimitWindow())
+	count := 0
+	for _, row := range resp.Values {
+		if len(row) < 2 {
+			continue
+		}
+		h, _ := row[0].(string)
+		s, _ := row[1].(string)
+		postedAt, err := time.Parse(time.RFC3339, s)
+		if err != nil || h != hash || !postedAt.After(since) {
+			continue
+		}
+		count++
+	}
+	if count < app.cfg.RateLimitPosts {
+		return ""
+	}
+	log.Printf("[스킵] 게시 제한 도달 (%d개/%s)", count, app.rateLimitWindow())
+	return fmt.Sprintf("최근 %d분 동안 새 글은 %d개까지 올릴 수 있어요. 잠시 후 다시 시도해주세요.",
+		int(app.rateLimitWindow().Minutes()), app.cfg.RateLimitPosts)
+}
+
+// 새 글 게시 기록
+func (app *App) recordRateLimitPost(ctx context.Context, userID string, now time.Time) {
+	if !app.rateLimitEnabled() || userID == "" {
+		return
+	}
+	_, err := app.sheets.Spreadsheets.Values.Append(
+		app.cfg.SheetsID,
+		"ratelimit!A:B",
+		&sheets.ValueRange{Values: [][]interface{}{{app.rateLimitHash(userID), now.Format(time.RFC3339)}}},
+	).ValueInputOption("RAW").Context(ctx).Do()
+	if err != nil {
+		log.Printf("[경고] 게시 제한 기록 실패: %v", err)
+	}
+}
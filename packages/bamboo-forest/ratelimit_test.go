@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func bambooCommand(userID string) string {
+	return url.Values{"command": {"/bamboo"}, "user_id": {userID}, "trigger_id": {"T1"}}.Encode()
+}
+
+func TestRateLimitSlashCommand(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		posted   []time.Duration // 이전 글을 올린 시점 (now 기준)
+		wantOpen bool
+	}{
+		{"under limit", []time.Duration{-10 * time.Minute}, true},
+		{"at limit", []time.Duration{-10 * time.Minute, -20 * time.Minute}, false},
+		{"old posts outside window", []time.Duration{-2 * time.Hour, -3 * time.Hour}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			sh, svc := newFakeSheets(t)
+			app := &App{cfg: &Config{SheetsID: "sheet", SlackSigningSecret: "secret", RateLimitPosts: 2}, slack: client, sheets: svc}
+			for _, d := range tt.posted {
+				sh.seed("ratelimit", []string{app.rateLimitHash("U1"), now.Add(d).Format(time.RFC3339)})
+			}
+			// 다른 사용자의 글은 세지 않음
+			sh.seed("ratelimit", []string{app.rateLimitHash("U2"), now.Format(time.RFC3339)}, []string{app.rateLimitHash("U2"), now.Format(time.RFC3339)})
+
+			resp, _ := app.handleSlashCommand(context.Background(), bambooCommand("U1"))
+
+			opens := len(fs.callsTo("views.open"))
+			if tt.wantOpen {
+				if opens != 1 {
+					t.Errorf("views.open 호출 수 = %d, want 1 (body=%s)", opens, resp.Body)
+				}
+				return
+			}
+			if opens != 0 {
+				t.Errorf("제한에 닿았는데 모달이 열림")
+			}
+			if !strings.Contains(resp.Body, "2개까지") {
+				t.Errorf("안내 = %q", resp.Body)
+			}
+		})
+	}
+}
+
+func TestRateLimitSubmissionRecordsSaltedHash(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	app := &App{cfg: &Config{SheetsID: "sheet", RateLimitPosts: 1, RateLimitSalt: "pepper"}, slack: client, sheets: svc}
+
+	submit := func() string {
+		payload := viewSubmission(CallbackNewPost, "", newPostValues("question", "normal"))
+		payload.User.ID = "U1"
+		resp, _ := app.handleViewSubmission(payload)
+		return resp.Body
+	}
+
+	if body := submit(); body != "" {
+		t.Fatalf("첫 글이 거절됨: %s", body)
+	}
+	rows := sh.rows("ratelimit")
+	if len(rows) != 1 || rows[0][0] != app.rateLimitHash("U1") {
+		t.Fatalf("ratelimit 행 = %v", rows)
+	}
+	if strings.Contains(strings.Join(rows[0], "|"), "U1") || strings.Contains(strings.Join(rows[0], "|"), "1700000000") {
+		t.Errorf("ratelimit 행에 사용자 ID나 메시지 ts가 기록됨: %v", rows[0])
+	}
+
+	// 모달을 미리 열어 둔 경우에도 제출 시 거절
+	if errs := responseErrors(t, submit()); !strings.Contains(errs[BlockIDMessage], "1개까지") {
+		t.Errorf("두 번째 글 에러 = %v", errs)
+	}
+	if n := len(fs.callsTo("chat.postMessage")); n != 1 {
+		t.Errorf("chat.postMessage 호출 수 = %d, want 1", n)
+	}
+}
+
+func TestRateLimitHashUsesSalt(t *testing.T) {
+	a := &App{cfg: &Config{RateLimitSalt: "salt-a"}}
+	b := &App{cfg: &Config{RateLimitSalt: "salt-b"}}
+	fallback := &App{cfg: &Config{SlackSigningSecret: "salt-a"}}
+
+	if a.rateLimitHash("U1") == b.rateLimitHash("U1") {
+		t.Error("salt가 달라도 해시가 같음")
+	}
+	if a.rateLimitHash("U1") != fallback.rateLimitHash("U1") {
+		t.Error("RATE_LIMIT_SALT가 없으면 서명 시크릿을 salt로 써야 함")
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"log"
+	"strings"
+)
+
+// ─────────────────────────────────────
+// 반응 이모지 구성 (REACTION_SET)
+// 기본 반응 버튼(👍 👎 🤗 💪) 대신 쓸 반응 목록을 설정으로 받는다. 목록 순서가 버튼과 카운트 표시 순서가 된다.
+// value는 버튼 value이자 reactions 탭에 기록되는 값이라, 이미 쌓인 반응을 계속 세려면 기존 값을 그대로 써야 한다.
+// 목록에서 빠진 값의 기록은 시트에 남지만 카운트에는 나타나지 않는다.
+
+// 반응 목록 한 항목 (action_id를 비우면 value로 만들고, weight를 비우면 1)
+type ReactionSetEntry struct {
+	ActionID string `json:"action_id"`
+	Value    string `json:"value"`
+	Emoji    string `json:"emoji"`
+	Weight   *int   `json:"weight"`
+}
+
+// 설정된 반응 목록 (value나 emoji가 비었거나 value/action_id가 겹치는 항목은 빼고 경고)
+func reactionSetEmojis(entries []ReactionSetEntry) []reactionEmoji {
+	var out []reactionEmoji
+	seenValue := map[string]bool{}
+	seenAction := map[string]bool{ActionEmojiMulti: true}
+	for _, entry := range entries {
+		value := strings.TrimSpace(entry.Value)
+		icon := strings.TrimSpace(entry.Emoji)
+		if value == "" || icon == "" {
+			log.Printf("[경고] REACTION_SET 항목 제외 (value/emoji 없음): %+v", entry)
+			continue
+		}
+		actionID := strings.TrimSpace(entry.ActionID)
+		if actionID == "" {
+			actionID = ActionEmojiCustomPrefix + value
+		}
+		if seenValue[value] || seenAction[actionID] {
+			log.Printf("[경고] REACTION_SET 항목 제외 (value 또는 action_id 중복): %s", value)
+			continue
+		}
+		seenValue[value] = true
+		seenAction[actionID] = true
+
+		weight := customEmojiWeight
+		if entry.Weight != nil {
+			weight = *entry.Weight
+		}
+		out = append(out, reactionEmoji{Name: value, ActionID: actionID, Icon: icon, Weight: weight})
+	}
+	return out
+}
+
+// 커스텀 이모지를 덧붙이기 전의 기본 반응 목록 (REACTION_SET이 없거나 쓸 항목이 없으면 기본 4종)
+func baseReactionEmojis(entries []ReactionSetEntry) []reactionEmoji {
+	if len(entries) == 0 {
+		return defaultReactionEmojis
+	}
+	set := reactionSetEmojis(entries)
+	if len(set) == 0 {
+		log.Println("[경고] REACTION_SET에 쓸 수 있는 항목이 없어 기본 반응 이모지 사용")
+		return defaultReactionEmojis
+	}
+	return set
+}
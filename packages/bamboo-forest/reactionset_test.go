@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+func intPtr(n int) *int { return &n }
+
+func TestReactionSetEmojis(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []ReactionSetEntry
+		want    []reactionEmoji
+	}{
+		{
+			name: "설정 순서 유지, action_id/weight 기본값",
+			entries: []ReactionSetEntry{
+				{Value: "heart", Emoji: "❤️", Weight: intPtr(2)},
+				{ActionID: ActionEmojiThumbsUp, Value: "thumbsup", Emoji: "👍"},
+			},
+			want: []reactionEmoji{
+				{Name: "heart", ActionID: ActionEmojiCustomPrefix + "heart", Icon: "❤️", Weight: 2},
+				{Name: "thumbsup", ActionID: ActionEmojiThumbsUp, Icon: "👍", Weight: 1},
+			},
+		},
+		{
+			name: "빈 값, 중복 value/action_id, 다중 선택 action_id는 제외",
+			entries: []ReactionSetEntry{
+				{Value: "", Emoji: "❓"},
+				{Value: "eyes", Emoji: ""},
+				{Value: "clap", Emoji: "👏", Weight: intPtr(0)},
+				{Value: "clap", Emoji: "🙌"},
+				{ActionID: ActionEmojiCustomPrefix + "clap", Value: "raised", Emoji: "🙌"},
+				{ActionID: ActionEmojiMulti, Value: "multi", Emoji: "➕"},
+			},
+			want: []reactionEmoji{
+				{Name: "clap", ActionID: ActionEmojiCustomPrefix + "clap", Icon: "👏", Weight: 0},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := reactionSetEmojis(tt.entries)
+			if len(got) != len(tt.want) {
+				t.Fatalf("reactionSetEmojis = %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("[%d] = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestReactionSetReplacesDefaults(t *testing.T) {
+	slackOptions = nil
+	t.Cleanup(func() { reactionEmojis = defaultReactionEmojis })
+
+	cfg := &Config{SlackBotToken: "xoxb-test", SlackSigningSecret: "secret", ReactionSet: []ReactionSetEntry{
+		{Value: "heart", Emoji: "❤️"},
+		{Value: "eyes", Emoji: "👀"},
+	}}
+	if _, err := NewApp(context.Background(), cfg); err != nil {
+		t.Fatalf("NewApp: %v", err)
+	}
+
+	// 버튼과 카운트는 설정 순서대로
+	buttons := emojiButtons()
+	if len(buttons) != 2 || buttons[0].(*slack.ButtonBlockElement).Value != "heart" || buttons[1].(*slack.ButtonBlockElement).Value != "eyes" {
+		t.Fatalf("버튼 = %+v", buttons)
+	}
+	if got, want := formatEmojiCounts(map[string]int{"eyes": 2, "thumbsup": 5}), "❤️ 0 │ 👀 2"; got != want {
+		t.Errorf("카운트 표시 = %q, want %q", got, want)
+	}
+
+	// 기본 이모지 버튼은 더 이상 반응 버튼이 아님
+	if isEmojiButtonAction(ActionEmojiThumbsUp) {
+		t.Error("REACTION_SET에 없는 기본 이모지 버튼이 반응으로 처리됨")
+	}
+
+	// 설정된 값은 시트에 그대로 기록되고 카운트도 설정 값으로 초기화
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	app := &App{cfg: &Config{SheetsID: "sheet"}, slack: client, sheets: svc}
+	payload := emojiClick("C1", "1.0", "U1")
+	payload.ActionCallback.BlockActions = []*slack.BlockAction{{ActionID: ActionEmojiCustomPrefix + "eyes", Value: "eyes"}}
+	app.handleBlockAction(context.Background(), payload)
+
+	rows := sh.rows("reactions")
+	if len(rows) != 1 || rows[0][2] != "eyes" {
+		t.Fatalf("reactions 행 = %v, want eyes 1건", rows)
+	}
+	if n := len(fs.callsTo("chat.update")); n != 1 {
+		t.Errorf("chat.update 호출 수 = %d, want 1", n)
+	}
+	counts, err := app.getEmojiCounts(context.Background(), "1.0")
+	if err != nil {
+		t.Fatalf("getEmojiCounts: %v", err)
+	}
+	if len(counts) != 2 || counts["heart"] != 0 || counts["eyes"] != 1 {
+		t.Errorf("counts = %v, want heart 0, eyes 1", counts)
+	}
+}
+
+func TestReactionSetEmptyFallsBackToDefaults(t *testing.T) {
+	got := baseReactionEmojis([]ReactionSetEntry{{Value: "", Emoji: ""}})
+	if len(got) != len(defaultReactionEmojis) || got[0] != defaultReactionEmojis[0] {
+		t.Errorf("baseReactionEmojis = %+v, want 기본 이모지", got)
+	}
+}
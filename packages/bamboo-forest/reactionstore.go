@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+	"google.golang.org/api/sheets/v4"
+)
+
+// ─────────────────────────────────────
+// 반응 저장소 (REACTION_STORE)
+// 이모지 반응의 중복 확인, 기록, 카운트 조회를 저장소 인터페이스 뒤로 모은다.
+// 기본은 지금까지처럼 Google Sheets reactions 탭이고, 반응이 많아 Sheets 전체 조회가 느리거나
+// 할당량에 걸리면 DynamoDB 테이블(dynamodb.go)을 쓸 수 있다.
+// 오래된 반응 삭제(REACTION_RETENTION_DAYS)와 떠난 사용자 정리(REACTION_SWEEP)는 Sheets 저장소에서만 동작한다.
+
+const (
+	ReactionStoreSheets   = "sheets"
+	ReactionStoreDynamoDB = "dynamodb"
+)
+
+type ReactionStore interface {
+	// 주어진 해시 중 이미 기록된 것
+	CheckDuplicate(ctx context.Context, hashes []string) (map[string]bool, error)
+	// 반응 기록 (여러 개면 한 번에)
+	Record(ctx context.Context, messageTS string, reactions []pendingReaction) error
+	// 글 하나의 이모지별 반응 수 (같은 해시는 한 번만 셈)
+	Counts(ctx context.Context, messageTS string) (map[string]int, error)
+	// 해시가 같은 반응을 지우고 지운 수를 반환 (반응 취소)
+	Remove(ctx context.Context, hash string) (int, error)
+}
+
+// REACTION_STORE에 맞는 저장소 준비 (sheets는 Sheets 클라이언트를 그대로 씀)
+func (app *App) initReactionStore(ctx context.Context) error {
+	switch strings.ToLower(strings.TrimSpace(app.cfg.ReactionStore)) {
+	case "", ReactionStoreSheets:
+		return nil
+	case ReactionStoreDynamoDB:
+		if app.cfg.DynamoDBTable == "" {
+			return fmt.Errorf("REACTION_STORE=dynamodb에는 DYNAMODB_REACTIONS_TABLE이 필요합니다")
+		}
+		awsCfg, err := config.LoadDefaultConfig(ctx)
+		if err != nil {
+			return fmt.Errorf("AWS 설정 로드 실패: %w", err)
+		}
+		index := app.cfg.DynamoDBMessageIndex
+		if index == "" {
+			index = defaultDynamoMessageIndex
+		}
+		app.reactions = dynamoReactionStore{
+			client:    newDynamoClient(awsCfg, app.cfg.DynamoDBEndpoint),
+			table:     app.cfg.DynamoDBTable,
+			index:     index,
+			retention: time.Duration(app.cfg.ReactionRetentionDays) * 24 * time.Hour,
+		}
+		log.Printf("[성공] DynamoDB 반응 저장소 사용 (table=%s, index=%s)", app.cfg.DynamoDBTable, index)
+		return nil
+	default:
+		return fmt.Errorf("REACTION_STORE 값 오류 (%q, sheets 또는 dynamodb)", app.cfg.ReactionStore)
+	}
+}
+
+// 설정된 반응 저장소 (없으면 nil, 반응 기능 비활성화)
+func (app *App) reactionStore() ReactionStore {
+	if app.reactions != nil {
+		return app.reactions
+	}
+	if app.sheets != nil {
+		return sheetsReactionStore{app: app}
+	}
+	return nil
+}
+
+// 보관 기간 삭제와 떠난 사용자 정리처럼 시트 행을 직접 다루는 작업을 할 수 있는지
+func (app *App) sheetsReactionsActive() bool {
+	_, ok := app.reactionStore().(sheetsReactionStore)
+	return ok
+}
+
+// ─────────────────────────────────────
+// Google Sheets 저장소 (reactions 탭: A 해시 | B 메시지 ts | C 이모지 | D 기록 시각 | E salt 버전)
+
+type sheetsReactionStore struct {
+	app *App
+}
+
+func (s sheetsReactionStore) CheckDuplicate(ctx context.Context, hashes []string) (map[string]bool, error) {
+	// A열 해시 목록
+	resp, err := s.app.sheets.Spreadsheets.Values.Get(s.app.cfg.SheetsID, "reactions!A:A").Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("Sheets 조회 실패: %w", err)
+	}
+
+	wanted := map[string]bool{}
+	for _, h := range hashes {
+		wanted[h] = true
+	}
+	found := map[string]bool{}
+	for _, row := range resp.Values {
+		if len(row) == 0 {
+			continue
+		}
+		if hash, ok := row[0].(string); ok && wanted[hash] {
+			found[hash] = true
+		}
+	}
+	return found, nil
+}
+
+func (s sheetsReactionStore) Record(ctx context.Context, messageTS string, reactions []pendingReaction) error {
+	now := time.Now().Format(time.RFC3339)
+	version := s.app.reactionSaltVersion()
+	values := make([][]interface{}, 0, len(reactions))
+	for _, r := range reactions {
+		values = append(values, []interface{}{r.Hash, messageTS, r.Emoji, now, version})
+	}
+
+	_, err := s.app.sheets.Spreadsheets.Values.Append(
+		s.app.cfg.SheetsID,
+		"reactions!A:E",
+		&sheets.ValueRange{Values: values},
+	).ValueInputOption("RAW").Context(ctx).Do()
+	return err
+}
+
+func (s sheetsReactionStore) Counts(ctx context.Context, messageTS string) (map[string]int, error) {
+	resp, err := s.app.sheets.Spreadsheets.Values.Get(s.app.cfg.SheetsID, "reactions!A:C").Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("Sheets 조회 실패: %w", err)
+	}
+	log.Printf("[디버그] 카운트 계산에 reactions 탭 %d행 조회 (ts=%s)", len(resp.Values), messageTS)
+
+	counts := map[string]int{}
+	seen := map[string]bool{}
+	for _, row := range resp.Values {
+		if len(row) >= 3 {
+			hash, _ := row[0].(string)
+			ts, ok1 := row[1].(string)
+			emoji, ok2 := row[2].(string)
+			if ok1 && ok2 && ts == messageTS {
+				if hash != "" && seen[hash] {
+					continue
+				}
+				seen[hash] = true
+				counts[emoji]++
+			}
+		}
+	}
+	return counts, nil
+}
+
+func (s sheetsReactionStore) Remove(ctx context.Context, hash string) (int, error) {
+	rows, err := s.app.loadReactionRows(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var mine []reactionRow
+	for _, r := range rows {
+		if r.Hash == hash {
+			mine = append(mine, r)
+		}
+	}
+	removed, _ := s.app.clearReactionRows(ctx, mine)
+	return removed, nil
+}
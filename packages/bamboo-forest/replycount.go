@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 답글 수 표시
+// 익명 답글(빠른 한마디 포함)이 게시되면 원글 헤더 끝에 "💬 N개의 답글"을 붙이거나 고친다.
+// 답글 수는 따로 기록하지 않고 Slack이 원글에 남기는 reply_count를 그대로 쓰므로, 스레드에 직접 단 답글도 함께 센다.
+// 헤더의 다른 요소(처리 완료 표시, 수정됨, 게시 시각)와 block_id는 그대로 둔다.
+
+const replyCountPrefix = "💬 "
+
+func replyCountText(n int) string {
+	return fmt.Sprintf("%s%d개의 답글", replyCountPrefix, n)
+}
+
+// 원글 헤더(첫 컨텍스트 블록)의 답글 수 요소를 n으로 바꾼 블록 (없으면 끝에 추가)
+func withReplyCount(blocks []slack.Block, n int) []slack.Block {
+	out := make([]slack.Block, 0, len(blocks))
+	done := false
+	for _, block := range blocks {
+		b, ok := block.(*slack.ContextBlock)
+		if !ok || done || b.BlockID == "emoji_counts" {
+			out = append(out, block)
+			continue
+		}
+		var elements []slack.MixedElement
+		for _, el := range b.ContextElements.Elements {
+			if text, ok := el.(*slack.TextBlockObject); ok && strings.HasPrefix(text.Text, replyCountPrefix) {
+				continue
+			}
+			elements = append(elements, el)
+		}
+		elements = append(elements, slack.NewTextBlockObject("mrkdwn", replyCountText(n), false, false))
+		out = append(out, slack.NewContextBlock(b.BlockID, elements...))
+		done = true
+	}
+	return out
+}
+
+// 원글을 다시 읽어 헤더의 답글 수 갱신
+func (app *App) refreshReplyCount(ctx context.Context, channelID, threadTS string) error {
+	msgs, _, _, err := app.slack.GetConversationRepliesContext(ctx, &slack.GetConversationRepliesParameters{
+		ChannelID: channelID,
+		Timestamp: threadTS,
+		Limit:     1,
+	})
+	if err != nil {
+		return fmt.Errorf("원글 조회 실패: %w", err)
+	}
+	if len(msgs) == 0 || msgs[0].Timestamp != threadTS {
+		return fmt.Errorf("원글을 찾을 수 없음 (ts=%s)", threadTS)
+	}
+	parent := msgs[0]
+	if len(parent.Blocks.BlockSet) == 0 {
+		return nil
+	}
+
+	_, _, _, err = app.slack.UpdateMessageContext(ctx, channelID, threadTS,
+		slack.MsgOptionBlocks(withReplyCount(parent.Blocks.BlockSet, parent.ReplyCount)...))
+	if err != nil {
+		return err
+	}
+	log.Printf("[정보] 답글 수 갱신 (ts=%s, replies=%d)", threadTS, parent.ReplyCount)
+	return nil
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+func TestWithReplyCount(t *testing.T) {
+	post := buildNewPostBlocks("글", "", nil, "question", "normal", "")
+	tests := []struct {
+		name       string
+		blocks     []slack.Block
+		n          int
+		wantHeader string
+		wantID     string
+	}{
+		{"first reply", post, 1, "🎋 *익명* │ ❓ 질문 │ 🟡 보통 💬 1개의 답글", ""},
+		{"replaces previous count", withReplyCount(post, 1), 2, "🎋 *익명* │ ❓ 질문 │ 🟡 보통 💬 2개의 답글", ""},
+		{"completed post keeps mark", completedBlocks(post, "UADMIN"), 3, "🎋 *익명* │ ❓ 질문 │ 🟡 보통 │ ✅ 처리됨 (<@UADMIN>) 💬 3개의 답글", completedHeaderBlockPrefix + "UADMIN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := withReplyCount(tt.blocks, tt.n)
+			if len(got) != len(tt.blocks) {
+				t.Fatalf("블록 수 = %d, want %d", len(got), len(tt.blocks))
+			}
+			header := got[0].(*slack.ContextBlock)
+			var texts []string
+			for _, el := range header.ContextElements.Elements {
+				texts = append(texts, el.(*slack.TextBlockObject).Text)
+			}
+			if joined := strings.Join(texts, " "); joined != tt.wantHeader {
+				t.Errorf("헤더 = %q, want %q", joined, tt.wantHeader)
+			}
+			if header.BlockID != tt.wantID {
+				t.Errorf("block_id = %q, want %q", header.BlockID, tt.wantID)
+			}
+			counts := got[2].(*slack.ContextBlock)
+			if counts.BlockID != "emoji_counts" || len(counts.ContextElements.Elements) != 1 {
+				t.Errorf("emoji_counts 블록이 바뀜: %+v", counts)
+			}
+		})
+	}
+}
+
+func TestThreadReplyUpdatesParentReplyCount(t *testing.T) {
+	tests := []struct {
+		name   string
+		parent []slack.Block
+	}{
+		{"open post", buildNewPostBlocks("글", "", nil, "question", "normal", "")},
+		{"completed post", completedBlocks(buildNewPostBlocks("글", "", nil, "question", "normal", ""), "UADMIN")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			raw, _ := json.Marshal(map[string]interface{}{
+				"ok": true,
+				"messages": []map[string]interface{}{
+					{"ts": "1.0", "text": "글", "reply_count": 3, "blocks": tt.parent},
+				},
+			})
+			fs.responses["conversations.replies"] = string(raw)
+			app := &App{cfg: &Config{}, slack: client}
+
+			resp, _ := app.handleViewSubmission(viewSubmission(CallbackNewThread, "C1|1.0", map[string]map[string]slack.BlockAction{
+				BlockIDMessage: {ActionIDMessage: {Value: "저도 궁금해요"}},
+				BlockIDConfirm: {ActionIDConfirm: {SelectedOptions: []slack.OptionBlockObject{{Value: "confirmed"}}}},
+			}))
+			if resp.Body != "" {
+				t.Fatalf("답글 제출 실패: %s", resp.Body)
+			}
+
+			updates := fs.callsTo("chat.update")
+			if len(updates) != 1 || updates[0].Form.Get("ts") != "1.0" {
+				t.Fatalf("원글 업데이트 = %v", updates)
+			}
+			text := blocksText(t, updates[0].Form.Get("blocks"))
+			if !strings.Contains(text, "💬 3개의 답글") {
+				t.Errorf("답글 수 없음: %s", text)
+			}
+			completed := strings.Contains(updates[0].Form.Get("blocks"), completedHeaderBlockPrefix+"UADMIN")
+			if wantCompleted := tt.name == "completed post"; completed != wantCompleted || strings.Contains(text, "✅ 처리됨") != wantCompleted {
+				t.Errorf("처리 완료 표시 유지 = %v, want %v", completed, wantCompleted)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 오래된 반응 기록 삭제 (REACTION_RETENTION_DAYS) + 운영 채널 요약 (OPS_CHANNEL)
+// 반응 해시는 중복 방지에만 필요하므로 보관 기간이 지나면 reactions 탭에서 지운다.
+// 지운 직후에는 글에 표시된 카운트가 그대로지만, 그 글에 누군가 다시 반응하면 카운트를 남은 행으로 다시 세므로
+// 지운 반응만큼 줄어든다. 또 지운 반응의 주인은 같은 이모지를 다시 누를 수 있다 (중복으로 막히지 않고 새 반응으로 기록).
+// 정리 결과는 개인정보 보관 조치의 기록으로 운영 채널에 요약해 올린다.
+
+// 보관 기간(created_at 기준)이 지난 반응 (시각을 읽을 수 없는 행은 남긴다)
+func expiredReactions(rows []reactionRow, cutoff time.Time) []reactionRow {
+	var expired []reactionRow
+	for _, r := range rows {
+		createdAt, err := time.Parse(time.RFC3339, r.CreatedAt)
+		if err != nil {
+			continue
+		}
+		if createdAt.Before(cutoff) {
+			expired = append(expired, r)
+		}
+	}
+	return expired
+}
+
+// 보관 기간이 지난 반응 기록을 지우고 지운 수를 반환
+func (app *App) purgeExpiredReactions(ctx context.Context, now time.Time) (int, error) {
+	days := app.cfg.ReactionRetentionDays
+	// DynamoDB 저장소는 기록할 때 넣은 만료 시각(TTL)으로 지워진다
+	if days <= 0 || !app.sheetsReactionsActive() {
+		return 0, nil
+	}
+
+	rows, err := app.loadReactionRows(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	removed, _ := app.clearReactionRows(ctx, expiredReactions(rows, now.AddDate(0, 0, -days)))
+	log.Printf("[정보] %d일 지난 반응 %d개 삭제", days, removed)
+	return removed, nil
+}
+
+// 정리한 내용이 있으면 운영 채널에 요약을 올림
+func (app *App) postCleanupSummary(purged, departed int) {
+	var lines []string
+	if purged > 0 {
+		lines = append(lines, fmt.Sprintf("• %d일 지난 리액션 %s건 삭제", app.cfg.ReactionRetentionDays, formatThousands(purged)))
+	}
+	if departed > 0 {
+		lines = append(lines, fmt.Sprintf("• 떠난 사용자 리액션 %s건 삭제", formatThousands(departed)))
+	}
+	if len(lines) == 0 || app.cfg.OpsChannel == "" {
+		return
+	}
+
+	text := "🧹 반응 기록 정리\n" + strings.Join(lines, "\n")
+	if _, _, err := app.slack.PostMessage(app.cfg.OpsChannel, slack.MsgOptionText(text, false)); err != nil {
+		log.Printf("[경고] 정리 요약 게시 실패 (channel=%s): %v", app.cfg.OpsChannel, err)
+	}
+}
+
+// 1234 → "1,234"
+func formatThousands(n int) string {
+	if n < 0 {
+		return "-" + formatThousands(-n)
+	}
+	s := strconv.Itoa(n)
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return s
+}
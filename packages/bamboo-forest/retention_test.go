@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPurgeExpiredReactionsPostsSummary(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	old := now.AddDate(0, 0, -91).Format(time.RFC3339)
+	recent := now.AddDate(0, 0, -10).Format(time.RFC3339)
+
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	sh.seed("reactions",
+		[]string{"hash", "message_ts", "emoji", "created_at"},
+		[]string{"h1", "1.0", "thumbsup", old},
+		[]string{"h2", "1.0", "hug", old},
+		[]string{"h3", "2.0", "hug", old},
+		[]string{"h4", "3.0", "thumbsup", recent},
+		[]string{"h5", "3.0", "muscle", "알 수 없음"},
+	)
+	app := &App{cfg: &Config{SheetsID: "sheet", ReactionRetentionDays: 90, OpsChannel: "COPS"}, slack: client, sheets: svc}
+
+	app.handleScheduled(context.Background(), now)
+
+	rows := sh.rows("reactions")
+	if len(rows) != 3 {
+		t.Fatalf("남은 행 = %v, want 헤더 + 최근 반응 + 시각 없는 반응", rows)
+	}
+	for _, row := range rows[1:] {
+		if row[3] == old {
+			t.Errorf("보관 기간이 지난 반응이 남아 있음: %v", row)
+		}
+	}
+
+	posts := fs.callsTo("chat.postMessage")
+	if len(posts) != 1 {
+		t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+	}
+	if ch := posts[0].Form.Get("channel"); ch != "COPS" {
+		t.Errorf("요약 채널 = %q, want COPS", ch)
+	}
+	if text := posts[0].Form.Get("text"); !strings.Contains(text, "90일 지난 리액션 3건 삭제") {
+		t.Errorf("요약 = %q", text)
+	}
+}
+
+func TestPostCleanupSummary(t *testing.T) {
+	tests := []struct {
+		name     string
+		ops      string
+		purged   int
+		departed int
+		want     []string
+	}{
+		{"both", "COPS", 1234, 2, []string{"90일 지난 리액션 1,234건 삭제", "떠난 사용자 리액션 2건 삭제"}},
+		{"nothing removed", "COPS", 0, 0, nil},
+		{"no ops channel", "", 5, 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: &Config{ReactionRetentionDays: 90, OpsChannel: tt.ops}, slack: client}
+
+			app.postCleanupSummary(tt.purged, tt.departed)
+
+			posts := fs.callsTo("chat.postMessage")
+			if tt.want == nil {
+				if len(posts) != 0 {
+					t.Errorf("요약을 올리지 않아야 함 (%d회)", len(posts))
+				}
+				return
+			}
+			if len(posts) != 1 {
+				t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+			}
+			for _, want := range tt.want {
+				if text := posts[0].Form.Get("text"); !strings.Contains(text, want) {
+					t.Errorf("요약 = %q, want %q 포함", text, want)
+				}
+			}
+		})
+	}
+}
+
+func TestFormatThousands(t *testing.T) {
+	tests := map[int]string{0: "0", 999: "999", 1000: "1,000", 1234567: "1,234,567", -1234: "-1,234"}
+	for n, want := range tests {
+		if got := formatThousands(n); got != want {
+			t.Errorf("formatThousands(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// ─────────────────────────────────────
+// Lambda 진입점
+// Function URL 요청(Slack)과 EventBridge 스케줄 이벤트를 같은 함수에서 처리한다.
+// 스케줄 이벤트는 Slack 서명 검증을 거치지 않으므로 handler와 분리한다.
+
+func isScheduledEvent(raw json.RawMessage) bool {
+	var probe struct {
+		Source     string `json:"source"`
+		DetailType string `json:"detail-type"`
+	}
+	if err := json.Unmarshal(raw, &probe); err != nil {
+		return false
+	}
+	return probe.Source == "aws.events" && probe.DetailType == "Scheduled Event"
+}
+
+func (app *App) invoke(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+	if isScheduledEvent(raw) {
+		log.Println("[요청] 스케줄 실행")
+		app.handleScheduled(ctx, time.Now())
+		return nil, nil
+	}
+
+	var req events.LambdaFunctionURLRequest
+	if err := json.Unmarshal(raw, &req); err != nil {
+		log.Printf("[에러] 요청 파싱 실패: %v", err)
+		return events.LambdaFunctionURLResponse{StatusCode: 400}, nil
+	}
+	return app.handler(ctx, req)
+}
+
+// 주기 작업 (각 작업의 실패는 다른 작업에 영향을 주지 않는다)
+func (app *App) handleScheduled(ctx context.Context, now time.Time) {
+	if _, err := app.escalateOverdueUrgentPosts(ctx, now); err != nil {
+		log.Printf("[에러] 긴급 글 알림 실패: %v", err)
+	}
+	if _, err := app.escalatePendingReviews(ctx, now); err != nil {
+		log.Printf("[에러] 검토 단계 이관 실패: %v", err)
+	}
+	purged, err := app.purgeExpiredReactions(ctx, now)
+	if err != nil {
+		log.Printf("[에러] 오래된 반응 삭제 실패: %v", err)
+	}
+	departed, err := app.sweepDepartedReactions(ctx)
+	if err != nil {
+		log.Printf("[에러] 떠난 사용자 반응 정리 실패: %v", err)
+	}
+	app.postCleanupSummary(purged, departed)
+	if _, err := app.postGratitudeSummary(ctx, now); err != nil {
+		log.Printf("[에러] 감사 릴레이 요약 실패: %v", err)
+	}
+	if _, err := app.postDigest(ctx, now); err != nil {
+		log.Printf("[에러] 주간 요약 실패: %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+func TestReactionScore(t *testing.T) {
+	tests := []struct {
+		name    string
+		counts  map[string]int
+		weights map[string]int
+		want    int
+	}{
+		{
+			name:   "default_weights",
+			counts: map[string]int{"thumbsup": 3, "thumbsdown": 1, "hug": 2, "flex": 1},
+			want:   3 - 1 + 4 + 1,
+		},
+		{
+			name:    "configured_weights_override",
+			counts:  map[string]int{"thumbsup": 2, "thumbsdown": 3, "hug": 1},
+			weights: map[string]int{"thumbsdown": -2, "hug": 5},
+			want:    2 - 6 + 5,
+		},
+		{
+			name:    "unknown_emoji_ignored",
+			counts:  map[string]int{"thumbsup": 1, "party": 10},
+			weights: map[string]int{"party": 3},
+			want:    1,
+		},
+		{
+			name: "no_reactions",
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := reactionScore(tt.counts, tt.weights); got != tt.want {
+				t.Errorf("reactionScore = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatReactionSummary(t *testing.T) {
+	counts := map[string]int{"thumbsup": 2, "thumbsdown": 1}
+
+	plain := (&App{cfg: &Config{}}).formatReactionSummary(counts)
+	if plain != "👍 2 │ 👎 1 │ 🤗 0 │ 💪 0" {
+		t.Errorf("가중치 미설정 요약 = %q", plain)
+	}
+
+	weighted := (&App{cfg: &Config{ReactionWeights: map[string]int{"thumbsup": 1, "thumbsdown": -1}}}).formatReactionSummary(counts)
+	if !strings.HasSuffix(weighted, "│ 📊 반응 점수 1") {
+		t.Errorf("가중치 설정 요약 = %q", weighted)
+	}
+}
+
+func TestHandleEmojiReactionRecomputesScore(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	sh.seed("reactions",
+		[]string{reactionHashFor("U2", "1.0", "thumbsup"), "1.0", "thumbsup", "t"},
+		[]string{reactionHashFor("U3", "1.0", "thumbsdown"), "1.0", "thumbsdown", "t"},
+	)
+	app := &App{
+		cfg:    &Config{SheetsID: "sheet", ReactionWeights: map[string]int{"thumbsup": 1, "thumbsdown": -1, "hug": 2}},
+		slack:  client,
+		sheets: svc,
+	}
+
+	counts := slack.NewContextBlock("emoji_counts", slack.NewTextBlockObject("mrkdwn", "", false, false))
+	app.handleEmojiReaction(context.Background(), emojiClick("C1", "1.0", "U1", counts), ActionEmojiHug, "hug")
+
+	updates := fs.callsTo("chat.update")
+	if len(updates) != 1 {
+		t.Fatalf("chat.update 호출 수 = %d, want 1", len(updates))
+	}
+	if text := blocksText(t, updates[0].Form.Get("blocks")); !strings.Contains(text, "반응 점수 2") {
+		t.Errorf("반응 점수 갱신 안 됨: %s", text)
+	}
+}
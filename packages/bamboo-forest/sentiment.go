@@ -0,0 +1,54 @@
+package main
+
+import "strings"
+
+// ─────────────────────────────────────
+// 게시글 감정 태그 (SENTIMENT_TAGGING, opt-in)
+// 조직 분위기 추이를 보기 위해 글마다 긍정/부정/중립 라벨만 posts 탭 K열에 남긴다.
+// 외부 AI 서비스 없이 한국어/일본어 긍정·부정 단어 목록으로 점수를 매기며,
+// 본문과 작성자 정보는 기록하지 않는다. 한국어는 활용형이 많아 어간 부분 일치로 센다.
+
+const (
+	SentimentPositive = "positive"
+	SentimentNegative = "negative"
+	SentimentNeutral  = "neutral"
+)
+
+var positiveWords = []string{
+	// 한국어
+	"감사", "고마", "좋아", "좋았", "좋은", "좋다", "행복", "기쁘", "기뻐", "즐거", "즐겁", "만족", "최고", "뿌듯", "든든", "칭찬", "다행", "훌륭", "멋지", "멋있", "응원",
+	// 일본어
+	"ありがとう", "感謝", "嬉しい", "うれしい", "楽しい", "良かった", "よかった", "最高", "満足", "助かり", "助かる", "素晴らしい", "幸せ", "安心",
+}
+
+var negativeWords = []string{
+	// 한국어
+	"힘들", "힘드", "피곤", "지치", "지쳤", "짜증", "불만", "불안", "걱정", "스트레스", "우울", "화나", "화가", "싫어", "싫다", "답답", "서운", "속상", "괴롭", "부당", "억울", "최악", "실망", "불편",
+	// 일본어
+	"辛い", "つらい", "疲れ", "不満", "不安", "心配", "ストレス", "嫌い", "嫌だ", "最悪", "困っ", "悲しい", "イライラ", "がっかり", "しんどい",
+}
+
+// 긍정 단어 수 - 부정 단어 수
+func sentimentScore(text string) int {
+	text = strings.ToLower(text)
+	score := 0
+	for _, w := range positiveWords {
+		score += strings.Count(text, w)
+	}
+	for _, w := range negativeWords {
+		score -= strings.Count(text, w)
+	}
+	return score
+}
+
+// 글의 감정 라벨 (단어가 없거나 긍정/부정이 같으면 중립)
+func sentimentLabel(text string) string {
+	switch score := sentimentScore(text); {
+	case score > 0:
+		return SentimentPositive
+	case score < 0:
+		return SentimentNegative
+	default:
+		return SentimentNeutral
+	}
+}
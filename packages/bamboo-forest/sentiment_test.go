@@ -0,0 +1,54 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+func TestSentimentLabel(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"ko positive", "이번 프로젝트 정말 고마웠어요. 팀 덕분에 행복했습니다!", SentimentPositive},
+		{"ko negative", "야근이 계속돼서 너무 힘들고 스트레스가 심해요", SentimentNegative},
+		{"ko neutral", "회의실 예약은 어디서 하나요?", SentimentNeutral},
+		{"ko mixed leans positive", "칭찬은 좋은데 일정이 걱정돼요", SentimentPositive},
+		{"ko discomfort is negative", "새 자리가 좀 불편해요", SentimentNegative},
+		{"ja positive", "いつも助かります。ありがとうございます", SentimentPositive},
+		{"ja negative", "最近疲れていて、不安です", SentimentNegative},
+		{"ja neutral", "来週の会議は何時からですか", SentimentNeutral},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sentimentLabel(tt.text); got != tt.want {
+				t.Errorf("sentimentLabel(%q) = %q (score=%d), want %q", tt.text, got, sentimentScore(tt.text), tt.want)
+			}
+		})
+	}
+}
+
+func TestSentimentRecordedWithoutContent(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	app := &App{cfg: &Config{SheetsID: "sheet", SentimentTagging: true}, slack: client, sheets: svc}
+
+	values := newPostValues("concern", "normal")
+	values[BlockIDMessage] = map[string]slack.BlockAction{ActionIDMessage: {Value: "요즘 너무 지치고 우울해요"}}
+	app.handleViewSubmission(viewSubmission(CallbackNewPost, "", values))
+
+	if n := len(fs.callsTo("chat.postMessage")); n != 1 {
+		t.Fatalf("chat.postMessage 호출 수 = %d, want 1", n)
+	}
+	rows := sh.rows("posts")
+	if len(rows) != 1 || len(rows[0]) < 11 || rows[0][10] != SentimentNegative {
+		t.Fatalf("posts 행 = %v, want K열 %s", rows, SentimentNegative)
+	}
+	// 본문은 기록하지 않는다
+	if strings.Contains(strings.Join(rows[0], "|"), "우울") {
+		t.Errorf("posts 행에 본문이 기록됨: %v", rows[0])
+	}
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/slack-go/slack"
+	"google.golang.org/api/sheets/v4"
+)
+
+// ─────────────────────────────────────
+// 떠난 사용자 반응 정리 (REACTION_SWEEP, opt-in)
+// 반응은 hash(userID|messageTS|emoji)로만 기록되므로 누가 남겼는지 알 수 없다.
+// 대신 현재 활성 멤버 전원의 해시를 만들어 보고, 어느 멤버와도 맞지 않는 반응 행을 지운 뒤
+// 해당 글의 이모지 카운트를 다시 계산한다. 스케줄 실행(handleScheduled)에서 호출된다.
+// 지금 salt로 만든 행(64자 해시, E열 salt 버전이 같은 행)만 비교한다. 예전 형식으로 기록됐거나
+// REACTION_HASH_SALT를 바꾸기 전에 기록된 행은 누구의 해시와도 맞지 않으므로 떠난 사용자로 보지 않고 그대로 둔다.
+
+// 기록된 반응 한 행 (Row는 1부터 시작하는 시트 행 번호)
+type reactionRow struct {
+	Row       int
+	Hash      string
+	MessageTS string
+	Emoji     string
+	CreatedAt string
+	Version   string // 해시를 만든 salt 버전 (예전 행은 "")
+}
+
+// reactions 탭의 반응 행 전체 (헤더나 빈 행처럼 반응이 아닌 행은 제외)
+func (app *App) loadReactionRows(ctx context.Context) ([]reactionRow, error) {
+	resp, err := app.sheets.Spreadsheets.Values.Get(app.cfg.SheetsID, "reactions!A:E").Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("Sheets 조회 실패: %w", err)
+	}
+
+	var rows []reactionRow
+	for i, row := range resp.Values {
+		if len(row) < 3 {
+			continue
+		}
+		hash, _ := row[0].(string)
+		ts, _ := row[1].(string)
+		emoji, _ := row[2].(string)
+		if hash == "" || !isReactionEmoji(emoji) {
+			continue
+		}
+		r := reactionRow{Row: i + 1, Hash: hash, MessageTS: ts, Emoji: emoji}
+		if len(row) > 3 {
+			r.CreatedAt, _ = row[3].(string)
+		}
+		if len(row) > 4 {
+			r.Version, _ = row[4].(string)
+		}
+		rows = append(rows, r)
+	}
+	return rows, nil
+}
+
+// 워크스페이스의 활성(비활성화되지 않은) 사용자 ID
+func (app *App) activeUserIDs(ctx context.Context) ([]string, error) {
+	users, err := app.slack.GetUsersContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("사용자 목록 조회 실패 (users:read 스코프 확인): %w", err)
+	}
+	var ids []string
+	for _, u := range users {
+		if !u.Deleted {
+			ids = append(ids, u.ID)
+		}
+	}
+	return ids, nil
+}
+
+// 활성 멤버 누구의 해시와도 맞지 않는 반응 (떠난 사용자의 반응, 지금 salt로 만든 행만)
+func (app *App) departedReactions(rows []reactionRow, activeUserIDs []string) []reactionRow {
+	version := app.reactionSaltVersion()
+	var current []reactionRow
+	for _, r := range rows {
+		if len(r.Hash) == 64 && r.Version == version {
+			current = append(current, r)
+		}
+	}
+
+	// 글·이모지 조합마다 한 번씩만 멤버 해시를 만든다
+	active := map[string]bool{}
+	seen := map[string]bool{}
+	for _, r := range current {
+		key := r.MessageTS + "|" + r.Emoji
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		for _, userID := range activeUserIDs {
+			active[app.generateReactionHash(userID, r.MessageTS, r.Emoji)] = true
+		}
+	}
+
+	var departed []reactionRow
+	for _, r := range current {
+		if !active[r.Hash] {
+			departed = append(departed, r)
+		}
+	}
+	return departed
+}
+
+// 떠난 사용자의 반응을 지우고 카운트가 바뀐 글을 갱신한 뒤 지운 반응 수를 반환
+func (app *App) sweepDepartedReactions(ctx context.Context) (int, error) {
+	if !app.cfg.ReactionSweep || app.sheets == nil {
+		return 0, nil
+	}
+	if !app.sheetsReactionsActive() {
+		log.Println("[스킵] 떠난 사용자 반응 정리는 Sheets 반응 저장소에서만 동작")
+		return 0, nil
+	}
+
+	rows, err := app.loadReactionRows(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	userIDs, err := app.activeUserIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	// 목록이 비어 있으면 모든 반응이 지워지므로 정리하지 않는다
+	if len(userIDs) == 0 {
+		return 0, fmt.Errorf("활성 사용자 목록이 비어 있음")
+	}
+
+	removed, changedTS := app.clearReactionRows(ctx, app.departedReactions(rows, userIDs))
+	for _, ts := range changedTS {
+		app.countCache.invalidate(ts)
+		if err := app.refreshEmojiCounts(ctx, app.targetChannel(), ts); err != nil {
+			log.Printf("[경고] 이모지 카운트 갱신 실패 (ts=%s): %v", ts, err)
+		}
+	}
+
+	log.Printf("[정보] 떠난 사용자 반응 %d개 정리 (글 %d개)", removed, len(changedTS))
+	return removed, nil
+}
+
+// 반응 행을 비우고 지운 수와 반응이 지워진 글 ts 목록(중복 없이)을 반환
+func (app *App) clearReactionRows(ctx context.Context, rows []reactionRow) (int, []string) {
+	removed := 0
+	changed := map[string]bool{}
+	var changedTS []string
+	for _, r := range rows {
+		// 행을 지우지 않고 비워 두어 정리 중에 추가된 반응의 행 번호가 밀리지 않게 한다
+		rng := fmt.Sprintf("reactions!A%d:E%d", r.Row, r.Row)
+		if _, err := app.sheets.Spreadsheets.Values.Clear(app.cfg.SheetsID, rng, &sheets.ClearValuesRequest{}).Context(ctx).Do(); err != nil {
+			log.Printf("[경고] 반응 삭제 실패 (row=%d): %v", r.Row, err)
+			continue
+		}
+		removed++
+		if !changed[r.MessageTS] {
+			changed[r.MessageTS] = true
+			changedTS = append(changedTS, r.MessageTS)
+		}
+	}
+	return removed, changedTS
+}
+
+// 게시된 글을 다시 읽어 이모지 카운트 블록만 현재 기록으로 교체
+func (app *App) refreshEmojiCounts(ctx context.Context, channelID, messageTS string) error {
+	history, err := app.slack.GetConversationHistoryContext(ctx, &slack.GetConversationHistoryParameters{
+		ChannelID: channelID,
+		Latest:    messageTS,
+		Inclusive: true,
+		Limit:     1,
+	})
+	if err != nil {
+		return fmt.Errorf("메시지 조회 실패: %w", err)
+	}
+	if len(history.Messages) == 0 || history.Messages[0].Timestamp != messageTS {
+		return fmt.Errorf("메시지를 찾을 수 없음")
+	}
+
+	counts, err := app.getEmojiCounts(ctx, messageTS)
+	if err != nil {
+		return err
+	}
+	_, _, _, err = app.slack.UpdateMessageContext(ctx, channelID, messageTS,
+		slack.MsgOptionBlocks(app.withEmojiCounts(history.Messages[0].Blocks.BlockSet, counts)...))
+	return err
+}
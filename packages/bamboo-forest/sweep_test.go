@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+var saltVersion = (&App{cfg: &Config{}}).reactionSaltVersion()
+
+func TestSweepDepartedReactions(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	fs.responses["users.list"] = `{"ok":true,"members":[
+		{"id":"UALICE","deleted":false},
+		{"id":"UBOB","deleted":true}
+	]}`
+	fs.responses["conversations.history"] = `{"ok":true,"messages":[{"ts":"1.0","text":"글","blocks":[
+		{"type":"section","block_id":"body","text":{"type":"mrkdwn","text":"익명 글"}},
+		{"type":"context","block_id":"emoji_counts","elements":[{"type":"mrkdwn","text":"👍 2 │ 👎 0 │ 🤗 1 │ 💪 0"}]}
+	]}]}`
+
+	sh, svc := newFakeSheets(t)
+	sh.seed("reactions",
+		[]string{"hash", "message_ts", "emoji", "created_at", "salt_version"},
+		[]string{reactionHashFor("UALICE", "1.0", "thumbsup"), "1.0", "thumbsup", "t", saltVersion},
+		[]string{reactionHashFor("UBOB", "1.0", "thumbsup"), "1.0", "thumbsup", "t", saltVersion},
+		[]string{reactionHashFor("UBOB", "1.0", "hug"), "1.0", "hug", "t", saltVersion},
+		[]string{reactionHashFor("UALICE", "2.0", "hug"), "2.0", "hug", "t", saltVersion},
+	)
+	app := &App{cfg: &Config{SheetsID: "sheet", ReactionSweep: true}, slack: client, sheets: svc}
+
+	removed, err := app.sweepDepartedReactions(context.Background())
+	if err != nil {
+		t.Fatalf("sweepDepartedReactions: %v", err)
+	}
+	if removed != 2 {
+		t.Errorf("정리한 반응 수 = %d, want 2", removed)
+	}
+
+	// 헤더와 남아 있는 사용자의 반응만 남는다
+	rows := sh.rows("reactions")
+	if len(rows) != 3 {
+		t.Fatalf("남은 행 = %v", rows)
+	}
+	for _, row := range rows[1:] {
+		if row[0] == reactionHashFor("UBOB", row[1], row[2]) {
+			t.Errorf("떠난 사용자 반응이 남아 있음: %v", row)
+		}
+	}
+
+	// 카운트가 바뀐 글(1.0)만 갱신
+	updates := fs.callsTo("chat.update")
+	if len(updates) != 1 {
+		t.Fatalf("chat.update 호출 수 = %d, want 1", len(updates))
+	}
+	if got := updates[0].Form.Get("ts"); got != "1.0" {
+		t.Errorf("갱신한 글 = %q, want 1.0", got)
+	}
+	text := blocksText(t, updates[0].Form.Get("blocks"))
+	if !strings.Contains(text, "👍 1 │ 👎 0 │ 🤗 0 │ 💪 0") {
+		t.Errorf("카운트 갱신 안 됨: %s", text)
+	}
+	if !strings.Contains(text, "익명 글") {
+		t.Errorf("본문 블록이 사라짐: %s", text)
+	}
+}
+
+func TestSweepDepartedReactionsDisabled(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	sh, svc := newFakeSheets(t)
+	sh.seed("reactions", []string{reactionHashFor("UBOB", "1.0", "thumbsup"), "1.0", "thumbsup", "t"})
+	app := &App{cfg: &Config{SheetsID: "sheet"}, slack: client, sheets: svc}
+
+	if removed, _ := app.sweepDepartedReactions(context.Background()); removed != 0 {
+		t.Errorf("비활성 상태에서 정리한 반응 수 = %d", removed)
+	}
+	if len(fs.callsTo("users.list")) != 0 {
+		t.Error("비활성 상태에서 사용자 목록을 조회함")
+	}
+	if len(sh.rows("reactions")) != 1 {
+		t.Error("비활성 상태에서 반응이 지워짐")
+	}
+}
+
+// 예전 형식 해시나 salt를 바꾸기 전에 기록된 행은 떠난 사용자로 보지 않는다
+func TestDepartedReactionsSkipsOtherHashFormats(t *testing.T) {
+	app := &App{cfg: &Config{}}
+	rotated := &App{cfg: &Config{ReactionHashSalt: "old-salt"}}
+	rows := []reactionRow{
+		{Hash: reactionHashFor("UBOB", "1.0", "thumbsup"), MessageTS: "1.0", Emoji: "thumbsup", Version: saltVersion},
+		{Hash: reactionHashFor("UBOB", "1.0", "thumbsup")[:32], MessageTS: "1.0", Emoji: "thumbsup"},
+		{Hash: reactionHashFor("UALICE", "1.0", "hug"), MessageTS: "1.0", Emoji: "hug"},
+		{Hash: rotated.generateReactionHash("UALICE", "1.0", "hug"), MessageTS: "1.0", Emoji: "hug", Version: rotated.reactionSaltVersion()},
+	}
+
+	got := app.departedReactions(rows, []string{"UALICE"})
+	if len(got) != 1 || got[0] != rows[0] {
+		t.Errorf("떠난 사용자 반응 = %+v, want 지금 salt로 만든 UBOB 행만", got)
+	}
+}
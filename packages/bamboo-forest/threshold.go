@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 카테고리별 반응 기준 (REACTION_THRESHOLDS)
+// 설정한 카테고리의 글이 반응 기준(특정 이모지 N개, 이모지를 비우면 모든 반응 합계)에 닿으면
+// 헤더에 표시(기본 "🌟 주목받는 글")를 붙이고, complete를 켠 경우 처리 완료로도 바꾼다.
+// 표시가 이미 붙은 글은 다시 처리하지 않으므로, 반응이 줄거나 누군가 처리 완료를 취소해도 표시는 그대로 두고 다시 완료하지 않는다.
+// 카테고리는 저장된 기록 없이 헤더의 카테고리 라벨로 알아낸다.
+
+const defaultThresholdBadge = "🌟 주목받는 글"
+
+// 자동 처리 완료한 글의 처리자 자리 (completed_by:reactions, 처리 완료 취소도 같은 방식)
+const reactionCompleter = "reactions"
+
+// 카테고리 하나의 반응 기준
+type ReactionThreshold struct {
+	Emoji    string `json:"emoji"`    // 셀 이모지 이름 (비우면 모든 반응 합계)
+	Count    int    `json:"count"`    // 기준 개수
+	Badge    string `json:"badge"`    // 헤더에 붙일 표시 (기본: 🌟 주목받는 글)
+	Complete bool   `json:"complete"` // 기준에 닿으면 처리 완료로도 표시
+}
+
+func (th ReactionThreshold) badge() string {
+	if th.Badge == "" {
+		return defaultThresholdBadge
+	}
+	return th.Badge
+}
+
+// 기준에 닿았는지
+func (th ReactionThreshold) reached(counts map[string]int) bool {
+	if th.Count <= 0 {
+		return false
+	}
+	if th.Emoji != "" {
+		return counts[th.Emoji] >= th.Count
+	}
+	total := 0
+	for _, n := range counts {
+		total += n
+	}
+	return total >= th.Count
+}
+
+// 글 헤더(첫 컨텍스트 블록)의 첫 텍스트 (없으면 "")
+func postHeaderText(blocks []slack.Block) string {
+	for _, block := range blocks {
+		b, ok := block.(*slack.ContextBlock)
+		if !ok || b.BlockID == "emoji_counts" {
+			continue
+		}
+		if len(b.ContextElements.Elements) > 0 {
+			if text, ok := b.ContextElements.Elements[0].(*slack.TextBlockObject); ok {
+				return text.Text
+			}
+		}
+		return ""
+	}
+	return ""
+}
+
+// 헤더의 카테고리 라벨로 찾은 카테고리 키 (없으면 "")
+func postCategory(blocks []slack.Block) string {
+	header := postHeaderText(blocks)
+	for category, label := range categoryLabels {
+		if strings.Contains(header, "│ "+label) {
+			return category
+		}
+	}
+	return ""
+}
+
+// 헤더 첫 텍스트 끝에 표시를 붙인 블록 (block_id와 뒤쪽 요소는 유지)
+func withHeaderBadge(blocks []slack.Block, badge string) []slack.Block {
+	out := make([]slack.Block, 0, len(blocks))
+	done := false
+	for _, block := range blocks {
+		b, ok := block.(*slack.ContextBlock)
+		if !ok || done || b.BlockID == "emoji_counts" || len(b.ContextElements.Elements) == 0 {
+			out = append(out, block)
+			continue
+		}
+		text, ok := b.ContextElements.Elements[0].(*slack.TextBlockObject)
+		if !ok {
+			out = append(out, block)
+			continue
+		}
+		elements := append([]slack.MixedElement{
+			slack.NewTextBlockObject("mrkdwn", text.Text+" │ "+badge, false, false),
+		}, b.ContextElements.Elements[1:]...)
+		out = append(out, slack.NewContextBlock(b.BlockID, elements...))
+		done = true
+	}
+	return out
+}
+
+// 카테고리 기준에 처음 닿은 글이면 표시(와 처리 완료)를 적용한 블록과 적용한 기준
+func (app *App) applyReactionThreshold(blocks []slack.Block, counts map[string]int) ([]slack.Block, ReactionThreshold, bool) {
+	th, ok := app.cfg.ReactionThresholds[postCategory(blocks)]
+	if !ok || !th.reached(counts) || strings.Contains(postHeaderText(blocks), " │ "+th.badge()) {
+		return blocks, th, false
+	}
+	blocks = withHeaderBadge(blocks, th.badge())
+	if th.Complete && !isCompletedPost(blocks) {
+		blocks = completedBlocks(blocks, reactionCompleter)
+	}
+	return blocks, th, true
+}
+
+// 이미 처리 완료 표시된 글인지
+func isCompletedPost(blocks []slack.Block) bool {
+	for _, block := range blocks {
+		if b, ok := block.(*slack.ContextBlock); ok && strings.HasPrefix(b.BlockID, completedHeaderBlockPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// 기준에 닿은 글의 기록 (자동 처리 완료면 posts 탭 상태도 갱신)
+func (app *App) onReactionThreshold(ctx context.Context, messageTS string, th ReactionThreshold) {
+	log.Printf("[정보] 반응 기준 도달 (ts=%s, badge=%s, complete=%t)", messageTS, th.badge(), th.Complete)
+	if !th.Complete || app.sheets == nil {
+		return
+	}
+	if err := app.markPostCompleted(ctx, messageTS); err != nil {
+		log.Printf("[경고] 게시글 처리완료 기록 실패: %v", err)
+	}
+}
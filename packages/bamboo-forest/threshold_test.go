@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+func TestReactionThresholdOnEmojiClick(t *testing.T) {
+	suggestion := buildNewPostBlocks("휴게실에 커피머신 놔주세요", "", nil, "suggestion", "normal", "")
+	badged := withHeaderBadge(suggestion, defaultThresholdBadge)
+	tests := []struct {
+		name         string
+		threshold    ReactionThreshold
+		blocks       []slack.Block
+		earlier      int // 이미 남은 👍 수 (클릭한 사람 제외)
+		wantBadges   int
+		wantComplete bool
+	}{
+		{"crossing adds badge", ReactionThreshold{Emoji: "thumbsup", Count: 3}, suggestion, 2, 1, false},
+		{"not crossing", ReactionThreshold{Emoji: "thumbsup", Count: 3}, suggestion, 1, 0, false},
+		{"other emoji not counted", ReactionThreshold{Emoji: "hug", Count: 3}, suggestion, 2, 0, false},
+		{"total of all reactions", ReactionThreshold{Count: 3}, suggestion, 2, 1, false},
+		{"crossing completes", ReactionThreshold{Emoji: "thumbsup", Count: 3, Complete: true}, suggestion, 2, 1, true},
+		{"already badged stays once", ReactionThreshold{Emoji: "thumbsup", Count: 3, Complete: true}, badged, 5, 1, false},
+		{"other category ignored", ReactionThreshold{Emoji: "thumbsup", Count: 3}, buildNewPostBlocks("질문", "", nil, "question", "normal", ""), 2, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			sh, svc := newFakeSheets(t)
+			for i := 0; i < tt.earlier; i++ {
+				user := "UPREV" + string(rune('A'+i))
+				sh.seed("reactions", []string{reactionHashFor(user, "1.0", "thumbsup"), "1.0", "thumbsup", "t"})
+			}
+			app := &App{cfg: &Config{SheetsID: "sheet", ReactionThresholds: map[string]ReactionThreshold{"suggestion": tt.threshold}}, slack: client, sheets: svc}
+
+			app.handleEmojiReaction(context.Background(), emojiClick("C1", "1.0", "U1", tt.blocks...), ActionEmojiThumbsUp, "thumbsup")
+
+			updates := fs.callsTo("chat.update")
+			if len(updates) == 0 {
+				t.Fatal("chat.update 호출 없음")
+			}
+			raw := updates[len(updates)-1].Form.Get("blocks")
+			text := blocksText(t, raw)
+			if got := strings.Count(text, defaultThresholdBadge); got != tt.wantBadges {
+				t.Errorf("표시 수 = %d, want %d: %s", got, tt.wantBadges, text)
+			}
+			completed := strings.Contains(text, "✅ 처리됨 (반응 기준 도달)")
+			if completed != tt.wantComplete {
+				t.Errorf("자동 처리 완료 = %v, want %v: %s", completed, tt.wantComplete, text)
+			}
+			if tt.wantComplete && (!strings.Contains(raw, completedHeaderBlockPrefix+reactionCompleter) || !strings.Contains(raw, ActionUncompleteButton)) {
+				t.Errorf("처리 완료 블록/취소 버튼 없음: %s", raw)
+			}
+		})
+	}
+}
+
+func TestAutoCompletedPostCanBeReopened(t *testing.T) {
+	post := buildNewPostBlocks("글", "", nil, "suggestion", "normal", "")
+	app := &App{cfg: &Config{ReactionThresholds: map[string]ReactionThreshold{"suggestion": {Emoji: "thumbsup", Count: 1, Complete: true}}}}
+
+	done, _, reached := app.applyReactionThreshold(post, map[string]int{"thumbsup": 1})
+	if !reached {
+		t.Fatal("기준 도달로 보지 않음")
+	}
+	reopened, ok := uncompletedBlocks(done)
+	if !ok {
+		t.Fatal("자동 처리 완료를 취소할 수 없음")
+	}
+	if header := postHeaderText(reopened); header != "🎋 *익명* │ 💡 건의사항 │ 🟡 보통 │ "+defaultThresholdBadge {
+		t.Errorf("취소 후 헤더 = %q", header)
+	}
+
+	// 표시가 남아 있으므로 반응이 더 와도 다시 완료하지 않음
+	if _, _, reached := app.applyReactionThreshold(reopened, map[string]int{"thumbsup": 2}); reached {
+		t.Error("취소한 글을 다시 자동 처리 완료함")
+	}
+}
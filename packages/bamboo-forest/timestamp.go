@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 게시 시각 표시 (SHOW_TIMESTAMP)
+// 헤더 끝에 Slack 날짜 토큰(<!date^...>)을 붙여 보는 사람마다 자기 시간대와 언어로 게시 시각을 보게 한다.
+// 게시 지연(POST_DELAY_*)을 쓰면 제출 시각이 아니라 실제로 올라가는 예약 시각을 표시해 지연의 의미를 살린다.
+// 처리 완료/이모지 반응/수정으로 헤더를 고쳐도 이 요소는 그대로 유지된다.
+
+// 날짜 토큰을 지원하지 않는 클라이언트용 대체 문구는 한국 시간 기준
+func postTimestampText(t time.Time) string {
+	fallback := t.In(time.FixedZone("KST", 9*60*60)).Format("2006-01-02 15:04 KST")
+	return fmt.Sprintf("<!date^%d^{date_short_pretty} {time}|%s>", t.Unix(), fallback)
+}
+
+// 헤더 컨텍스트 블록 끝에 게시 시각 요소 추가
+func withPostTimestamp(blocks []slack.Block, postedAt time.Time) []slack.Block {
+	out := make([]slack.Block, 0, len(blocks))
+	for i, block := range blocks {
+		if b, ok := block.(*slack.ContextBlock); ok && i == 0 {
+			elements := append(append([]slack.MixedElement{}, b.ContextElements.Elements...),
+				slack.NewTextBlockObject("mrkdwn", "🕒 "+postTimestampText(postedAt), false, false))
+			block = slack.NewContextBlock(b.BlockID, elements...)
+		}
+		out = append(out, block)
+	}
+	return out
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+func TestPostTimestampText(t *testing.T) {
+	got := postTimestampText(time.Date(2026, 10, 16, 3, 4, 0, 0, time.UTC))
+	if want := "<!date^1792119840^{date_short_pretty} {time}|2026-10-16 12:04 KST>"; got != want {
+		t.Errorf("postTimestampText = %q, want %q", got, want)
+	}
+}
+
+// 게시된 글의 블록을 다시 파싱 (버튼 클릭 payload 재현용)
+func postedBlocks(t *testing.T, raw string) []slack.Block {
+	t.Helper()
+	var blocks slack.Blocks
+	if err := json.Unmarshal([]byte(raw), &blocks); err != nil {
+		t.Fatalf("블록 파싱 실패: %v", err)
+	}
+	return blocks.BlockSet
+}
+
+func TestTimestampPreservedAcrossUpdates(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	_, svc := newFakeSheets(t)
+	app := &App{cfg: &Config{SheetsID: "sheet", ShowTimestamp: true}, slack: client, sheets: svc}
+
+	app.handleViewSubmission(viewSubmission(CallbackNewPost, "", newPostValues("concern", "normal")))
+	posts := fs.callsTo("chat.postMessage")
+	if len(posts) != 1 {
+		t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+	}
+	raw := posts[0].Form.Get("blocks")
+	if !strings.Contains(raw, "!date^") {
+		t.Fatalf("헤더에 날짜 토큰 없음: %s", raw)
+	}
+
+	// 이모지 반응으로 카운트가 바뀌어도 유지
+	app.handleEmojiReaction(context.Background(), emojiClick("C1", "1.0", "U1", postedBlocks(t, raw)...), ActionEmojiHug, "hug")
+	updates := fs.callsTo("chat.update")
+	if len(updates) != 1 || !strings.Contains(updates[0].Form.Get("blocks"), "!date^") {
+		t.Fatalf("반응 갱신 후 날짜 토큰 사라짐: %v", updates)
+	}
+
+	// 처리 완료로 헤더가 바뀌어도 유지
+	payload := emojiClick("C1", "1.0", "UMOD", postedBlocks(t, updates[0].Form.Get("blocks"))...)
+	payload.ActionCallback.BlockActions = []*slack.BlockAction{{ActionID: ActionCompleteButton}}
+	app.handleBlockAction(context.Background(), payload)
+
+	updates = fs.callsTo("chat.update")
+	if len(updates) != 2 {
+		t.Fatalf("chat.update 호출 수 = %d, want 2", len(updates))
+	}
+	text := blocksText(t, updates[1].Form.Get("blocks"))
+	if !strings.Contains(text, "처리됨") || !strings.Contains(updates[1].Form.Get("blocks"), "!date^") {
+		t.Errorf("처리 완료 후 헤더 = %q", text)
+	}
+}
+
+func TestTimestampDisabled(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{}, slack: client}
+
+	app.handleViewSubmission(viewSubmission(CallbackNewPost, "", newPostValues("concern", "normal")))
+	if raw := fs.callsTo("chat.postMessage")[0].Form.Get("blocks"); strings.Contains(raw, "!date^") {
+		t.Errorf("설정하지 않았는데 날짜 토큰 포함: %s", raw)
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 긴급 글 관리자 DM (URGENT_ALERT_USER_IDS)
+// 긴급 글이 게시되면 설정한 관리자들에게 글 링크를 DM으로 바로 보내 놓치지 않게 한다.
+// DM에는 링크와 카테고리만 담고 작성자나 본문은 넣지 않는다.
+// 예약 게시(POST_DELAY)한 글은 게시 전까지 링크가 없으므로, 예약할 때 링크 없이 채널과 카테고리만 보낸다.
+// 게시 예정 시각은 넣지 않아 관리자에게도 실제 제출 시각과 게시 시각이 함께 드러나지 않게 한다.
+
+// 게시된 긴급 글 링크를 관리자들에게 DM (관리자 목록이 비어 있거나 긴급 글이 아니면 아무것도 하지 않음)
+func (app *App) alertUrgentPost(ctx context.Context, channelID, messageTS, category, urgency string) {
+	if urgency != "urgent" || len(app.cfg.UrgentAlertUserIDs) == 0 {
+		return
+	}
+
+	link, err := app.slack.GetPermalinkContext(ctx, &slack.PermalinkParameters{Channel: channelID, Ts: messageTS})
+	if err != nil {
+		log.Printf("[경고] 긴급 글 링크 조회 실패 (ts=%s): %v", messageTS, err)
+		link = "<#" + channelID + ">"
+	}
+	app.sendUrgentAlert(ctx, "🚨 새 긴급 글이 올라왔어요 ("+categoryLabels[category]+"): "+link, messageTS)
+}
+
+// 예약 게시한 긴급 글을 관리자들에게 DM (링크 없이 게시될 채널만)
+func (app *App) alertScheduledUrgentPost(ctx context.Context, channelID, category, urgency string) {
+	if urgency != "urgent" || len(app.cfg.UrgentAlertUserIDs) == 0 {
+		return
+	}
+	app.sendUrgentAlert(ctx, "🚨 새 긴급 글이 곧 <#"+channelID+">에 올라와요 ("+categoryLabels[category]+")", "예약")
+}
+
+func (app *App) sendUrgentAlert(ctx context.Context, text, messageTS string) {
+	sent := 0
+	for _, userID := range app.cfg.UrgentAlertUserIDs {
+		if _, _, err := app.slack.PostMessageContext(ctx, userID, slack.MsgOptionText(text, false)); err != nil {
+			log.Printf("[경고] 긴급 글 DM 실패 (user=%s): %v", userID, err)
+			continue
+		}
+		sent++
+	}
+	log.Printf("[정보] 긴급 글 관리자 DM %d/%d건 (ts=%s)", sent, len(app.cfg.UrgentAlertUserIDs), messageTS)
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPostNewMessageUrgentAlert(t *testing.T) {
+	tests := []struct {
+		name    string
+		urgency string
+		admins  []string
+		wantDMs int
+	}{
+		{"urgent post", "urgent", []string{"UADMIN1", "UADMIN2"}, 2},
+		{"normal post", "normal", []string{"UADMIN1", "UADMIN2"}, 0},
+		{"no admins", "urgent", nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			fs.responses["chat.getPermalink"] = `{"ok":true,"permalink":"https://example.slack.com/archives/CBAMBOO/p1700000000000100"}`
+			app := &App{cfg: &Config{BambooChannelID: "CBAMBOO", UrgentAlertUserIDs: tt.admins}, slack: client}
+
+			if resp, _ := app.postNewMessage("USUBMITTER", "서버실 문이 열려 있어요", "신입", nil, "concern", tt.urgency, ""); resp.Body != "" {
+				t.Fatalf("게시 실패: %s", resp.Body)
+			}
+
+			dms := map[string]string{}
+			for _, c := range fs.callsTo("chat.postMessage") {
+				if ch := c.Form.Get("channel"); ch != "CBAMBOO" {
+					dms[ch] = c.Form.Get("text")
+				}
+			}
+			if len(dms) != tt.wantDMs {
+				t.Fatalf("DM = %v, want %d건", dms, tt.wantDMs)
+			}
+			for user, text := range dms {
+				if !strings.Contains(text, "https://example.slack.com/archives/CBAMBOO/p1700000000000100") {
+					t.Errorf("%s DM에 글 링크 없음: %q", user, text)
+				}
+				for _, leak := range []string{"USUBMITTER", "신입", "서버실"} {
+					if strings.Contains(text, leak) {
+						t.Errorf("%s DM에 %q 포함: %q", user, leak, text)
+					}
+				}
+			}
+			if tt.wantDMs > 0 {
+				if n := len(fs.callsTo("chat.getPermalink")); n != 1 {
+					t.Errorf("링크 조회 %d회, want 1", n)
+				}
+			}
+		})
+	}
+}
+
+func TestScheduledUrgentPostAlert(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{BambooChannelID: "CBAMBOO", UrgentAlertUserIDs: []string{"UADMIN1"}, PostDelayMinSeconds: 60, PostDelayMaxSeconds: 60}, slack: client}
+
+	if resp, _ := app.postNewMessage("USUBMITTER", "서버실 문이 열려 있어요", "", nil, "concern", "urgent", ""); resp.Body != "" {
+		t.Fatalf("게시 실패: %s", resp.Body)
+	}
+
+	if n := len(fs.callsTo("chat.scheduleMessage")); n != 1 {
+		t.Fatalf("예약 게시 %d회, want 1", n)
+	}
+	dms := fs.callsTo("chat.postMessage")
+	if len(dms) != 1 || dms[0].Form.Get("channel") != "UADMIN1" {
+		t.Fatalf("DM = %v, want UADMIN1에게 1건", dms)
+	}
+	if text := dms[0].Form.Get("text"); !strings.Contains(text, "<#CBAMBOO>") || strings.Contains(text, "서버실") {
+		t.Errorf("DM = %q, want 채널 안내만", text)
+	}
+	if n := len(fs.callsTo("chat.getPermalink")); n != 0 {
+		t.Errorf("예약 글인데 링크 조회 %d회", n)
+	}
+}
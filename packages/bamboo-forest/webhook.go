@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 외부 시스템 전달 웹훅 (FORWARD_WEBHOOK_URL)
+// 새 글이 게시되면 티켓 시스템 등에서 받을 수 있게 카테고리/긴급도/글 링크를 JSON으로 POST한다.
+// 본문은 FORWARD_WEBHOOK_CONTENT를 켠 경우에만 넣고, 작성자 정보는 어떤 경우에도 넣지 않는다.
+// 받는 쪽이 위조 요청을 거를 수 있도록 Slack 요청 서명과 같은 방식으로 서명한다 (FORWARD_WEBHOOK_SECRET이 없으면 전달하지 않음):
+//   X-Bamboo-Signature: v0=hex(HMAC-SHA256(FORWARD_WEBHOOK_SECRET, "v0:" + X-Bamboo-Request-Timestamp + ":" + 본문))
+// 전달 실패는 로그만 남기며 게시 결과에는 영향을 주지 않는다 (예약 게시한 글은 게시 시점에 링크가 없어 전달하지 않음).
+// 모달 제출(view_submission)은 3초 안에 응답해야 하므로, 링크 조회와 POST를 합쳐 webhookTimeout 안에 끝나지 않으면 전달을 포기한다.
+
+const (
+	webhookSignatureHeader = "X-Bamboo-Signature"
+	webhookTimestampHeader = "X-Bamboo-Request-Timestamp"
+)
+
+// 전달 전체(링크 조회 + POST)에 쓰는 시간 (Slack 게시와 Sheets 기록 뒤에도 3초 응답 기한을 넘기지 않도록 1초 미만)
+const webhookTimeout = 800 * time.Millisecond
+
+// 전달 요청 클라이언트 (게시 응답이 늦어지지 않도록 짧은 타임아웃)
+var webhookClient = &http.Client{Timeout: webhookTimeout}
+
+type webhookPayload struct {
+	Category  string `json:"category"`
+	Urgency   string `json:"urgency"`
+	Content   string `json:"content,omitempty"`
+	Permalink string `json:"permalink,omitempty"`
+	PostedAt  string `json:"posted_at"`
+}
+
+// 본문 서명 (v0=hex)
+func signWebhook(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("v0:" + timestamp + ":"))
+	mac.Write(body)
+	return "v0=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// 게시된 글을 웹훅으로 전달 (실패해도 게시는 성공으로 둠)
+func (app *App) forwardToWebhook(ctx context.Context, messageTS, message, category, urgency string, now time.Time) {
+	if app.cfg.ForwardWebhookURL == "" {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+
+	payload := webhookPayload{Category: category, Urgency: urgency, PostedAt: now.Format(time.RFC3339)}
+	if app.cfg.ForwardWebhookContent {
+		payload.Content = message
+	}
+	link, err := app.slack.GetPermalinkContext(ctx, &slack.PermalinkParameters{Channel: app.targetChannel(), Ts: messageTS})
+	if err != nil {
+		log.Printf("[경고] 웹훅용 글 링크 조회 실패, 링크 없이 전달 (ts=%s): %v", messageTS, err)
+	} else {
+		payload.Permalink = link
+	}
+
+	if err := app.postWebhook(ctx, payload, now); err != nil {
+		log.Printf("[경고] 웹훅 전달 실패 (ts=%s): %v", messageTS, err)
+		return
+	}
+	log.Printf("[성공] 웹훅 전달 완료 (ts=%s)", messageTS)
+}
+
+func (app *App) postWebhook(ctx context.Context, payload webhookPayload, now time.Time) error {
+	if app.cfg.ForwardWebhookSecret == "" {
+		return fmt.Errorf("FORWARD_WEBHOOK_SECRET 없음, 서명하지 않은 요청은 보내지 않음")
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, app.cfg.ForwardWebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	req.Header.Set(webhookTimestampHeader, timestamp)
+	req.Header.Set(webhookSignatureHeader, signWebhook(app.cfg.ForwardWebhookSecret, timestamp, body))
+
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("응답 코드 %d", resp.StatusCode)
+	}
+	return nil
+}
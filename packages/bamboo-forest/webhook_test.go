@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+type webhookRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// 받은 요청을 기록하는 웹훅 서버
+func newWebhookServer(t *testing.T, status int) (*httptest.Server, *[]webhookRequest) {
+	t.Helper()
+	var got []webhookRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		got = append(got, webhookRequest{header: r.Header.Clone(), body: body})
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &got
+}
+
+func TestWebhookPayload(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     bool
+		wantContent string
+	}{
+		{"metadata only", false, ""},
+		{"with content", true, "요즘 너무 힘들어요"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			fs.responses["chat.getPermalink"] = `{"ok":true,"permalink":"https://example.slack.com/archives/C1/p1700000000000100"}`
+			srv, got := newWebhookServer(t, http.StatusOK)
+			app := &App{cfg: &Config{ForwardWebhookURL: srv.URL, ForwardWebhookSecret: "shared", ForwardWebhookContent: tt.content}, slack: client}
+
+			payload := viewSubmission(CallbackNewPost, "", newPostValues("suggestion", "urgent"))
+			payload.User.ID = "U0SECRET"
+			app.handleViewSubmission(payload)
+
+			if len(*got) != 1 {
+				t.Fatalf("웹훅 호출 수 = %d, want 1", len(*got))
+			}
+			body := (*got)[0].body
+			var p webhookPayload
+			if err := json.Unmarshal(body, &p); err != nil {
+				t.Fatalf("웹훅 본문 파싱 실패: %v", err)
+			}
+			if p.Category != "suggestion" || p.Urgency != "urgent" || p.Content != tt.wantContent {
+				t.Errorf("웹훅 본문 = %+v", p)
+			}
+			if !strings.HasSuffix(p.Permalink, "/p1700000000000100") {
+				t.Errorf("permalink = %q", p.Permalink)
+			}
+			if strings.Contains(string(body), "U0SECRET") {
+				t.Errorf("웹훅 본문에 작성자 ID가 들어감: %s", body)
+			}
+			if (*got)[0].header.Get(webhookSignatureHeader) == "" {
+				t.Error("서명 헤더 없음")
+			}
+		})
+	}
+}
+
+func TestWebhookSignature(t *testing.T) {
+	srv, got := newWebhookServer(t, http.StatusOK)
+	app := &App{cfg: &Config{ForwardWebhookURL: srv.URL, ForwardWebhookSecret: "shared"}}
+	now := time.Unix(1700000000, 0)
+
+	if err := app.postWebhook(t.Context(), webhookPayload{Category: "question", Urgency: "normal"}, now); err != nil {
+		t.Fatalf("postWebhook: %v", err)
+	}
+	req := (*got)[0]
+	if ts := req.header.Get(webhookTimestampHeader); ts != "1700000000" {
+		t.Errorf("타임스탬프 헤더 = %q", ts)
+	}
+	// 받는 쪽과 같은 방식으로 다시 계산한 서명과 일치해야 함
+	want := signWebhook("shared", "1700000000", req.body)
+	if sig := req.header.Get(webhookSignatureHeader); sig != want {
+		t.Errorf("서명 = %q, want %q", sig, want)
+	}
+	if signWebhook("other", "1700000000", req.body) == want {
+		t.Error("비밀 값이 달라도 서명이 같음")
+	}
+	if signWebhook("shared", "1700000001", req.body) == want {
+		t.Error("타임스탬프가 달라도 서명이 같음")
+	}
+}
+
+func TestWebhookFailureDoesNotBlockPost(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	srv, got := newWebhookServer(t, http.StatusInternalServerError)
+	app := &App{cfg: &Config{ForwardWebhookURL: srv.URL, ForwardWebhookSecret: "shared"}, slack: client}
+
+	payload := viewSubmission(CallbackNewPost, "", newPostValues("question", "normal"))
+	payload.User.ID = "U0"
+	resp, err := app.handleViewSubmission(payload)
+
+	if err != nil || resp.StatusCode != 200 || resp.Body != "" {
+		t.Errorf("웹훅 실패가 게시 응답에 영향: status=%d body=%q err=%v", resp.StatusCode, resp.Body, err)
+	}
+	if n := len(fs.callsTo("chat.postMessage")); n != 1 {
+		t.Errorf("chat.postMessage 호출 수 = %d, want 1", n)
+	}
+	if len(*got) != 1 {
+		t.Errorf("웹훅 호출 수 = %d, want 1", len(*got))
+	}
+}
+
+func TestWebhookGivesUpWithinAckDeadline(t *testing.T) {
+	_, client := newFakeSlack(t)
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(release) })
+	app := &App{cfg: &Config{ForwardWebhookURL: srv.URL, ForwardWebhookSecret: "shared"}, slack: client}
+
+	start := time.Now()
+	app.forwardToWebhook(context.Background(), "1.0", "글", "question", "normal", start)
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Errorf("느린 웹훅을 %v 동안 기다림, want 1초 미만", elapsed)
+	}
+}
+
+// 비밀 값이 없으면 시작할 때 전달을 끄고, 서명 없는 요청은 보내지 않는다
+func TestWebhookRequiresSecret(t *testing.T) {
+	fs, _ := newFakeSlack(t)
+	slackOptions = []slack.Option{slack.OptionAPIURL(fs.url)}
+	t.Cleanup(func() { slackOptions = nil })
+	srv, got := newWebhookServer(t, http.StatusOK)
+
+	app, err := NewApp(context.Background(), &Config{SlackBotToken: "xoxb-test", SlackSigningSecret: "secret", ForwardWebhookURL: srv.URL})
+	if err != nil {
+		t.Fatalf("NewApp: %v", err)
+	}
+	if app.cfg.ForwardWebhookURL != "" {
+		t.Errorf("비밀 값 없이 웹훅 전달이 켜져 있음 (url=%q)", app.cfg.ForwardWebhookURL)
+	}
+
+	unsigned := &App{cfg: &Config{ForwardWebhookURL: srv.URL}}
+	if err := unsigned.postWebhook(t.Context(), webhookPayload{Category: "question"}, time.Now()); err == nil {
+		t.Error("비밀 값이 없는데 에러 없음")
+	}
+	if len(*got) != 0 {
+		t.Errorf("서명 없는 웹훅 호출 %d회", len(*got))
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ─────────────────────────────────────
+// 아스키 아트/이모티콘 보호
+// (╯°□°)╯︵ ┻━┻ 같은 이모티콘이나 여러 줄 아스키 아트는 번역 API를 거치면 기호가 바뀌거나 사라진다.
+// 기호 비중이 높고 단어(글자 2개 이상 연속)가 없는 줄은 줄 전체를, 그런 낱말은 낱말만 자리표시자로 바꿔
+// 자연어 부분만 번역되게 한다. 반복 문자 정규화(━━━━ 등)보다 먼저 보호해 원래 모양 그대로 복원한다.
+
+const (
+	artMinRunes     = 3   // 보호할 최소 글자 수 (공백 제외)
+	artSymbolRatio  = 0.6 // 기호로 보는 최소 비율
+	artPlaceholderF = "__ART%d__"
+)
+
+// 기호 비중이 높고 단어가 없는 문자열인지 (공백 제외)
+func isArtLike(s string) bool {
+	total, symbols, letterRun := 0, 0, 0
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			letterRun = 0
+			continue
+		}
+		total++
+		switch {
+		case unicode.IsLetter(r):
+			letterRun++
+			if letterRun >= 2 {
+				return false
+			}
+		case unicode.IsDigit(r):
+			letterRun = 0
+		default:
+			symbols++
+			letterRun = 0
+		}
+	}
+	return total >= artMinRunes && float64(symbols) >= artSymbolRatio*float64(total)
+}
+
+func protectArt(text string) (string, []string) {
+	var replacements []string
+	protect := func(s string) string {
+		placeholder := fmt.Sprintf(artPlaceholderF, len(replacements))
+		replacements = append(replacements, s)
+		return placeholder
+	}
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		// 줄 전체가 아트면 들여쓰기까지 통째로 보호 (여러 줄 아트는 줄마다 보호)
+		if isArtLike(line) {
+			lines[i] = protect(line)
+			continue
+		}
+		words := strings.Split(line, " ")
+		for j, w := range words {
+			if isArtLike(w) {
+				words[j] = protect(w)
+			}
+		}
+		lines[i] = strings.Join(words, " ")
+	}
+	return strings.Join(lines, "\n"), replacements
+}
+
+func restoreArt(text string, replacements []string) string {
+	for i, replacement := range replacements {
+		text = strings.ReplaceAll(text, fmt.Sprintf(artPlaceholderF, i), replacement)
+	}
+	return text
+}
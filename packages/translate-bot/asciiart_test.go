@@ -0,0 +1,70 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsArtLike(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"(╯°□°)╯︵", true},
+		{"┻━┻", true},
+		{"(´・ω・`)", true},
+		{"  /\\_/\\  ", true},
+		{"( o.o )", true},
+		{"**강조**", false},
+		{"좋아요!!!", false},
+		{":)", false},
+		{"hello", false},
+	}
+	for _, tt := range tests {
+		if got := isArtLike(tt.input); got != tt.want {
+			t.Errorf("isArtLike(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+// 기호를 망가뜨리는 번역기 흉내: 실제 번역 API처럼 아트 기호를 바꿔 버린다
+func artManglingTranslate(chunks []string, targetLang string) ([]string, error) {
+	out := make([]string, len(chunks))
+	for i, c := range chunks {
+		c = strings.NewReplacer("°", "o", "━", "-", "/", " / ", "\\", "").Replace(c)
+		out[i] = "[en] " + c
+	}
+	return out, nil
+}
+
+func TestTranslatePreservesArt(t *testing.T) {
+	app := &App{cfg: &Config{}}
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			"kaomoji line",
+			"배포 또 실패했어요 (╯°□°)╯︵ ┻━┻",
+			"[en] 배포 또 실패했어요 (╯°□°)╯︵ ┻━┻",
+		},
+		{
+			"multi-line ascii art mixed with text",
+			"고양이 그려봤어요\n /\\_/\\\n( o.o )\n > ^ <\n귀엽죠?",
+			"[en] 고양이 그려봤어요\n /\\_/\\\n( o.o )\n > ^ <\n귀엽죠?",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := app.translateTextWith(artManglingTranslate, tt.input, "en")
+			if err != nil {
+				t.Fatalf("translateTextWith: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("번역 결과 = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
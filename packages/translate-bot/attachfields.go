@@ -0,0 +1,96 @@
+package main
+
+import (
+	"log"
+	"strings"
+
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+)
+
+// ─────────────────────────────────────
+// 첨부 필드 번역 (TRANSLATE_ATTACHMENT_FIELDS, opt-in)
+// 수신 웹훅 메시지는 내용이 attachments[].fields[](제목/값, short)에 구조화되어 온다.
+// 필드 구조와 short 표시를 그대로 둔 채 제목과 값만 번역해 스레드에 번역 첨부로 게시한다.
+// 웹훅 메시지는 봇 메시지이므로 TRANSLATE_BOT_ALLOWLIST에 해당 bot_id를 등록해야 처리된다.
+
+// 필드가 있는 첨부
+func attachmentsWithFields(ev *slackevents.MessageEvent) []slack.Attachment {
+	var out []slack.Attachment
+	for _, a := range ev.Attachments {
+		if len(a.Fields) > 0 {
+			out = append(out, a)
+		}
+	}
+	return out
+}
+
+// 첨부 필드의 제목/값을 모은 텍스트 (언어 판별용)
+func attachmentFieldsText(a slack.Attachment) string {
+	var parts []string
+	for _, f := range a.Fields {
+		parts = append(parts, f.Title, f.Value)
+	}
+	return strings.Join(parts, "\n")
+}
+
+// 필드별로 번역한 첨부 (번역할 필요가 없는 제목/값은 원문 유지)
+func (app *App) translateAttachmentFields(channelID string, a slack.Attachment, lang string) (slack.Attachment, error) {
+	translate := app.translatorFor(channelID)
+	translateField := func(text string) (string, error) {
+		if strings.TrimSpace(text) == "" || app.targetLang(channelID, text) != lang {
+			return text, nil
+		}
+		return app.translateTextWith(translate, text, lang)
+	}
+
+	fields := make([]slack.AttachmentField, len(a.Fields))
+	for i, f := range a.Fields {
+		title, err := translateField(f.Title)
+		if err != nil {
+			return slack.Attachment{}, err
+		}
+		value, err := translateField(f.Value)
+		if err != nil {
+			return slack.Attachment{}, err
+		}
+		fields[i] = slack.AttachmentField{Title: title, Value: value, Short: f.Short}
+	}
+
+	footer := app.botText(msgTranslationLabel, lang)
+	return slack.Attachment{
+		Color:    a.Color,
+		Fallback: footer,
+		Fields:   fields,
+		Footer:   footer,
+	}, nil
+}
+
+func (app *App) processAttachmentFields(ev *slackevents.MessageEvent, threadTS string) error {
+	if !app.cfg.TranslateAttachmentFields {
+		return nil
+	}
+	for _, a := range attachmentsWithFields(ev) {
+		lang := app.targetLang(ev.Channel, attachmentFieldsText(a))
+		if lang == "" {
+			log.Printf("[스킵] 첨부 필드 번역 불필요 (channel=%s, ts=%s)", ev.Channel, ev.TimeStamp)
+			continue
+		}
+
+		translated, err := app.translateAttachmentFields(ev.Channel, a, lang)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = app.slack.PostMessage(
+			ev.Channel,
+			slack.MsgOptionAttachments(translated),
+			slack.MsgOptionTS(threadTS),
+		)
+		if err != nil {
+			return err
+		}
+		log.Printf("[성공] 첨부 필드 번역 게시 (channel=%s, ts=%s, 필드 %d개)", ev.Channel, ev.TimeStamp, len(translated.Fields))
+	}
+	return nil
+}
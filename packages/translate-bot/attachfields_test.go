@@ -0,0 +1,79 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+)
+
+const webhookMessage = `{
+	"type": "message", "subtype": "bot_message", "channel": "C1", "ts": "1.0", "bot_id": "BHOOK", "text": "",
+	"attachments": [{
+		"color": "#ff0000",
+		"fields": [
+			{"title": "서비스", "value": "결제 API", "short": true},
+			{"title": "상태", "value": "응답 지연", "short": true},
+			{"title": "요청 수", "value": "1,204", "short": false}
+		]
+	}]
+}`
+
+func TestProcessAttachmentFields(t *testing.T) {
+	tests := []struct {
+		name      string
+		enabled   bool
+		wantPosts int
+	}{
+		{"translates field titles and values", true, 1},
+		{"disabled", false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ev slackevents.MessageEvent
+			if err := json.Unmarshal([]byte(webhookMessage), &ev); err != nil {
+				t.Fatalf("이벤트 파싱 실패: %v", err)
+			}
+
+			fs, client := newFakeSlack(t)
+			cfg := &Config{TranslateBotAllowlist: []string{"BHOOK"}, TranslateAttachmentFields: tt.enabled}
+			app := &App{cfg: cfg, slack: client, translate: fakeTranslate("[ja]")}
+			if err := app.processMessage(&ev); err != nil {
+				t.Fatalf("processMessage: %v", err)
+			}
+
+			posts := fs.callsTo("chat.postMessage")
+			if len(posts) != tt.wantPosts {
+				t.Fatalf("chat.postMessage 호출 수 = %d, want %d", len(posts), tt.wantPosts)
+			}
+			if tt.wantPosts == 0 {
+				return
+			}
+			if posts[0].Form.Get("thread_ts") != "1.0" {
+				t.Errorf("thread_ts = %q, want 1.0", posts[0].Form.Get("thread_ts"))
+			}
+
+			var atts []slack.Attachment
+			if err := json.Unmarshal([]byte(posts[0].Form.Get("attachments")), &atts); err != nil || len(atts) != 1 {
+				t.Fatalf("attachments 파싱 실패: %v (%s)", err, posts[0].Form.Get("attachments"))
+			}
+			want := []slack.AttachmentField{
+				{Title: "[ja]서비스", Value: "[ja]결제 API", Short: true},
+				{Title: "[ja]상태", Value: "[ja]응답 지연", Short: true},
+				{Title: "[ja]요청 수", Value: "1,204", Short: false},
+			}
+			if len(atts[0].Fields) != len(want) {
+				t.Fatalf("필드 = %+v", atts[0].Fields)
+			}
+			for i, f := range atts[0].Fields {
+				if f != want[i] {
+					t.Errorf("필드[%d] = %+v, want %+v", i, f, want[i])
+				}
+			}
+			if atts[0].Color != "#ff0000" {
+				t.Errorf("color = %q, want 원래 색 유지", atts[0].Color)
+			}
+		})
+	}
+}
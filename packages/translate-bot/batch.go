@@ -0,0 +1,60 @@
+package main
+
+import (
+	"sync"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+// ─────────────────────────────────────
+// 여러 메시지 동시 번역
+// 한 번의 호출로 여러 메시지를 처리할 때 채널 단위로 순서를 지키면서 채널 간에는 병렬로 번역한다.
+// 같은 채널의 메시지는 들어온 순서대로 답글이 달리고, 동시에 번역 중인 메시지 수는 TRANSLATE_CONCURRENCY로 제한된다.
+
+const defaultTranslateConcurrency = 4
+
+func (app *App) translateConcurrency() int {
+	if app.cfg.TranslateConcurrency > 0 {
+		return app.cfg.TranslateConcurrency
+	}
+	return defaultTranslateConcurrency
+}
+
+// 메시지 목록을 처리하고 입력 순서에 맞춘 에러 목록을 반환
+func (app *App) processMessageBatch(evs []*slackevents.MessageEvent) []error {
+	return runPerChannel(evs, app.translateConcurrency(), app.processMessage)
+}
+
+func runPerChannel(evs []*slackevents.MessageEvent, concurrency int, process func(*slackevents.MessageEvent) error) []error {
+	errs := make([]error, len(evs))
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	// 채널별로 입력 순서를 유지한 인덱스 목록
+	var channels []string
+	byChannel := map[string][]int{}
+	for i, ev := range evs {
+		if _, ok := byChannel[ev.Channel]; !ok {
+			channels = append(channels, ev.Channel)
+		}
+		byChannel[ev.Channel] = append(byChannel[ev.Channel], i)
+	}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for _, ch := range channels {
+		wg.Add(1)
+		go func(indexes []int) {
+			defer wg.Done()
+			// 채널 안에서는 순차 처리 (이전 메시지 답글이 게시된 뒤 다음 메시지 번역)
+			for _, i := range indexes {
+				sem <- struct{}{}
+				errs[i] = process(evs[i])
+				<-sem
+			}
+		}(byChannel[ch])
+	}
+	wg.Wait()
+	return errs
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+func msg(channel, ts string) *slackevents.MessageEvent {
+	return &slackevents.MessageEvent{Channel: channel, TimeStamp: ts}
+}
+
+func TestRunPerChannelKeepsOrderWithinChannel(t *testing.T) {
+	evs := []*slackevents.MessageEvent{
+		msg("C1", "1"), msg("C2", "1"), msg("C1", "2"), msg("C1", "3"), msg("C2", "2"),
+	}
+
+	var mu sync.Mutex
+	order := map[string][]string{}
+	runPerChannel(evs, 4, func(ev *slackevents.MessageEvent) error {
+		// 앞 메시지가 늦게 끝나도 뒤 메시지가 먼저 게시되지 않아야 한다
+		if ev.TimeStamp == "1" {
+			time.Sleep(20 * time.Millisecond)
+		}
+		mu.Lock()
+		order[ev.Channel] = append(order[ev.Channel], ev.TimeStamp)
+		mu.Unlock()
+		return nil
+	})
+
+	if got := order["C1"]; len(got) != 3 || got[0] != "1" || got[1] != "2" || got[2] != "3" {
+		t.Errorf("C1 순서 = %v, want [1 2 3]", got)
+	}
+	if got := order["C2"]; len(got) != 2 || got[0] != "1" || got[1] != "2" {
+		t.Errorf("C2 순서 = %v, want [1 2]", got)
+	}
+}
+
+func TestRunPerChannelParallelAcrossChannels(t *testing.T) {
+	evs := []*slackevents.MessageEvent{msg("C1", "1"), msg("C2", "1"), msg("C3", "1")}
+
+	var inFlight, peak int32
+	release := make(chan struct{})
+	var once sync.Once
+	runPerChannel(evs, 2, func(ev *slackevents.MessageEvent) error {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		// 두 채널이 동시에 들어오면 풀어준다 (병렬이 아니면 타임아웃으로 진행)
+		if n == 2 {
+			once.Do(func() { close(release) })
+		}
+		select {
+		case <-release:
+		case <-time.After(500 * time.Millisecond):
+		}
+		atomic.AddInt32(&inFlight, -1)
+		return nil
+	})
+
+	if peak != 2 {
+		t.Errorf("최대 동시 처리 수 = %d, want 2 (동시성 제한)", peak)
+	}
+}
+
+func TestRunPerChannelReturnsErrorsInInputOrder(t *testing.T) {
+	evs := []*slackevents.MessageEvent{msg("C1", "ok"), msg("C2", "fail"), msg("C1", "ok")}
+	errs := runPerChannel(evs, 2, func(ev *slackevents.MessageEvent) error {
+		if ev.TimeStamp == "fail" {
+			return errTest
+		}
+		return nil
+	})
+	if errs[0] != nil || errs[1] != errTest || errs[2] != nil {
+		t.Errorf("errs = %v", errs)
+	}
+}
+
+var errTest = errors.New("test error")
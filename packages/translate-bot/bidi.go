@@ -0,0 +1,84 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// ─────────────────────────────────────
+// 쓰기 방향 보정 (BIDI_ISOLATION)
+// 아랍어/히브리어처럼 오른쪽에서 왼쪽으로 쓰는(RTL) 글이 번역에 섞이면, Slack은 줄마다 첫 글자로 방향을 정하므로
+// 뒤에 오는 숫자·영문·문장부호가 엉뚱한 쪽으로 붙어 보일 수 있다.
+// RTL 글자로 시작하는 줄은 RLI…PDI로, 왼쪽에서 오른쪽 줄 안에 섞인 RTL 구간은 구간마다 RLI…PDI로 감싸
+// 주변 글자와 방향이 섞이지 않게 한다. Slack 토큰(<@U…>, <https://…|…>) 안에는 표시 문자를 넣지 않는다.
+// 세로쓰기 전용 문장부호(︒, ﹁ 등)는 Slack이 가로로만 표시하므로 일반 문장부호로 바꾼다.
+
+const (
+	bidiRLI = "\u2067" // Right-to-Left Isolate
+	bidiPDI = "\u2069" // Pop Directional Isolate
+)
+
+var (
+	// RTL 글자 하나 이상으로 시작하고 끝나는 구간 (사이의 공백/중립 문자 포함)
+	rtlRunRegex = regexp.MustCompile(`[\p{Arabic}\p{Hebrew}\p{Syriac}\p{Thaana}\p{Nko}](?:[\p{Arabic}\p{Hebrew}\p{Syriac}\p{Thaana}\p{Nko}\p{Mn}\s،؛؟.,'"()\-]*[\p{Arabic}\p{Hebrew}\p{Syriac}\p{Thaana}\p{Nko}\p{Mn}])?`)
+	// Slack 특수 토큰 (링크 라벨의 공백 포함)
+	bidiTokenRegex = regexp.MustCompile(`<[^<>\n]*>`)
+)
+
+// 세로쓰기 표현형 → 가로 문장부호
+var verticalPunct = strings.NewReplacer(
+	"︐", "，", "︑", "、", "︒", "。", "︓", "：", "︔", "；", "︕", "！", "︖", "？",
+	"︗", "〖", "︘", "〗", "︙", "…", "︰", "‥", "︱", "ー", "︵", "（", "︶", "）",
+	"︷", "｛", "︸", "｝", "︹", "〔", "︺", "〕", "︻", "【", "︼", "】", "︽", "《", "︾", "》",
+	"︿", "〈", "﹀", "〉", "﹁", "「", "﹂", "」", "﹃", "『", "﹄", "』",
+)
+
+func isRTL(r rune) bool {
+	return unicode.In(r, unicode.Arabic, unicode.Hebrew, unicode.Syriac, unicode.Thaana, unicode.Nko)
+}
+
+// 줄의 첫 강한 방향 글자가 RTL인지 (Slack 토큰은 건너뜀)
+func startsRTL(line string) bool {
+	for _, r := range bidiTokenRegex.ReplaceAllString(line, "") {
+		if isRTL(r) {
+			return true
+		}
+		if unicode.IsLetter(r) {
+			return false
+		}
+	}
+	return false
+}
+
+// Slack 토큰 밖의 RTL 구간만 감쌈
+func isolateRTLRuns(line string) string {
+	var b strings.Builder
+	prev := 0
+	for _, loc := range bidiTokenRegex.FindAllStringIndex(line, -1) {
+		b.WriteString(rtlRunRegex.ReplaceAllString(line[prev:loc[0]], bidiRLI+"$0"+bidiPDI))
+		b.WriteString(line[loc[0]:loc[1]])
+		prev = loc[1]
+	}
+	b.WriteString(rtlRunRegex.ReplaceAllString(line[prev:], bidiRLI+"$0"+bidiPDI))
+	return b.String()
+}
+
+func isolateBidi(text string) string {
+	text = verticalPunct.Replace(text)
+	// 이미 방향 표시 문자가 있으면 작성자/번역기가 정한 방향을 존중
+	if strings.ContainsAny(text, "\u2066\u2067\u2068\u2069\u202a\u202b\u202c\u202d\u202e") {
+		return text
+	}
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		switch {
+		case !strings.ContainsFunc(line, isRTL):
+		case startsRTL(line):
+			lines[i] = bidiRLI + line + bidiPDI
+		default:
+			lines[i] = isolateRTLRuns(line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
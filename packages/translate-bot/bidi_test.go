@@ -0,0 +1,51 @@
+package main
+
+import "testing"
+
+func TestIsolateBidi(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"ltr only", "Release 3.2 is out", "Release 3.2 is out"},
+		{"rtl line", "مرحبا بالعالم 2026", bidiRLI + "مرحبا بالعالم 2026" + bidiPDI},
+		{"rtl run in ltr line", "Meeting with فريق المبيعات at 3pm", "Meeting with " + bidiRLI + "فريق المبيعات" + bidiPDI + " at 3pm"},
+		{"hebrew run before number", "Order from שלום 123", "Order from " + bidiRLI + "שלום" + bidiPDI + " 123"},
+		{"mixed lines", "Hello\nשלום עולם", "Hello\n" + bidiRLI + "שלום עולם" + bidiPDI},
+		{"mention before rtl", "<@U123> مرحبا", bidiRLI + "<@U123> مرحبا" + bidiPDI},
+		{"link label untouched", "See <https://example.com|دليل المستخدم> now", "See <https://example.com|دليل المستخدم> now"},
+		{"existing marks kept", "Hi " + bidiRLI + "שלום" + bidiPDI, "Hi " + bidiRLI + "שלום" + bidiPDI},
+		{"vertical punctuation", "﹁こんにちは﹂︒", "「こんにちは」。"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isolateBidi(tt.input); got != tt.want {
+				t.Errorf("isolateBidi(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBidiIsolationInTranslation(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled bool
+		want    string
+	}{
+		{"enabled", true, "Call " + bidiRLI + "أحمد" + bidiPDI + " at 10"},
+		{"disabled", false, "Call أحمد at 10"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &App{cfg: &Config{BidiIsolation: tt.enabled}, translate: fakeTranslate("")}
+			got, err := app.translateTextWith(app.translate, "Call أحمد at 10", "en")
+			if err != nil {
+				t.Fatalf("translateTextWith: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("번역 결과 = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 이중 언어 사용자 번역 건너뛰기 (`/translate-bilingual on`, BILINGUAL_USERS)
+// 두 언어를 모두 편하게 쓰는 사람의 메시지는 번역이 필요 없으므로, 본인이 이중 언어 사용자로 표시하면 그 사람의 메시지를 번역하지 않는다.
+// BILINGUAL_USERS에 넣은 사용자는 설정과 관계없이 항상 건너뛴다.
+// BILINGUAL_NUDGE_AFTER를 설정하면 두 가지 이상의 언어로 각각 N번 넘게 쓴 사람에게 이 명령을 한 번만 본인에게 보이게 권한다.
+// 표시는 읽기 언어처럼 사용자 설정 저장소(USER_PREFS_TABLE)에 해시한 사용자 ID로 저장하므로, 저장소가 없으면 명령과 권유를 쓰지 않는다.
+// 권유용 언어 집계는 해시한 사용자 ID로 Lambda 인스턴스 메모리에만 둔다.
+
+const bilingualCommand = "/translate-bilingual"
+
+// 해시한 사용자 ID별 권유용 언어 집계
+type bilingualUsers struct {
+	mu     sync.Mutex
+	langs  map[string]map[string]int
+	nudged map[string]bool
+}
+
+// 쓴 언어를 세고, 처음으로 두 언어 이상에서 각각 after번에 닿았으면 true (한 사람에게 한 번만)
+func (b *bilingualUsers) observe(userID, lang string, after int) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	key := userPrefKey(userID)
+	if b.nudged[key] {
+		return false
+	}
+	if b.langs == nil {
+		b.langs = map[string]map[string]int{}
+	}
+	if b.langs[key] == nil {
+		b.langs[key] = map[string]int{}
+	}
+	b.langs[key][lang]++
+
+	fluent := 0
+	for _, n := range b.langs[key] {
+		if n >= after {
+			fluent++
+		}
+	}
+	if fluent < 2 {
+		return false
+	}
+	if b.nudged == nil {
+		b.nudged = map[string]bool{}
+	}
+	b.nudged[key] = true
+	delete(b.langs, key)
+	return true
+}
+
+// 메시지를 번역하지 않을 이중 언어 사용자인지
+func (app *App) isBilingual(userID string) bool {
+	if userID == "" {
+		return false
+	}
+	for _, id := range app.cfg.BilingualUsers {
+		if id == userID {
+			return true
+		}
+	}
+	return app.markedBilingual(userID)
+}
+
+// 여러 언어로 자주 쓰는 사람에게 `/translate-bilingual on`을 한 번 권함 (BILINGUAL_NUDGE_AFTER)
+func (app *App) nudgeBilingual(channelID, userID, lang, threadTS string) {
+	after := app.cfg.BilingualNudgeAfter
+	if after <= 0 || app.prefs == nil || userID == "" || lang == "" || !app.bilingual.observe(userID, lang, after) {
+		return
+	}
+	opts := []slack.MsgOption{slack.MsgOptionText(app.userBotText(userID, msgBilingualNudge), false)}
+	if threadTS != "" {
+		opts = append(opts, slack.MsgOptionTS(threadTS))
+	}
+	if _, err := app.slack.PostEphemeral(channelID, userID, opts...); err != nil {
+		log.Printf("[경고] 이중 언어 안내 전송 실패: %v", err)
+		return
+	}
+	log.Printf("[정보] 이중 언어 사용자 표시 권유 (channel=%s)", channelID)
+}
+
+// `/translate-bilingual [on|off]` 처리 후 안내 문구 반환 (요청한 사람의 언어로)
+func (app *App) setBilingual(userID, arg string) string {
+	if app.prefs == nil {
+		return app.userBotText(userID, msgUserPrefsUnavailable)
+	}
+	switch strings.ToLower(strings.TrimSpace(arg)) {
+	case "":
+		if app.isBilingual(userID) {
+			return app.userBotText(userID, msgBilingualOn)
+		}
+		return app.userBotText(userID, msgBilingualOff)
+	case "on":
+		if err := app.prefs.SetBilingual(context.Background(), userPrefKey(userID), true); err != nil {
+			log.Printf("[에러] 이중 언어 사용자 표시 저장 실패: %v", err)
+			return app.userBotText(userID, msgUserPrefsFailed)
+		}
+		log.Println("[정보] 이중 언어 사용자 표시")
+		return app.userBotText(userID, msgBilingualMarked)
+	case "off":
+		if err := app.prefs.SetBilingual(context.Background(), userPrefKey(userID), false); err != nil {
+			log.Printf("[에러] 이중 언어 사용자 표시 해제 실패: %v", err)
+			return app.userBotText(userID, msgUserPrefsFailed)
+		}
+		log.Println("[정보] 이중 언어 사용자 표시 해제")
+		for _, id := range app.cfg.BilingualUsers {
+			if id == userID {
+				return app.userBotText(userID, msgBilingualAdminSet)
+			}
+		}
+		return app.userBotText(userID, msgBilingualUnmarked)
+	}
+	return app.userBotText(userID, msgBilingualUsage)
+}
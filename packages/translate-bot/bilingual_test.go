@@ -0,0 +1,138 @@
+package main
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+func TestBilingualUserSkipped(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfgUsers []string
+		command  string // U1이 실행할 `/translate-bilingual` 인자 ("-"면 실행 안 함)
+		wantPost bool
+	}{
+		{"marked by command", nil, "on", false},
+		{"configured", []string{"U1"}, "-", false},
+		{"unmarked", nil, "off", true},
+		{"configured ignores off", []string{"U1"}, "off", false},
+		{"other user configured", []string{"U2"}, "-", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			_, store := newFakePrefStore(t)
+			app := &App{cfg: &Config{BilingualUsers: tt.cfgUsers}, slack: client, translate: fakeTranslate("[번역]"), prefs: store}
+
+			if tt.command != "-" {
+				app.handleCommand(url.Values{"command": {bilingualCommand}, "user_id": {"U1"}, "text": {"on"}})
+				app.handleCommand(url.Values{"command": {bilingualCommand}, "user_id": {"U1"}, "text": {tt.command}})
+			}
+			ev := &slackevents.MessageEvent{Channel: "C1", User: "U1", Text: "오늘 점심 뭐 먹을까요", TimeStamp: "1.0"}
+			if err := app.processMessage(ev); err != nil {
+				t.Fatalf("processMessage: %v", err)
+			}
+			if posted := len(fs.callsTo("chat.postMessage")) > 0; posted != tt.wantPost {
+				t.Errorf("번역 게시 = %v, want %v", posted, tt.wantPost)
+			}
+		})
+	}
+}
+
+func TestBilingualCommand(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	fs.responses["users.info"] = `{"ok":true,"user":{"id":"U1","locale":"ko-KR"}}`
+	ft, store := newFakePrefStore(t)
+	app := &App{cfg: &Config{}, slack: client, prefs: store}
+	run := func(text string) string {
+		resp, err := app.handleCommand(url.Values{"command": {bilingualCommand}, "user_id": {"U1"}, "text": {text}})
+		if err != nil || resp.StatusCode != 200 {
+			t.Fatalf("handleCommand(%q): status=%d err=%v", text, resp.StatusCode, err)
+		}
+		return resp.Body
+	}
+
+	if got := run(""); !strings.Contains(got, "내 메시지도 번역합니다") {
+		t.Errorf("표시 전 조회 = %q", got)
+	}
+	if run("ON"); !app.isBilingual("U1") {
+		t.Error("on 후 이중 언어 사용자가 아님")
+	}
+	if got := run(""); !strings.Contains(got, "번역하지 않습니다") {
+		t.Errorf("표시 후 조회 = %q", got)
+	}
+	for key := range ft.items {
+		if strings.Contains(key, "U1") {
+			t.Errorf("사용자 ID가 그대로 저장됨: %q", key)
+		}
+	}
+	if run("off"); app.isBilingual("U1") {
+		t.Error("off 후에도 이중 언어 사용자")
+	}
+	if got := run("maybe"); !strings.Contains(got, "사용법") {
+		t.Errorf("잘못된 인자 응답 = %q", got)
+	}
+}
+
+// 다른 인스턴스에서 표시한 이중 언어 사용자도 건너뛴다
+func TestBilingualSharedAcrossInstances(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	_, store := newFakePrefStore(t)
+	first := &App{cfg: &Config{}, slack: client, prefs: store}
+	second := &App{cfg: &Config{}, slack: client, translate: fakeTranslate("[번역]"), prefs: store}
+
+	first.setBilingual("U1", "on")
+	ev := &slackevents.MessageEvent{Channel: "C1", User: "U1", Text: "오늘 점심 뭐 먹을까요", TimeStamp: "1.0"}
+	if err := second.processMessage(ev); err != nil {
+		t.Fatalf("processMessage: %v", err)
+	}
+	if n := len(fs.callsTo("chat.postMessage")); n != 0 {
+		t.Errorf("다른 인스턴스에서 표시한 사용자의 메시지가 번역됨 (%d건)", n)
+	}
+}
+
+// 저장소가 없으면 표시하지 않고 안내만, 권유도 하지 않음
+func TestBilingualWithoutStore(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	fs.responses["users.info"] = `{"ok":true,"user":{"id":"U1","locale":"ko-KR"}}`
+	app := &App{cfg: &Config{BilingualNudgeAfter: 1}, slack: client, translate: fakeTranslate("[번역]")}
+
+	if got := app.setBilingual("U1", "on"); !strings.Contains(got, "USER_PREFS_TABLE") {
+		t.Errorf("저장소 없을 때 응답 = %q", got)
+	}
+	for _, text := range []string{"안녕하세요", "明日の会議です"} {
+		app.processMessage(&slackevents.MessageEvent{Channel: "C1", User: "U1", Text: text, TimeStamp: "1.0"})
+	}
+	if n := len(fs.callsTo("chat.postEphemeral")); n != 0 {
+		t.Errorf("저장소 없이 권유 안내 %d회", n)
+	}
+	if n := len(fs.callsTo("chat.postMessage")); n != 2 {
+		t.Errorf("번역 %d건, want 2", n)
+	}
+}
+
+func TestBilingualNudgeOnce(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	_, store := newFakePrefStore(t)
+	app := &App{cfg: &Config{BilingualNudgeAfter: 2}, slack: client, translate: fakeTranslate("[번역]"), prefs: store}
+
+	for _, text := range []string{"안녕하세요", "明日の会議です", "감사합니다", "よろしくお願いします", "또 봐요", "またね"} {
+		ev := &slackevents.MessageEvent{Channel: "C1", User: "U1", Text: text, TimeStamp: "1.0"}
+		if err := app.processMessage(ev); err != nil {
+			t.Fatalf("processMessage: %v", err)
+		}
+	}
+	eph := fs.callsTo("chat.postEphemeral")
+	if len(eph) != 1 {
+		t.Fatalf("권유 안내 %d회, want 1", len(eph))
+	}
+	if got := eph[0].Form.Get("text"); !strings.Contains(got, bilingualCommand+" on") {
+		t.Errorf("안내 = %q", got)
+	}
+	if n := len(fs.callsTo("chat.postMessage")); n != 6 {
+		t.Errorf("권유만 하고 번역은 계속해야 함 (번역 %d건)", n)
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+)
+
+// ─────────────────────────────────────
+// 허용한 봇 메시지 번역 (TRANSLATE_BOT_ALLOWLIST)
+// 봇 메시지는 기본적으로 무시하지만, CI/모니터링 알림처럼 외국어로 오는 연동 메시지는 bot_id를 등록하면 번역한다.
+// 이런 메시지는 본문이 블록(section/header/context)에 있고 text는 요약(fallback)인 경우가 많아 블록 텍스트를 모아 번역한다.
+// 메시지 이벤트에는 app_id가 없으므로 bot_id로만 구분한다.
+
+func (app *App) isAllowlistedBot(ev *slackevents.MessageEvent) bool {
+	// 자기 자신의 번역 메시지는 등록되어 있어도 번역하지 않는다 (무한 번역 방지)
+	if ev.User != "" && ev.User == app.botUserID {
+		return false
+	}
+	return contains(app.cfg.TranslateBotAllowlist, ev.BotID)
+}
+
+// 봇 메시지 블록의 텍스트 (section 본문/필드, header, context 순서대로, 없으면 "")
+func botBlocksText(blocks slack.Blocks) string {
+	var parts []string
+	addText := func(t *slack.TextBlockObject) {
+		if t != nil && strings.TrimSpace(t.Text) != "" {
+			parts = append(parts, t.Text)
+		}
+	}
+
+	for _, block := range blocks.BlockSet {
+		switch b := block.(type) {
+		case *slack.HeaderBlock:
+			addText(b.Text)
+		case *slack.SectionBlock:
+			addText(b.Text)
+			for _, f := range b.Fields {
+				addText(f)
+			}
+		case *slack.ContextBlock:
+			for _, el := range b.ContextElements.Elements {
+				if t, ok := el.(*slack.TextBlockObject); ok {
+					addText(t)
+				}
+			}
+		}
+	}
+	return strings.Join(parts, "\n")
+}
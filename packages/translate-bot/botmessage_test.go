@@ -0,0 +1,60 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+const ciBotMessage = `{
+	"type": "message", "channel": "C1", "ts": "1.0", "bot_id": "BCI", "text": "ビルド失敗",
+	"blocks": [
+		{"type": "header", "text": {"type": "plain_text", "text": "ビルドが失敗しました"}},
+		{"type": "section", "text": {"type": "mrkdwn", "text": "*main* ブランチのテストが失敗しました"},
+		 "fields": [{"type": "mrkdwn", "text": "所要時間: 3分"}]},
+		{"type": "context", "elements": [{"type": "mrkdwn", "text": "詳細はログを確認してください"}]}
+	]
+}`
+
+func TestProcessMessageAllowlistedBot(t *testing.T) {
+	tests := []struct {
+		name      string
+		allowlist []string
+		botUserID string
+		wantText  string
+	}{
+		{"allowlisted", []string{"BCI"}, "UBOT", "[번역]ビルドが失敗しました\n*main* ブランチのテストが失敗しました\n所要時間: 3分\n詳細はログを確認してください"},
+		{"not allowlisted", []string{"BOTHER"}, "UBOT", ""},
+		{"own bot", []string{"BCI"}, "UCI", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ev slackevents.MessageEvent
+			if err := json.Unmarshal([]byte(ciBotMessage), &ev); err != nil {
+				t.Fatalf("이벤트 파싱 실패: %v", err)
+			}
+			ev.User = "UCI"
+
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: &Config{TranslateBotAllowlist: tt.allowlist}, slack: client, botUserID: tt.botUserID, translate: fakeTranslate("[번역]")}
+			if err := app.processMessage(&ev); err != nil {
+				t.Fatalf("processMessage: %v", err)
+			}
+
+			posts := fs.callsTo("chat.postMessage")
+			if tt.wantText == "" {
+				if len(posts) != 0 {
+					t.Errorf("번역하지 않아야 함: %q", posts[0].Form.Get("text"))
+				}
+				return
+			}
+			if len(posts) != 1 {
+				t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+			}
+			if got := posts[0].Form.Get("text"); got != tt.wantText {
+				t.Errorf("번역문 = %q, want %q", got, tt.wantText)
+			}
+		})
+	}
+}
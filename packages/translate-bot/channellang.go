@@ -0,0 +1,124 @@
+package main
+
+import (
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 채널 이름으로 언어 쌍 추론 (CHANNEL_LANG_PATTERN, opt-in)
+// `#ko-ja-chat`처럼 이름에 언어 코드 두 개가 들어간 채널은 그 쌍으로 번역 방향을 정한다.
+// 패턴은 캡처 그룹 두 개(두 언어 코드)를 가진 정규식이며 두 언어 사이에서 양방향으로 번역한다.
+// 맞지 않는 채널은 기본 한↔일 판별(determineLang)을 그대로 쓴다.
+
+// 채널 언어 쌍 (First↔Second 양방향)
+type langPair struct {
+	First  string
+	Second string
+}
+
+// 채널 캐시 항목 (맞지 않는 채널도 ok=false로 캐시)
+type channelLangEntry struct {
+	pair langPair
+	ok   bool
+}
+
+var latinRegex = regexp.MustCompile(`\p{Latin}`)
+
+// 채널 이름에서 언어 쌍 추출 (맞지 않으면 ok=false)
+func parseChannelLangPair(name string, pattern *regexp.Regexp) (langPair, bool) {
+	m := pattern.FindStringSubmatch(name)
+	if len(m) < 3 || m[1] == "" || m[2] == "" {
+		return langPair{}, false
+	}
+	first, second := strings.ToLower(m[1]), strings.ToLower(m[2])
+	if first == second {
+		return langPair{}, false
+	}
+	return langPair{First: first, Second: second}, true
+}
+
+// 원문 언어 감지 (한국어/일본어/라틴 문자 중 하나만 있을 때)
+func detectSourceLang(s string) string {
+	hasKorean := koreanRegex.MatchString(s)
+	hasJapanese := japaneseRegex.MatchString(s)
+
+	switch {
+	case hasKorean && hasJapanese:
+		return ""
+	case hasKorean:
+		return "ko"
+	case hasJapanese:
+		return "ja"
+	case latinRegex.MatchString(s):
+		return "en"
+	default:
+		return ""
+	}
+}
+
+// 언어 쌍 안에서 번역 방향 결정 (원문이 쌍에 없으면 "")
+func (p langPair) targetFor(s string) string {
+	switch detectSourceLang(s) {
+	case p.First:
+		return p.Second
+	case p.Second:
+		return p.First
+	default:
+		return ""
+	}
+}
+
+// 채널 언어 쌍 조회 (conversations.info 결과를 채널별로 캐시)
+func (app *App) channelLangPair(channelID string) (langPair, bool) {
+	if app.cfg.ChannelLangPattern == "" || channelID == "" {
+		return langPair{}, false
+	}
+
+	app.channelLangsMu.Lock()
+	defer app.channelLangsMu.Unlock()
+
+	if entry, ok := app.channelLangs[channelID]; ok {
+		return entry.pair, entry.ok
+	}
+
+	pattern, err := regexp.Compile(app.cfg.ChannelLangPattern)
+	if err != nil {
+		log.Printf("[경고] 잘못된 CHANNEL_LANG_PATTERN 무시 (%q): %v", app.cfg.ChannelLangPattern, err)
+		return langPair{}, false
+	}
+
+	info, err := app.slack.GetConversationInfo(&slack.GetConversationInfoInput{ChannelID: channelID})
+	if err != nil {
+		// 조회 실패는 캐시하지 않고 다음 메시지에서 다시 시도
+		log.Printf("[경고] 채널 정보 조회 실패 (channel=%s): %v", channelID, err)
+		return langPair{}, false
+	}
+
+	pair, ok := parseChannelLangPair(info.Name, pattern)
+	if app.channelLangs == nil {
+		app.channelLangs = map[string]channelLangEntry{}
+	}
+	app.channelLangs[channelID] = channelLangEntry{pair: pair, ok: ok}
+	if ok {
+		log.Printf("[정보] 채널 언어 쌍 (channel=%s, name=%s, %s↔%s)", channelID, info.Name, pair.First, pair.Second)
+	}
+	return pair, ok
+}
+
+// 번역 대상 언어 결정 (채널 언어 쌍이 있으면 우선, 없으면 한↔일 기본 판별)
+// 지원하지 않는 언어 쌍이면 "" (SUPPORTED_LANG_PAIRS)
+func (app *App) targetLang(channelID, s string) string {
+	return app.supportedTarget(detectSourceLang(s), app.pairTargetLang(channelID, s))
+}
+
+// 언어 쌍 확인 전의 번역 대상 언어
+func (app *App) pairTargetLang(channelID, s string) string {
+	if pair, ok := app.channelLangPair(channelID); ok {
+		return pair.targetFor(s)
+	}
+	return determineLang(s)
+}
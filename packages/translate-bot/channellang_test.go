@@ -0,0 +1,105 @@
+package main
+
+import (
+	"net/url"
+	"regexp"
+	"testing"
+)
+
+const testChannelLangPattern = `^([a-z]{2})-([a-z]{2})(?:-|$)`
+
+func TestParseChannelLangPair(t *testing.T) {
+	pattern := regexp.MustCompile(testChannelLangPattern)
+	tests := []struct {
+		name   string
+		want   langPair
+		wantOK bool
+	}{
+		{"ko-ja-chat", langPair{"ko", "ja"}, true},
+		{"ko-en", langPair{"ko", "en"}, true},
+		{"general", langPair{}, false},
+		{"dev-random", langPair{}, false},
+		{"ko-ko-chat", langPair{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseChannelLangPair(tt.name, pattern)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("parseChannelLangPair(%q) = %v, %t, want %v, %t", tt.name, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestLangPairTargetFor(t *testing.T) {
+	pair := langPair{"ko", "en"}
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"안녕하세요", "en"},
+		{"Hello there", "ko"},
+		{"こんにちは", ""}, // 쌍에 없는 언어는 건너뛴다
+		{"12345", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := pair.targetFor(tt.input); got != tt.want {
+				t.Errorf("targetFor(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+// 채널 이름별 conversations.info 응답
+func channelInfo(method string, form url.Values) string {
+	if method != "conversations.info" {
+		return ""
+	}
+	switch form.Get("channel") {
+	case "C1":
+		return `{"ok":true,"channel":{"id":"C1","name":"ko-en-chat"}}`
+	case "C2":
+		return `{"ok":true,"channel":{"id":"C2","name":"general"}}`
+	}
+	return ""
+}
+
+func TestTargetLangFromChannelName(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	fs.respond = channelInfo
+	app := &App{cfg: &Config{ChannelLangPattern: testChannelLangPattern}, slack: client}
+
+	tests := []struct {
+		channel string
+		input   string
+		want    string
+	}{
+		{"C1", "안녕하세요", "en"}, // 이름이 맞는 채널: ko↔en
+		{"C1", "Good morning", "ko"},
+		{"C2", "안녕하세요", "ja"}, // 맞지 않는 채널: 기본 한↔일
+		{"C2", "Good morning", ""},
+	}
+	for _, tt := range tests {
+		if got := app.targetLang(tt.channel, tt.input); got != tt.want {
+			t.Errorf("targetLang(%s, %q) = %q, want %q", tt.channel, tt.input, got, tt.want)
+		}
+	}
+
+	// 채널 정보는 채널마다 한 번만 조회한다
+	if n := len(fs.callsTo("conversations.info")); n != 2 {
+		t.Errorf("conversations.info 호출 수 = %d, want 2", n)
+	}
+}
+
+func TestTargetLangDisabledSkipsLookup(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{}, slack: client}
+
+	if got := app.targetLang("C1", "こんにちは"); got != "ko" {
+		t.Errorf("targetLang = %q, want ko", got)
+	}
+	if n := len(fs.callsTo("conversations.info")); n != 0 {
+		t.Errorf("패턴 미설정인데 conversations.info 호출 %d회", n)
+	}
+}
@@ -0,0 +1,20 @@
+package main
+
+import "log"
+
+// ─────────────────────────────────────
+// 채널별 번역 모델 (CHANNEL_MODEL_OVERRIDES)
+// 고객 응대 채널은 LLM 모델, 내부 채널은 NMT처럼 채널마다 품질/비용을 다르게 가져갈 수 있다.
+// 목록에 없는 채널은 기본 번역 함수(app.translate)를 그대로 쓴다.
+
+// 메시지가 올라온 채널에 맞는 번역 함수
+func (app *App) translatorFor(channelID string) func([]string, string) ([]string, error) {
+	model, ok := app.cfg.ChannelModelOverrides[channelID]
+	if !ok || model == "" || app.translateModel == nil {
+		return app.translate
+	}
+	return func(chunks []string, targetLang string) ([]string, error) {
+		log.Printf("[디버그] 채널별 번역 모델 사용 (channel=%s, model=%s)", channelID, model)
+		return app.translateModel(chunks, targetLang, model)
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+func TestProcessMessageChannelModelOverride(t *testing.T) {
+	tests := []struct {
+		channel   string
+		wantText  string
+		wantModel string
+	}{
+		{"CSUPPORT", "[model:general/translation-llm]안녕하세요", "general/translation-llm"},
+		{"CINTERNAL", "[model:general/nmt]안녕하세요", "general/nmt"},
+		{"COTHER", "[기본]안녕하세요", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.channel, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			var usedModel string
+			app := &App{
+				cfg: &Config{ChannelModelOverrides: map[string]string{
+					"CSUPPORT":  "general/translation-llm",
+					"CINTERNAL": "general/nmt",
+				}},
+				slack:     client,
+				translate: fakeTranslate("[기본]"),
+				translateModel: func(chunks []string, targetLang, model string) ([]string, error) {
+					usedModel = model
+					return fakeTranslate("[model:"+model+"]")(chunks, targetLang)
+				},
+			}
+
+			ev := &slackevents.MessageEvent{Channel: tt.channel, User: "U1", Text: "안녕하세요", TimeStamp: "1.0"}
+			if err := app.processMessage(ev); err != nil {
+				t.Fatalf("processMessage: %v", err)
+			}
+
+			posts := fs.callsTo("chat.postMessage")
+			if len(posts) != 1 {
+				t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+			}
+			if got := posts[0].Form.Get("text"); got != tt.wantText {
+				t.Errorf("text = %q, want %q", got, tt.wantText)
+			}
+			if usedModel != tt.wantModel {
+				t.Errorf("사용한 모델 = %q, want %q", usedModel, tt.wantModel)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+// ─────────────────────────────────────
+// 채널 주 언어와 같은 메시지 건너뛰기 (CHANNEL_NORM_WINDOW, opt-in)
+// 한국어만 오가는 채널에서 한국어 메시지까지 번역하지 않도록, 채널별로 최근 N개 메시지의 원문 언어를 센다.
+// 한 언어가 충분히(channelNormShare 이상) 우세하면 그 언어를 채널 주 언어로 보고,
+// 주 언어로 쓴 메시지는 건너뛰고 다른 언어로 쓴 메시지만 번역한다.
+// 집계는 Lambda 인스턴스 메모리에만 있으므로 콜드 스타트 후에는 다시 쌓일 때까지 모두 번역한다.
+
+const (
+	channelNormMinSamples = 5   // 주 언어를 판단하기 위한 최소 메시지 수
+	channelNormShare      = 0.8 // 주 언어로 보는 최소 비율
+)
+
+// 채널별 최근 메시지 언어 (오래된 것부터)
+type channelLangWindow struct {
+	langs []string
+}
+
+func (w *channelLangWindow) add(lang string, size int) {
+	w.langs = append(w.langs, lang)
+	if len(w.langs) > size {
+		w.langs = w.langs[len(w.langs)-size:]
+	}
+}
+
+// 우세한 언어 (표본이 적거나 어느 언어도 충분히 우세하지 않으면 "")
+func (w *channelLangWindow) dominant() string {
+	if len(w.langs) < channelNormMinSamples {
+		return ""
+	}
+	counts := map[string]int{}
+	best := ""
+	for _, lang := range w.langs {
+		counts[lang]++
+		if counts[lang] > counts[best] {
+			best = lang
+		}
+	}
+	if best == "" || float64(counts[best]) < channelNormShare*float64(len(w.langs)) {
+		return ""
+	}
+	return best
+}
+
+// 메시지 언어를 채널 집계에 더하고 현재 채널 주 언어를 반환 (기능이 꺼져 있으면 "")
+func (app *App) observeChannelLang(channelID, lang string) string {
+	size := app.cfg.ChannelNormWindow
+	if size <= 0 || channelID == "" || lang == "" {
+		return ""
+	}
+
+	app.channelNormsMu.Lock()
+	defer app.channelNormsMu.Unlock()
+
+	if app.channelNorms == nil {
+		app.channelNorms = map[string]*channelLangWindow{}
+	}
+	w, ok := app.channelNorms[channelID]
+	if !ok {
+		w = &channelLangWindow{}
+		app.channelNorms[channelID] = w
+	}
+	w.add(lang, size)
+	return w.dominant()
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+func TestChannelLangWindowDominant(t *testing.T) {
+	tests := []struct {
+		name  string
+		langs []string
+		want  string
+	}{
+		{"too few samples", []string{"ko", "ko", "ko"}, ""},
+		{"mostly korean", []string{"ko", "ko", "ko", "ko", "ja", "ko", "ko", "ko", "ko", "ko"}, "ko"},
+		{"mixed", []string{"ko", "ja", "ko", "ja", "ko", "ja"}, ""},
+		{"window drops old", []string{"ja", "ja", "ja", "ja", "ja", "ko", "ko", "ko", "ko", "ko"}, "ko"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &channelLangWindow{}
+			for _, lang := range tt.langs {
+				w.add(lang, 5)
+			}
+			if got := w.dominant(); got != tt.want {
+				t.Errorf("dominant = %q, want %q (window=%v)", got, tt.want, w.langs)
+			}
+		})
+	}
+}
+
+func TestProcessMessageChannelNorm(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{ChannelNormWindow: 10}, slack: client, translate: fakeTranslate("[번역]")}
+
+	send := func(channel, text string) {
+		t.Helper()
+		ev := &slackevents.MessageEvent{Channel: channel, User: "U1", Text: text, TimeStamp: "1.0"}
+		if err := app.processMessage(ev); err != nil {
+			t.Fatalf("processMessage: %v", err)
+		}
+	}
+	posts := func() int { return len(fs.callsTo("chat.postMessage")) }
+
+	// 주 언어가 정해지기 전에는 모두 번역
+	for i := 0; i < channelNormMinSamples; i++ {
+		send("CKO", "오늘 점심 뭐 먹을까요")
+	}
+	if got := posts(); got != channelNormMinSamples-1 {
+		t.Errorf("주 언어 확정 전 번역 수 = %d, want %d", got, channelNormMinSamples-1)
+	}
+
+	// 한국어가 주 언어인 채널: 한국어는 건너뛰고 일본어만 번역
+	before := posts()
+	send("CKO", "내일 회의는 10시예요")
+	if posts() != before {
+		t.Error("주 언어(한국어) 메시지를 번역함")
+	}
+	send("CKO", "明日の会議は何時ですか")
+	if posts() != before+1 {
+		t.Error("소수 언어(일본어) 메시지를 번역하지 않음")
+	}
+
+	// 다른 채널은 따로 센다
+	send("CMIX", "오늘 점심 뭐 먹을까요")
+	if posts() != before+2 {
+		t.Error("다른 채널 메시지를 번역하지 않음")
+	}
+}
+
+func TestProcessMessageChannelNormDisabled(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{}, slack: client, translate: fakeTranslate("[번역]")}
+	for i := 0; i < 10; i++ {
+		app.processMessage(&slackevents.MessageEvent{Channel: "C1", User: "U1", Text: "안녕하세요", TimeStamp: "1.0"})
+	}
+	if n := len(fs.callsTo("chat.postMessage")); n != 10 {
+		t.Errorf("번역 수 = %d, want 10", n)
+	}
+}
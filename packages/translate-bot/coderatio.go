@@ -0,0 +1,62 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// ─────────────────────────────────────
+// 코드 비중이 높은 메시지 건너뛰기 (SKIP_HIGH_CODE_RATIO, opt-in)
+// 로그나 스택 트레이스 위주의 메시지는 번역해도 쓸모가 없으므로, 코드처럼 보이는 부분의 비율이
+// 기준을 넘으면 번역하지 않는다. 코드 블록(```)과 인라인 코드(`)는 통째로 코드로 센다.
+// 리치 텍스트의 코드 블록은 이미 번역 대상에서 빠져 있으므로 남은 텍스트만 검사한다.
+// 멘션/링크 같은 Slack 토큰(<...>)은 어느 쪽으로도 세지 않는다.
+
+var (
+	fencedCodeRegex = regexp.MustCompile("(?s)```.*?```")
+	inlineCodeRegex = regexp.MustCompile("`[^`\n]+`")
+	slackTokenRegex = regexp.MustCompile(`<[@#!]?[^<>\s]+>`)
+
+	// 코드처럼 보이는 토큰: 괄호/연산자, 경로, 16진수, 점으로 이어진 식별자, 파일:줄번호
+	codeTokenRegex = regexp.MustCompile(`[{}\[\];<>=|$#]|\w\(|::|->|\w/\w|\\|0x[0-9a-fA-F]+|\b[0-9a-fA-F]{8,}\b|\w\.\w+\.\w|\.\w+:\d+`)
+)
+
+// 코드처럼 보이는 글자 수 / 전체 글자 수 (공백 제외)
+func codeRatio(text string) float64 {
+	text = slackTokenRegex.ReplaceAllString(text, " ")
+	total := countNonSpace(text)
+	if total == 0 {
+		return 0
+	}
+
+	code := 0
+	rest := fencedCodeRegex.ReplaceAllStringFunc(text, func(m string) string {
+		code += countNonSpace(m)
+		return " "
+	})
+	rest = inlineCodeRegex.ReplaceAllStringFunc(rest, func(m string) string {
+		code += countNonSpace(m)
+		return " "
+	})
+	for _, token := range strings.Fields(rest) {
+		if codeTokenRegex.MatchString(token) {
+			code += utf8.RuneCountInString(token)
+		}
+	}
+	return float64(code) / float64(total)
+}
+
+func countNonSpace(s string) int {
+	n := 0
+	for _, token := range strings.Fields(s) {
+		n += utf8.RuneCountInString(token)
+	}
+	return n
+}
+
+// 코드 비중이 기준을 넘는지 (기준 미설정이면 false)
+func (app *App) isCodeHeavy(text string) bool {
+	threshold := app.cfg.SkipHighCodeRatio
+	return threshold > 0 && codeRatio(text) > threshold
+}
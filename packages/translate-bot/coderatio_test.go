@@ -0,0 +1,65 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+const stackTraceMessage = `배포 후 에러
+panic: runtime error: invalid memory address or nil pointer dereference
+[signal SIGSEGV: segmentation violation code=0x1 addr=0x0 pc=0x4a2b3c]
+goroutine 1 [running]:
+main.(*App).processMessage(0xc000123456, 0xc000abcdef)
+	/app/packages/translate-bot/main.go:612 +0x1a4
+main.main()
+	/app/packages/translate-bot/main.go:845 +0x25`
+
+func TestCodeRatio(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		heavy bool
+	}{
+		{"natural korean", "내일 오전 10시에 회의실에서 배포 일정 논의해요. 다들 참석 부탁드려요!", false},
+		{"natural japanese with mention and link", "<@U123> 明日の会議の資料です <https://example.com/docs/a/b>", false},
+		{"natural with inline code", "설정에서 `TRIM_SIGNATURES` 옵션을 켜면 서명 아래는 번역되지 않아요", false},
+		{"stack trace", stackTraceMessage, true},
+		{"fenced log", "로그입니다\n```\nERROR 2024-01-01 db.conn.pool: timeout after 30s\nERROR retry=3 host=10.0.0.1\n```", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ratio := codeRatio(tt.text)
+			if got := ratio > 0.5; got != tt.heavy {
+				t.Errorf("codeRatio = %.2f, heavy = %t, want %t", ratio, got, tt.heavy)
+			}
+		})
+	}
+}
+
+func TestProcessMessageSkipsCodeHeavy(t *testing.T) {
+	tests := []struct {
+		name      string
+		threshold float64
+		text      string
+		wantPosts int
+	}{
+		{"stack trace skipped", 0.5, stackTraceMessage, 0},
+		{"natural translated", 0.5, "내일 회의 일정 공유드려요", 1},
+		{"check disabled", 0, stackTraceMessage, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: &Config{SkipHighCodeRatio: tt.threshold}, slack: client, translate: fakeTranslate("[ja]")}
+
+			ev := &slackevents.MessageEvent{Channel: "C1", User: "U1", Text: tt.text, TimeStamp: "1.0"}
+			if err := app.processMessage(ev); err != nil {
+				t.Fatalf("processMessage: %v", err)
+			}
+			if n := len(fs.callsTo("chat.postMessage")); n != tt.wantPosts {
+				t.Errorf("chat.postMessage 호출 수 = %d, want %d", n, tt.wantPosts)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+)
+
+// ─────────────────────────────────────
+// 번역 신뢰도 기준 (CONFIDENCE_THRESHOLD, opt-in)
+// translateText 응답에는 신뢰도가 없으므로 detectLanguage 응답의 원문 언어 감지 신뢰도를 쓴다.
+// 기준보다 낮으면 번역 끝에 경고 문구를 붙이거나(note, 기본) 게시하지 않는다(skip).
+
+const (
+	LowConfidenceNote = "note"
+	LowConfidenceSkip = "skip"
+)
+
+// detectLanguage 응답에서 가장 유력한 언어와 신뢰도 추출
+func parseDetectResponse(body []byte) (string, float64, error) {
+	var out struct {
+		Languages []struct {
+			LanguageCode string  `json:"languageCode"`
+			Confidence   float64 `json:"confidence"`
+		} `json:"languages"`
+	}
+	if err := json.Unmarshal(body, &out); err != nil {
+		return "", 0, err
+	}
+	if len(out.Languages) == 0 {
+		return "", 0, fmt.Errorf("감지된 언어 없음")
+	}
+
+	best := out.Languages[0]
+	for _, l := range out.Languages[1:] {
+		if l.Confidence > best.Confidence {
+			best = l
+		}
+	}
+	return best.LanguageCode, best.Confidence, nil
+}
+
+// Google Translate detectLanguage 호출
+func (app *App) detectLanguage(text string) (string, float64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	proj := app.cfg.GoogleCloudProject
+	loc := app.cfg.GoogleTranslateLoc
+	if loc == "" {
+		loc = "global"
+	}
+
+	token, err := app.googleAccessToken()
+	if err != nil {
+		return "", 0, err
+	}
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"content":  text,
+		"mimeType": "text/plain",
+	})
+	url := fmt.Sprintf("https://translation.googleapis.com/v3/projects/%s/locations/%s:detectLanguage", proj, loc)
+	req, _ := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", 0, err
+	}
+	defer resp.Body.Close()
+
+	respB, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return "", 0, fmt.Errorf("언어 감지 API 실패 (status=%d): %s", resp.StatusCode, respB)
+	}
+	return parseDetectResponse(respB)
+}
+
+// 원문 언어 감지 신뢰도가 기준 미만인지 확인 (기준 미설정이거나 감지 실패 시 false)
+func (app *App) lowConfidence(text string) (float64, bool) {
+	if app.cfg.ConfidenceThreshold <= 0 || app.detect == nil {
+		return 0, false
+	}
+	// 감지 API로도 개인정보가 나가지 않도록 번역과 같은 방식으로 가려서 보낸다
+	masked, _ := protectPII(text, app.piiPatterns())
+	lang, confidence, err := app.detect(masked)
+	if err != nil {
+		// 감지 실패로 번역을 막지는 않는다
+		log.Printf("[경고] 언어 감지 실패, 신뢰도 검사 생략: %v", err)
+		return 0, false
+	}
+	if confidence >= app.cfg.ConfidenceThreshold {
+		return confidence, false
+	}
+	log.Printf("[정보] 언어 감지 신뢰도 낮음 (lang=%s, confidence=%.2f, threshold=%.2f)", lang, confidence, app.cfg.ConfidenceThreshold)
+	return confidence, true
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+const (
+	highConfidenceResponse = `{"languages":[{"languageCode":"ko","confidence":0.98}]}`
+	lowConfidenceResponse  = `{"languages":[{"languageCode":"ja","confidence":0.31},{"languageCode":"ko","confidence":0.42}]}`
+)
+
+func TestParseDetectResponse(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantLang   string
+		wantConf   float64
+		wantErrNil bool
+	}{
+		{"high", highConfidenceResponse, "ko", 0.98, true},
+		{"low picks best", lowConfidenceResponse, "ko", 0.42, true},
+		{"empty", `{"languages":[]}`, "", 0, false},
+		{"invalid", `not json`, "", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lang, conf, err := parseDetectResponse([]byte(tt.body))
+			if (err == nil) != tt.wantErrNil {
+				t.Fatalf("err = %v", err)
+			}
+			if lang != tt.wantLang || conf != tt.wantConf {
+				t.Errorf("got (%q, %v), want (%q, %v)", lang, conf, tt.wantLang, tt.wantConf)
+			}
+		})
+	}
+}
+
+// 고정된 detectLanguage 응답을 돌려주는 가짜 감지 함수
+func fakeDetect(body string) func(string) (string, float64, error) {
+	return func(string) (string, float64, error) {
+		return parseDetectResponse([]byte(body))
+	}
+}
+
+func TestProcessMessageConfidenceThreshold(t *testing.T) {
+	tests := []struct {
+		name      string
+		detect    string
+		action    string
+		wantPosts int
+		wantNote  bool
+	}{
+		{"high confidence", highConfidenceResponse, "", 1, false},
+		{"low confidence note", lowConfidenceResponse, "", 1, true},
+		{"low confidence skip", lowConfidenceResponse, LowConfidenceSkip, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{
+				cfg:       &Config{ConfidenceThreshold: 0.7, LowConfidenceAction: tt.action},
+				slack:     client,
+				translate: fakeTranslate("[ja]"),
+				detect:    fakeDetect(tt.detect),
+			}
+
+			ev := &slackevents.MessageEvent{Channel: "C1", User: "U1", Text: "안녕하세요", TimeStamp: "1.0"}
+			if err := app.processMessage(ev); err != nil {
+				t.Fatalf("processMessage: %v", err)
+			}
+
+			posts := fs.callsTo("chat.postMessage")
+			if len(posts) != tt.wantPosts {
+				t.Fatalf("chat.postMessage 호출 수 = %d, want %d", len(posts), tt.wantPosts)
+			}
+			if tt.wantPosts == 0 {
+				return
+			}
+			text := posts[0].Form.Get("text")
+			if !strings.HasPrefix(text, "[ja]안녕하세요") {
+				t.Errorf("text = %q", text)
+			}
+			if got := strings.Contains(text, "⚠️ 翻訳品質が低い可能性があります (言語検出の信頼度 42%)"); got != tt.wantNote {
+				t.Errorf("경고 문구 포함 = %t, want %t (text=%q)", got, tt.wantNote, text)
+			}
+		})
+	}
+}
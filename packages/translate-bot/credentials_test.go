@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+// 발급할 때마다 번호가 붙은 토큰을 돌려주는 토큰 소스
+type countingTokenSource struct {
+	issued int
+	expiry time.Time
+}
+
+func (s *countingTokenSource) Token() (*oauth2.Token, error) {
+	s.issued++
+	return &oauth2.Token{AccessToken: fmt.Sprintf("token-%d", s.issued), Expiry: s.expiry}, nil
+}
+
+func TestGoogleCredentialsLoadedOnce(t *testing.T) {
+	loads := 0
+	src := &countingTokenSource{expiry: time.Now().Add(time.Hour)}
+	app := &App{cfg: &Config{}}
+	app.loadCredentials = func(ctx context.Context) (*google.Credentials, error) {
+		loads++
+		return &google.Credentials{TokenSource: oauth2.ReuseTokenSource(nil, src)}, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		token, err := app.googleAccessToken()
+		if err != nil {
+			t.Fatalf("googleAccessToken: %v", err)
+		}
+		if token != "token-1" {
+			t.Errorf("%d번째 토큰 = %q, want token-1 (캐시된 토큰 재사용)", i+1, token)
+		}
+	}
+	if loads != 1 {
+		t.Errorf("인증 정보 로드 횟수 = %d, want 1", loads)
+	}
+}
+
+func TestGoogleTokenRefreshedOnExpiry(t *testing.T) {
+	loads := 0
+	// 이미 만료된 토큰만 발급 → 매번 새로 발급받아야 한다
+	src := &countingTokenSource{expiry: time.Now().Add(-time.Minute)}
+	app := &App{cfg: &Config{}}
+	app.loadCredentials = func(ctx context.Context) (*google.Credentials, error) {
+		loads++
+		return &google.Credentials{TokenSource: oauth2.ReuseTokenSource(nil, src)}, nil
+	}
+
+	first, _ := app.googleAccessToken()
+	second, err := app.googleAccessToken()
+	if err != nil {
+		t.Fatalf("googleAccessToken: %v", err)
+	}
+	if first == second {
+		t.Errorf("만료된 토큰이 갱신되지 않음 (%q)", second)
+	}
+	if loads != 1 {
+		t.Errorf("인증 정보 로드 횟수 = %d, want 1 (갱신은 토큰 소스가 처리)", loads)
+	}
+}
+
+func TestGoogleCredentialsFailureNotCached(t *testing.T) {
+	loads := 0
+	app := &App{cfg: &Config{}}
+	app.loadCredentials = func(ctx context.Context) (*google.Credentials, error) {
+		loads++
+		if loads == 1 {
+			return nil, errors.New("일시적 오류")
+		}
+		return &google.Credentials{TokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "ok"})}, nil
+	}
+
+	if _, err := app.googleAccessToken(); err == nil {
+		t.Fatal("첫 호출 에러가 전달되지 않음")
+	}
+	token, err := app.googleAccessToken()
+	if err != nil || token != "ok" {
+		t.Errorf("재시도 결과 = %q, %v, want ok", token, err)
+	}
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ─────────────────────────────────────
+// Slack 날짜 토큰 보호
+// `<!date^1700000000^{date_short}|2023-11-14>` 같은 토큰은 보는 사람의 시간대로 렌더링되는데,
+// 번역 API를 거치면 기호나 포맷 문자열이 바뀌어 깨진다. 번역 전 자리표시자로 바꾸고 번역 후 원문 그대로 되돌린다.
+
+var slackDateTokenRegex = regexp.MustCompile(`<!date\^\d+\^[^>]*>`)
+
+func protectDateTokens(text string) (string, []string) {
+	var replacements []string
+	result := slackDateTokenRegex.ReplaceAllStringFunc(text, func(match string) string {
+		placeholder := fmt.Sprintf("__DATE%d__", len(replacements))
+		replacements = append(replacements, match)
+		return placeholder
+	})
+	return result, replacements
+}
+
+func restoreDateTokens(text string, replacements []string) string {
+	for i, replacement := range replacements {
+		placeholder := fmt.Sprintf("__DATE%d__", i)
+		text = strings.ReplaceAll(text, placeholder, replacement)
+	}
+	return text
+}
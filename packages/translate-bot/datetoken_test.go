@@ -0,0 +1,65 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// 날짜 토큰의 기호/포맷 문자열을 망가뜨리는 가짜 번역 함수
+func dateManglingTranslate(chunks []string, targetLang string) ([]string, error) {
+	r := strings.NewReplacer("<!date", "＜！日付", "^", "＾", "{date_short}", "{日付}", "|", "｜", "회의", "会議")
+	out := make([]string, len(chunks))
+	for i, c := range chunks {
+		out[i] = r.Replace(c)
+	}
+	return out, nil
+}
+
+func TestDateTokensSurviveTranslation(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			"single token",
+			"회의는 <!date^1700000000^{date_short} {time}|2023-11-14 22:13> 에 시작",
+			"会議는 <!date^1700000000^{date_short} {time}|2023-11-14 22:13> 에 시작",
+		},
+		{
+			"token with link and no fallback",
+			"회의 <!date^1700000000^{date_short}^https://example.com> 참고",
+			"会議 <!date^1700000000^{date_short}^https://example.com> 참고",
+		},
+		{
+			"multiple tokens",
+			"회의 <!date^1700000000^{date}|A>~<!date^1700003600^{time}|B>",
+			"会議 <!date^1700000000^{date}|A>~<!date^1700003600^{time}|B>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &App{cfg: &Config{}, translate: dateManglingTranslate}
+			got, err := app.translateText(tt.input, "ja")
+			if err != nil {
+				t.Fatalf("translateText: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("translateText(%q)\n got  %q\n want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateTokenKeepsRepeatedDigits(t *testing.T) {
+	// 타임스탬프의 연속된 0이 반복 정규화/캡에 걸리지 않아야 한다
+	app := &App{cfg: &Config{}, translate: fakeTranslate("")}
+	input := "ㅋㅋㅋㅋㅋ <!date^1700000000^{date}|x>"
+	got, err := app.translateText(input, "ja")
+	if err != nil {
+		t.Fatalf("translateText: %v", err)
+	}
+	if !strings.Contains(got, "<!date^1700000000^{date}|x>") {
+		t.Errorf("날짜 토큰이 바뀜: %q", got)
+	}
+}
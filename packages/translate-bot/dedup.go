@@ -0,0 +1,55 @@
+package main
+
+import (
+	"time"
+)
+
+// ─────────────────────────────────────
+// 연속 중복 번역 건너뛰기 (DEDUP_WINDOW_SECONDS, opt-in)
+// 같은 문구가 반복해서 올라오는 채널에서 똑같은 번역이 연달아 쌓이지 않도록,
+// 채널별로 마지막에 게시한 번역문과 게시 시각을 기억해 두고 창 안에서 같은 번역문이 바로 이어지면 게시하지 않는다.
+// 그 사이에 다른 번역이 하나라도 게시되면 비교 대상이 바뀌므로 A → B → A는 모두 게시한다.
+// 기록은 Lambda 인스턴스 메모리에만 있으므로 콜드 스타트 후 첫 번역은 항상 게시한다.
+
+// 채널의 마지막 번역 게시 기록
+type lastTranslation struct {
+	hash string
+	at   time.Time
+}
+
+// 중복 확인 창 (0이면 기능 꺼짐)
+func (app *App) dedupWindow() time.Duration {
+	if app.cfg.DedupWindowSeconds <= 0 {
+		return 0
+	}
+	return time.Duration(app.cfg.DedupWindowSeconds) * time.Second
+}
+
+// 채널에 마지막으로 게시한 번역과 같은 번역이 창 안에 다시 나왔는지
+func (app *App) isDuplicateTranslation(channelID, text string) bool {
+	window := app.dedupWindow()
+	if window == 0 || channelID == "" {
+		return false
+	}
+
+	app.lastTranslationsMu.Lock()
+	defer app.lastTranslationsMu.Unlock()
+
+	last, ok := app.lastTranslations[channelID]
+	return ok && last.hash == sourceHash(text) && time.Since(last.at) < window
+}
+
+// 채널에 게시한 번역 기록 (기능이 꺼져 있으면 기록하지 않음)
+func (app *App) rememberPostedTranslation(channelID, text string) {
+	if app.dedupWindow() == 0 || channelID == "" {
+		return
+	}
+
+	app.lastTranslationsMu.Lock()
+	defer app.lastTranslationsMu.Unlock()
+
+	if app.lastTranslations == nil {
+		app.lastTranslations = map[string]lastTranslation{}
+	}
+	app.lastTranslations[channelID] = lastTranslation{hash: sourceHash(text), at: time.Now()}
+}
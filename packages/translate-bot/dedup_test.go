@@ -0,0 +1,51 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+func TestProcessMessageDedup(t *testing.T) {
+	tests := []struct {
+		name   string
+		window int
+		msgs   []string // "채널:본문"
+		want   int
+	}{
+		{"consecutive identical skipped", 60, []string{"C1:안녕하세요", "C1:안녕하세요", "C1:안녕하세요"}, 1},
+		{"interleaved distinct all posted", 60, []string{"C1:안녕하세요", "C1:감사합니다", "C1:안녕하세요", "C1:감사합니다"}, 4},
+		{"per channel", 60, []string{"C1:안녕하세요", "C2:안녕하세요"}, 2},
+		{"disabled", 0, []string{"C1:안녕하세요", "C1:안녕하세요"}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: &Config{DedupWindowSeconds: tt.window}, slack: client, translate: fakeTranslate("[번역]")}
+
+			for i, msg := range tt.msgs {
+				ev := &slackevents.MessageEvent{Channel: msg[:2], User: "U1", Text: msg[3:], TimeStamp: string(rune('1'+i)) + ".0"}
+				if err := app.processMessage(ev); err != nil {
+					t.Fatalf("processMessage: %v", err)
+				}
+			}
+			if got := len(fs.callsTo("chat.postMessage")); got != tt.want {
+				t.Errorf("게시 수 = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDedupWindowExpires(t *testing.T) {
+	app := &App{cfg: &Config{DedupWindowSeconds: 30}}
+	app.rememberPostedTranslation("C1", "[번역] 안녕하세요")
+	if !app.isDuplicateTranslation("C1", "[번역] 안녕하세요") {
+		t.Fatal("창 안의 같은 번역을 중복으로 보지 않음")
+	}
+
+	app.lastTranslations["C1"] = lastTranslation{hash: sourceHash("[번역] 안녕하세요"), at: time.Now().Add(-31 * time.Second)}
+	if app.isDuplicateTranslation("C1", "[번역] 안녕하세요") {
+		t.Error("창이 지난 번역을 중복으로 봄")
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"strings"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+// ─────────────────────────────────────
+// 수정된 메시지 재번역 (TRANSLATE_EDITS, opt-in)
+// message_changed 이벤트는 링크 미리보기가 붙을 때처럼 본문이 그대로인 경우에도 온다.
+// 마지막으로 번역한 원문의 해시를 메시지별로 기억해두고, 본문이 실제로 바뀐 경우에만 스레드에 다시 번역한다.
+// 해시는 Lambda 인스턴스 메모리에만 있으므로 콜드 스타트 후에는 이전 본문(previous_message)과 비교한다.
+
+const (
+	subtypeMessageChanged = "message_changed"
+	maxTranslatedSources  = 2000
+)
+
+// 메시지별 마지막 번역 원문 해시 (오래된 것부터 밀어낸다)
+type translatedSources struct {
+	hashes map[string]string
+	order  []string
+}
+
+func sourceHash(text string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(text)))
+	return hex.EncodeToString(sum[:])
+}
+
+func sourceKey(channel, ts string) string {
+	return channel + "|" + ts
+}
+
+// 번역해 게시한 원문 기록
+func (app *App) rememberTranslated(channel, ts, text string) {
+	if !app.cfg.TranslateEdits {
+		return
+	}
+
+	app.translatedMu.Lock()
+	defer app.translatedMu.Unlock()
+
+	s := &app.translated
+	if s.hashes == nil {
+		s.hashes = map[string]string{}
+	}
+	key := sourceKey(channel, ts)
+	if _, ok := s.hashes[key]; !ok {
+		s.order = append(s.order, key)
+		if len(s.order) > maxTranslatedSources {
+			delete(s.hashes, s.order[0])
+			s.order = s.order[1:]
+		}
+	}
+	s.hashes[key] = sourceHash(text)
+}
+
+// 마지막으로 번역한 원문과 같은 본문인지
+func (app *App) alreadyTranslated(channel, ts, text string) bool {
+	app.translatedMu.Lock()
+	defer app.translatedMu.Unlock()
+
+	hash, ok := app.translated.hashes[sourceKey(channel, ts)]
+	return ok && hash == sourceHash(text)
+}
+
+// message_changed 이벤트 처리: 본문이 바뀌었으면 수정된 메시지를 새 메시지처럼 번역
+func (app *App) processEdit(ev *slackevents.MessageEvent) error {
+	if !app.cfg.TranslateEdits {
+		return nil
+	}
+	msg := ev.Message
+	if msg == nil || msg.BotID != "" {
+		return nil
+	}
+
+	if app.alreadyTranslated(ev.Channel, msg.TimeStamp, msg.Text) {
+		log.Printf("[스킵] 본문 변경 없는 수정 (channel=%s, ts=%s)", ev.Channel, msg.TimeStamp)
+		return nil
+	}
+	if prev := ev.PreviousMessage; prev != nil && strings.TrimSpace(prev.Text) == strings.TrimSpace(msg.Text) {
+		log.Printf("[스킵] 본문 변경 없는 수정 (channel=%s, ts=%s)", ev.Channel, msg.TimeStamp)
+		return nil
+	}
+
+	log.Printf("[정보] 수정된 메시지 재번역 (channel=%s, ts=%s)", ev.Channel, msg.TimeStamp)
+	return app.processMessage(&slackevents.MessageEvent{
+		Channel:         ev.Channel,
+		User:            msg.User,
+		Text:            msg.Text,
+		TimeStamp:       msg.TimeStamp,
+		ThreadTimeStamp: msg.ThreadTimeStamp,
+		Blocks:          msg.Blocks,
+		Attachments:     msg.Attachments,
+	})
+}
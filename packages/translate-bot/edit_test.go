@@ -0,0 +1,71 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+func editEvent(prev, text string) *slackevents.MessageEvent {
+	return &slackevents.MessageEvent{
+		Channel:         "C1",
+		SubType:         subtypeMessageChanged,
+		Message:         &slackevents.MessageEvent{User: "U1", Text: text, TimeStamp: "1.0"},
+		PreviousMessage: &slackevents.MessageEvent{User: "U1", Text: prev, TimeStamp: "1.0"},
+	}
+}
+
+func TestProcessEdit(t *testing.T) {
+	tests := []struct {
+		name      string
+		enabled   bool
+		prev      string
+		edited    string
+		wantPosts int
+	}{
+		{"no-op edit (unfurl)", true, "안녕하세요 https://example.com", "안녕하세요 https://example.com", 1},
+		{"real edit", true, "안녕하세요", "안녕하세요, 내일 뵙겠습니다", 2},
+		{"disabled", false, "안녕하세요", "안녕하세요, 내일 뵙겠습니다", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: &Config{TranslateEdits: tt.enabled}, slack: client, translate: fakeTranslate("[번역]")}
+
+			// 처음 게시된 메시지 번역
+			if err := app.processMessage(&slackevents.MessageEvent{Channel: "C1", User: "U1", Text: tt.prev, TimeStamp: "1.0"}); err != nil {
+				t.Fatalf("processMessage: %v", err)
+			}
+			// 수정 이벤트 (이전 본문을 모르는 콜드 스타트도 해시로 판단하도록 previous_message는 비움)
+			ev := editEvent(tt.prev, tt.edited)
+			ev.PreviousMessage = nil
+			if err := app.processMessage(ev); err != nil {
+				t.Fatalf("processMessage(edit): %v", err)
+			}
+
+			posts := fs.callsTo("chat.postMessage")
+			if len(posts) != tt.wantPosts {
+				t.Fatalf("chat.postMessage 호출 수 = %d, want %d", len(posts), tt.wantPosts)
+			}
+			if tt.wantPosts == 2 {
+				last := posts[1].Form
+				if last.Get("text") != "[번역]"+tt.edited || last.Get("thread_ts") != "1.0" {
+					t.Errorf("재번역 = %q (thread_ts=%q)", last.Get("text"), last.Get("thread_ts"))
+				}
+			}
+		})
+	}
+}
+
+func TestProcessEditComparesPreviousMessage(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{TranslateEdits: true}, slack: client, translate: fakeTranslate("[번역]")}
+
+	// 번역 기록이 없어도(콜드 스타트) 이전 본문과 같으면 건너뜀
+	if err := app.processMessage(editEvent("안녕하세요", "안녕하세요")); err != nil {
+		t.Fatalf("processMessage: %v", err)
+	}
+	if n := len(fs.callsTo("chat.postMessage")); n != 0 {
+		t.Errorf("본문 변경 없는 수정을 번역함 (%d회)", n)
+	}
+}
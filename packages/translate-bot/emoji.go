@@ -0,0 +1,78 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ─────────────────────────────────────
+// 앞뒤 이모지 보존 ("👍 좋아요!" → 이모지는 그대로 두고 텍스트만 번역)
+// 번역 API가 이모지 위치를 바꾸는 것을 막기 위해 메시지 앞뒤의 이모지를 떼어냈다가 번역 후 같은 위치에 다시 붙인다.
+
+const (
+	EdgeEmojiPreserve = "preserve" // 기본값: 앞뒤 이모지를 분리해 위치 고정
+	EdgeEmojiInline   = "inline"   // 이모지를 포함한 원문 그대로 번역 API에 전달
+)
+
+// Slack 이모지 코드 (:thumbsup:, :+1:, :skin-tone-2: 등)
+var (
+	leadingShortcodeRegex  = regexp.MustCompile(`^:[a-z0-9_+'-]+:`)
+	trailingShortcodeRegex = regexp.MustCompile(`:[a-z0-9_+'-]+:$`)
+)
+
+// 이모지를 구성하는 룬인지 확인 (기호, 국기, 피부색 수식자, ZWJ, 변형 선택자)
+func isEmojiRune(r rune) bool {
+	switch {
+	case r == 0x200D, r == 0xFE0F, r == 0x20E3:
+		return true
+	case r >= 0x1F3FB && r <= 0x1F3FF:
+		return true
+	}
+	return unicode.Is(unicode.So, r)
+}
+
+// 메시지를 앞쪽 이모지, 본문, 뒤쪽 이모지로 분리한다.
+// 이모지 사이/주변 공백은 이모지 쪽에 포함되어 다시 합칠 때 원래 간격이 유지된다.
+func splitEdgeEmoji(text string) (prefix, body, suffix string) {
+	start := 0
+	for start < len(text) {
+		rest := text[start:]
+		if loc := leadingShortcodeRegex.FindStringIndex(rest); loc != nil {
+			start += loc[1]
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(rest)
+		if !isEmojiRune(r) && !unicode.IsSpace(r) {
+			break
+		}
+		start += size
+	}
+
+	end := len(text)
+	for end > start {
+		rest := text[start:end]
+		if loc := trailingShortcodeRegex.FindStringIndex(rest); loc != nil {
+			end = start + loc[0]
+			continue
+		}
+		r, size := utf8.DecodeLastRuneInString(rest)
+		if !isEmojiRune(r) && !unicode.IsSpace(r) {
+			break
+		}
+		end -= size
+	}
+
+	// 이모지 없이 공백만 있으면 분리하지 않는다
+	prefix, body, suffix = text[:start], text[start:end], text[end:]
+	if strings.TrimSpace(prefix) == "" {
+		body = prefix + body
+		prefix = ""
+	}
+	if strings.TrimSpace(suffix) == "" {
+		body += suffix
+		suffix = ""
+	}
+	return prefix, body, suffix
+}
@@ -0,0 +1,102 @@
+package main
+
+import "testing"
+
+func TestSplitEdgeEmoji(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantPrefix string
+		wantBody   string
+		wantSuffix string
+	}{
+		{
+			name:       "leading_unicode_emoji",
+			input:      "👍 좋아요!",
+			wantPrefix: "👍 ",
+			wantBody:   "좋아요!",
+		},
+		{
+			name:       "trailing_unicode_emoji",
+			input:      "お疲れ様です 🙏🙏",
+			wantBody:   "お疲れ様です",
+			wantSuffix: " 🙏🙏",
+		},
+		{
+			name:       "both_sides_with_shortcode",
+			input:      ":tada: 배포 완료했습니다 :rocket:",
+			wantPrefix: ":tada: ",
+			wantBody:   "배포 완료했습니다",
+			wantSuffix: " :rocket:",
+		},
+		{
+			name:       "zwj_and_skin_tone_sequence",
+			input:      "👩‍💻👍🏻 확인했습니다",
+			wantPrefix: "👩‍💻👍🏻 ",
+			wantBody:   "확인했습니다",
+		},
+		{
+			name:     "emoji_in_middle_untouched",
+			input:    "오늘 🍕 먹어요",
+			wantBody: "오늘 🍕 먹어요",
+		},
+		{
+			name:     "whitespace_only_edges_stay_in_body",
+			input:    "  안녕하세요  ",
+			wantBody: "  안녕하세요  ",
+		},
+		{
+			name:       "emoji_only",
+			input:      "🎉🎉",
+			wantPrefix: "🎉🎉",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefix, body, suffix := splitEdgeEmoji(tt.input)
+			if prefix != tt.wantPrefix || body != tt.wantBody || suffix != tt.wantSuffix {
+				t.Errorf("got (%q, %q, %q), want (%q, %q, %q)",
+					prefix, body, suffix, tt.wantPrefix, tt.wantBody, tt.wantSuffix)
+			}
+		})
+	}
+}
+
+func TestEndToEnd_EdgeEmojiPosition(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		fakeTrans string
+		want      string
+	}{
+		{
+			name:      "leading_emoji_stays_leading",
+			input:     "👍 좋아요!",
+			fakeTrans: "いいですね！",
+			want:      "👍 いいですね！",
+		},
+		{
+			name:      "trailing_emoji_stays_trailing",
+			input:     "ありがとうございます :pray:",
+			fakeTrans: "감사합니다",
+			want:      "감사합니다 :pray:",
+		},
+		{
+			name:      "both_sides",
+			input:     "🔥 대박 🔥",
+			fakeTrans: "すごい",
+			want:      "🔥 すごい 🔥",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefix, _, suffix := splitEdgeEmoji(tt.input)
+			got := prefix + tt.fakeTrans + suffix
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
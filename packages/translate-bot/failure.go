@@ -0,0 +1,43 @@
+package main
+
+import (
+	"log"
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 번역 실패 시 원문 게시 (POST_ORIGINAL_ON_FAILURE, opt-in)
+// 번역 API가 끝내 실패하면 메시지가 조용히 번역 없이 남는다.
+// 대신 스레드에 경고와 함께 원문을 인용해 올려 채널 멤버가 실패를 알 수 있게 한다.
+// 번역 메타데이터를 함께 달아두므로, 나중에 🔁 반응을 달면 이 메시지가 번역으로 바뀐다.
+
+// 원문을 인용 형태로 감싼 경고 메시지 (경고 문구는 번역 언어로)
+func (app *App) originalFallbackText(original, lang string) string {
+	lines := strings.Split(strings.TrimSpace(original), "\n")
+	for i, line := range lines {
+		lines[i] = "> " + line
+	}
+	return app.botText(msgTranslationFailed, lang) + "\n" + strings.Join(lines, "\n")
+}
+
+// 번역에 실패한 메시지의 원문을 스레드에 게시 (설정이 꺼져 있으면 아무것도 하지 않음)
+func (app *App) postOriginalOnFailure(channel, threadTS, sourceTS, lang, original string) {
+	if !app.cfg.PostOriginalOnFailure || strings.TrimSpace(original) == "" {
+		return
+	}
+
+	text := app.originalFallbackText(original, lang)
+	err := app.postWithRetry(
+		deadLetterRecord{Channel: channel, ThreadTS: threadTS, SourceTS: sourceTS, Lang: lang, Text: text},
+		slack.MsgOptionText(text, false),
+		slack.MsgOptionTS(threadTS),
+		slack.MsgOptionMetadata(translationMetadata(sourceTS, lang)),
+	)
+	if err != nil {
+		log.Printf("[에러] 번역 실패 원문 게시 실패 (channel=%s, ts=%s): %v", channel, sourceTS, err)
+		return
+	}
+	log.Printf("[경고] 번역 실패, 원문 게시 (channel=%s, ts=%s)", channel, sourceTS)
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+func TestPostOriginalOnFailure(t *testing.T) {
+	tests := []struct {
+		name      string
+		enabled   bool
+		wantPosts int
+	}{
+		{"posts original with warning", true, 1},
+		{"disabled", false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			failing := func([]string, string) ([]string, error) { return nil, errors.New("503 Service Unavailable") }
+			app := &App{cfg: &Config{PostOriginalOnFailure: tt.enabled}, slack: client, translate: failing}
+
+			ev := &slackevents.MessageEvent{Channel: "C1", User: "U1", Text: "오늘 회의는\n3시로 옮길게요", TimeStamp: "1.0"}
+			if err := app.processMessage(ev); err == nil {
+				t.Error("번역 실패가 에러로 반환되지 않음")
+			}
+
+			posts := fs.callsTo("chat.postMessage")
+			if len(posts) != tt.wantPosts {
+				t.Fatalf("chat.postMessage 호출 수 = %d, want %d", len(posts), tt.wantPosts)
+			}
+			if tt.wantPosts == 0 {
+				return
+			}
+			form := posts[0].Form
+			// 원문 언어(ko)를 읽지 못하는 번역 대상 언어(ja) 사용자를 위한 안내
+			want := "⚠️ 自動翻訳に失敗しました — 原文\n> 오늘 회의는\n> 3시로 옮길게요"
+			if got := form.Get("text"); got != want {
+				t.Errorf("게시 내용 = %q, want %q", got, want)
+			}
+			if form.Get("thread_ts") != "1.0" {
+				t.Errorf("thread_ts = %q, want 1.0", form.Get("thread_ts"))
+			}
+			// 🔁 재번역으로 원문을 찾을 수 있도록 메타데이터 기록
+			if !strings.Contains(form.Get("metadata"), `"source_ts":"1.0"`) {
+				t.Errorf("metadata = %q", form.Get("metadata"))
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 테스트용 가짜 Slack API 서버
+
+type fakeSlackCall struct {
+	Method string
+	Form   url.Values
+}
+
+type fakeSlack struct {
+	mu        sync.Mutex
+	calls     []fakeSlackCall
+	responses map[string]string // method → 응답 JSON (없으면 기본 성공 응답)
+	// 요청 내용에 따라 응답을 고를 때 사용 (빈 문자열이면 responses/기본 응답으로 진행)
+	respond func(method string, form url.Values) string
+	// method → 남은 실패 횟수 (그동안 503 응답)
+	failures map[string]int
+}
+
+func newFakeSlack(t *testing.T) (*fakeSlack, *slack.Client) {
+	t.Helper()
+	fs := &fakeSlack{responses: map[string]string{}, failures: map[string]int{}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		form, _ := url.ParseQuery(string(raw))
+		method := strings.TrimPrefix(r.URL.Path, "/")
+
+		fs.mu.Lock()
+		fs.calls = append(fs.calls, fakeSlackCall{Method: method, Form: form})
+		resp, ok := fs.responses[method]
+		respond := fs.respond
+		fail := fs.failures[method] > 0
+		if fail {
+			fs.failures[method]--
+		}
+		fs.mu.Unlock()
+
+		if fail {
+			http.Error(w, "service unavailable", http.StatusServiceUnavailable)
+			return
+		}
+
+		if respond != nil {
+			if r := respond(method, form); r != "" {
+				resp, ok = r, true
+			}
+		}
+
+		if !ok {
+			resp = `{"ok":true,"channel":"` + form.Get("channel") + `","ts":"1700000000.000100"}`
+		}
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, resp)
+	}))
+	t.Cleanup(srv.Close)
+	return fs, slack.New("xoxb-test", slack.OptionAPIURL(srv.URL+"/"))
+}
+
+// 특정 메서드 호출 목록
+func (fs *fakeSlack) callsTo(method string) []fakeSlackCall {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	var out []fakeSlackCall
+	for _, c := range fs.calls {
+		if c.Method == method {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// 입력을 접두어와 함께 그대로 돌려주는 가짜 번역 함수
+func fakeTranslate(prefix string) func([]string, string) ([]string, error) {
+	return func(chunks []string, targetLang string) ([]string, error) {
+		out := make([]string, len(chunks))
+		for i, c := range chunks {
+			out[i] = prefix + c
+		}
+		return out, nil
+	}
+}
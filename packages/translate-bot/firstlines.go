@@ -0,0 +1,34 @@
+package main
+
+import "strings"
+
+// ─────────────────────────────────────
+// 긴 메시지 앞부분만 번역 (TRANSLATE_FIRST_LINES, opt-in)
+// 회의록이나 공지처럼 아주 긴 메시지는 앞 몇 줄만 읽어도 대강 파악되므로, 앞 N줄만 번역하고
+// 뒤에 "...(이하 생략)" 표시를 붙인다. 남긴 줄은 줄바꿈 그대로 번역한다.
+
+// 대상 언어별 생략 표시 (없는 언어는 한국어)
+var omittedMarkers = map[string]string{
+	"ko": "...(이하 생략)",
+	"ja": "...(以下省略)",
+	"en": "...(remaining lines omitted)",
+}
+
+func omittedMarker(lang string) string {
+	if marker, ok := omittedMarkers[lang]; ok {
+		return marker
+	}
+	return omittedMarkers["ko"]
+}
+
+// 앞 n줄만 남김 (n 이하이거나 n이 0 이하면 그대로, 잘랐으면 true)
+func takeFirstLines(text string, n int) (string, bool) {
+	if n <= 0 {
+		return text, false
+	}
+	lines := strings.Split(text, "\n")
+	if len(lines) <= n {
+		return text, false
+	}
+	return strings.Join(lines[:n], "\n"), true
+}
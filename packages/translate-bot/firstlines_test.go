@@ -0,0 +1,58 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTakeFirstLines(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		n       int
+		want    string
+		wantCut bool
+	}{
+		{"disabled", "a\nb\nc", 0, "a\nb\nc", false},
+		{"short message", "a\nb", 3, "a\nb", false},
+		{"exactly n lines", "a\nb\nc", 3, "a\nb\nc", false},
+		{"long message", "a\nb\n\nc\nd", 3, "a\nb\n", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, cut := takeFirstLines(tt.input, tt.n)
+			if got != tt.want || cut != tt.wantCut {
+				t.Errorf("takeFirstLines(%q, %d) = %q, %t, want %q, %t", tt.input, tt.n, got, cut, tt.want, tt.wantCut)
+			}
+		})
+	}
+}
+
+func TestTranslateFirstLinesOnly(t *testing.T) {
+	var sent []string
+	app := &App{cfg: &Config{TranslateFirstLines: 3}}
+	translate := func(chunks []string, lang string) ([]string, error) {
+		sent = append(sent, chunks...)
+		return fakeTranslate("["+lang+"] ")(chunks, lang)
+	}
+
+	lines := []string{"회의록", "1. 배포 일정 확정", "2. 리뷰 담당 변경", "3. 다음 회의는 금요일", "4. 기타 안건", "5. 끝"}
+	got, err := app.translateTextWith(translate, strings.Join(lines, "\n"), "ja")
+	if err != nil {
+		t.Fatalf("translateTextWith: %v", err)
+	}
+
+	// 앞 3줄만 번역 API로 보내고 줄바꿈은 그대로 유지
+	if want := "회의록\n1. 배포 일정 확정\n2. 리뷰 담당 변경"; strings.Join(sent, "") != want {
+		t.Errorf("번역 요청 = %q, want %q", strings.Join(sent, ""), want)
+	}
+	if want := "[ja] 회의록\n1. 배포 일정 확정\n2. 리뷰 담당 변경\n...(以下省略)"; got != want {
+		t.Errorf("번역 결과 = %q, want %q", got, want)
+	}
+
+	// 짧은 메시지는 생략 표시 없이 전체 번역
+	got, _ = app.translateTextWith(translate, "안녕하세요\n반갑습니다", "ko")
+	if strings.Contains(got, "생략") {
+		t.Errorf("짧은 메시지에 생략 표시: %q", got)
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ─────────────────────────────────────
+// 언어별 출력 표기 규칙 (FORMAT_RULES)
+// POST_PROCESS가 기계번역 잔여물을 정리한다면, 이 규칙은 대상 언어의 표기 관습을 맞춘다.
+// 대상 언어 → 규칙 이름 목록으로 켜고, 보호 표현(웃음/통화/키워드) 복원과 후처리 뒤에 적용한다.
+// URL과 날짜 토큰은 아직 보호된 상태라 규칙에 걸리지 않는다.
+
+var (
+	// 숫자와 단위/수량 단위 사이 공백 ("3 개" → "3개")
+	koNumberUnitRegex = regexp.MustCompile(`(\d) +(개월|시간|퍼센트|달러|개|명|분|일|월|년|원|엔|번|건|주|초|층|회|살|세|배|%)`)
+	// 반각 문장부호 뒤에 바로 붙은 한글 ("네.알겠습니다" → "네. 알겠습니다")
+	koSentenceSpacingRegex = regexp.MustCompile(`([.!?,])(\p{Hangul})`)
+	// 일본어 문자 뒤 반각 문장부호 ("了解です." → "了解です。")
+	jaHalfWidthPunctRegex = regexp.MustCompile(`([\p{Han}\p{Hiragana}\p{Katakana}ー])([,.!?]) ?`)
+)
+
+var jaFullWidthPunct = map[string]string{",": "、", ".": "。", "!": "！", "?": "？"}
+
+// 대상 언어별 사용할 수 있는 규칙
+var formatRules = map[string]map[string]func(string) string{
+	"ko": {
+		"number_unit_spacing": func(s string) string {
+			return koNumberUnitRegex.ReplaceAllString(s, "$1$2")
+		},
+		"sentence_spacing": func(s string) string {
+			return koSentenceSpacingRegex.ReplaceAllString(s, "$1 $2")
+		},
+		"localize_numbers": localizeNumbersRule("ko"),
+		"localize_dates":   localizeDatesRule("ko"),
+	},
+	"ja": {
+		"fullwidth_punct": func(s string) string {
+			return jaHalfWidthPunctRegex.ReplaceAllStringFunc(s, func(m string) string {
+				sub := jaHalfWidthPunctRegex.FindStringSubmatch(m)
+				return sub[1] + jaFullWidthPunct[sub[2]]
+			})
+		},
+		"localize_numbers": localizeNumbersRule("ja"),
+		"localize_dates":   localizeDatesRule("ja"),
+	},
+	"en": {
+		"localize_numbers": localizeNumbersRule("en"),
+		"localize_dates":   localizeDatesRule("en"),
+	},
+}
+
+// 설정한 순서대로 대상 언어의 규칙 적용 (없는 규칙은 무시)
+func applyFormatRules(text, lang string, names []string) string {
+	for _, name := range names {
+		if rule, ok := formatRules[lang][name]; ok {
+			text = rule(text)
+		}
+	}
+	return text
+}
+
+// 알 수 없는 언어/규칙 이름 경고 (설정 오타 확인용)
+func warnUnknownFormatRules(rules map[string][]string) {
+	for lang, names := range rules {
+		for _, name := range names {
+			if _, ok := formatRules[lang][name]; !ok {
+				log.Printf("[경고] 알 수 없는 FORMAT_RULES 규칙 무시 (%s: %s, 사용 가능: %s)", lang, name, strings.Join(formatRuleNames(lang), ", "))
+			}
+		}
+	}
+}
+
+func formatRuleNames(lang string) []string {
+	var names []string
+	for name := range formatRules[lang] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
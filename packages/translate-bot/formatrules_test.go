@@ -0,0 +1,44 @@
+package main
+
+import "testing"
+
+func TestApplyFormatRules(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		lang  string
+		rules []string
+		want  string
+	}{
+		{"ko number unit spacing", "사과 3 개와 10 분 뒤에 50 %", "ko", []string{"number_unit_spacing"}, "사과 3개와 10분 뒤에 50%"},
+		{"ko keeps non-unit spacing", "2026 버전", "ko", []string{"number_unit_spacing"}, "2026 버전"},
+		{"ko sentence spacing", "네.알겠습니다!내일 봐요", "ko", []string{"sentence_spacing"}, "네. 알겠습니다! 내일 봐요"},
+		{"ko sentence spacing keeps decimals", "3.5배", "ko", []string{"sentence_spacing"}, "3.5배"},
+		{"ja full-width punct", "了解です. 明日行きます!大丈夫?", "ja", []string{"fullwidth_punct"}, "了解です。明日行きます！大丈夫？"},
+		{"ja keeps latin punct", "Ver.2 を使います", "ja", []string{"fullwidth_punct"}, "Ver.2 を使います"},
+		{"rule for other language ignored", "了解です.", "ja", []string{"sentence_spacing"}, "了解です."},
+		{"no rules", "사과 3 개", "ko", nil, "사과 3 개"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := applyFormatRules(tt.input, tt.lang, tt.rules); got != tt.want {
+				t.Errorf("applyFormatRules(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatRulesAfterRestoration(t *testing.T) {
+	app := &App{
+		cfg:       &Config{FormatRules: map[string][]string{"ja": {"fullwidth_punct"}}},
+		translate: fakeTranslate(""),
+	}
+	// URL은 규칙 적용 뒤에 복원되므로 URL 안의 "."은 바뀌지 않는다
+	got, err := app.translateTextWith(app.translate, "資料です. https://example.com/a.b?x=1", "ja")
+	if err != nil {
+		t.Fatalf("translateTextWith: %v", err)
+	}
+	if want := "資料です。https://example.com/a.b?x=1"; got != want {
+		t.Errorf("번역 결과 = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"log"
+
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+)
+
+// ─────────────────────────────────────
+// 전달된 메시지 번역
+// 다른 채널의 메시지를 공유(전달)하면 원문이 author_name/text를 가진 첨부로 들어온다.
+// 첨부 본문을 번역하고 원 작성자 표시를 유지한 블록으로 스레드에 게시한다.
+
+// 작성자 정보가 있는 첨부만 전달된 메시지로 본다
+func forwardedAttachments(ev *slackevents.MessageEvent) []slack.Attachment {
+	var out []slack.Attachment
+	for _, a := range ev.Attachments {
+		if a.AuthorName == "" || a.Text == "" {
+			continue
+		}
+		out = append(out, a)
+	}
+	return out
+}
+
+// 원 작성자 라벨 + 번역문 블록
+func forwardBlocks(a slack.Attachment, translated string) []slack.Block {
+	label := "↪️ *" + a.AuthorName + "*"
+	if a.AuthorSubname != "" {
+		label += " (" + a.AuthorSubname + ")"
+	}
+	return []slack.Block{
+		slack.NewContextBlock("", slack.NewTextBlockObject(slack.MarkdownType, label, false, false)),
+		slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, translated, false, false), nil, nil),
+	}
+}
+
+func (app *App) processForwards(ev *slackevents.MessageEvent, threadTS string) error {
+	for _, a := range forwardedAttachments(ev) {
+		lang := app.targetLang(ev.Channel, a.Text)
+		if lang == "" {
+			log.Printf("[스킵] 전달 메시지 번역 불필요 (channel=%s, author=%s)", ev.Channel, a.AuthorName)
+			continue
+		}
+
+		translated, err := app.translateTextWith(app.translatorFor(ev.Channel), a.Text, lang)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = app.slack.PostMessage(
+			ev.Channel,
+			slack.MsgOptionText(a.AuthorName+": "+translated, false),
+			slack.MsgOptionBlocks(forwardBlocks(a, translated)...),
+			slack.MsgOptionTS(threadTS),
+		)
+		if err != nil {
+			return err
+		}
+		log.Printf("[성공] 전달 메시지 번역 게시 (channel=%s, author=%s)", ev.Channel, a.AuthorName)
+	}
+	return nil
+}
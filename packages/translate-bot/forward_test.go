@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+// 메시지 공유(전달) 시 들어오는 이벤트 형태
+const forwardedMessageEvent = `{
+	"type": "message",
+	"channel": "C1",
+	"user": "U1",
+	"text": "",
+	"ts": "1700000000.000200",
+	"attachments": [{
+		"author_id": "U2",
+		"author_name": "田中",
+		"author_subname": "tanaka",
+		"text": "明日の会議は10時からです",
+		"is_share": true,
+		"is_msg_unfurl": true,
+		"from_url": "https://example.slack.com/archives/C2/p1700000000000100",
+		"ts": "1700000000.000100"
+	}]
+}`
+
+func TestProcessMessageTranslatesForward(t *testing.T) {
+	var ev slackevents.MessageEvent
+	if err := json.Unmarshal([]byte(forwardedMessageEvent), &ev); err != nil {
+		t.Fatalf("이벤트 파싱 실패: %v", err)
+	}
+
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{}, slack: client, translate: fakeTranslate("[ko]")}
+
+	if err := app.processMessage(&ev); err != nil {
+		t.Fatalf("processMessage: %v", err)
+	}
+
+	posts := fs.callsTo("chat.postMessage")
+	if len(posts) != 1 {
+		t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+	}
+	form := posts[0].Form
+	if got := form.Get("thread_ts"); got != "1700000000.000200" {
+		t.Errorf("thread_ts = %q", got)
+	}
+	blocks := form.Get("blocks")
+	if !strings.Contains(blocks, "田中") || !strings.Contains(blocks, "tanaka") {
+		t.Errorf("원 작성자 라벨 누락: %s", blocks)
+	}
+	if !strings.Contains(blocks, "[ko]明日の会議は10時からです") {
+		t.Errorf("번역문 누락: %s", blocks)
+	}
+}
+
+func TestForwardedAttachmentsSkipsPlainAttachments(t *testing.T) {
+	ev := &slackevents.MessageEvent{}
+	if err := json.Unmarshal([]byte(`{"attachments":[{"title":"링크","text":"설명"},{"author_name":"김","text":""}]}`), ev); err != nil {
+		t.Fatal(err)
+	}
+	if got := forwardedAttachments(ev); len(got) != 0 {
+		t.Errorf("forwardedAttachments = %v, want 없음", got)
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ─────────────────────────────────────
+// 하이라이트 키워드 보호 (HIGHLIGHT_KEYWORDS)
+// Slack 키워드 알림은 글자가 그대로 있어야 울리므로, 설정한 키워드는 번역하지 않고 원문 형태를 유지한다.
+// 통화/웃음 표현과 같은 방식으로 번역 전 자리표시자로 바꾸고 번역 후 되돌린다 (대소문자 무시).
+
+// 키워드 목록으로 매칭 정규식 생성 (긴 키워드 우선, 키워드가 없으면 nil)
+func compileKeywordPattern(keywords []string) *regexp.Regexp {
+	var quoted []string
+	for _, k := range keywords {
+		if k = strings.TrimSpace(k); k != "" {
+			quoted = append(quoted, regexp.QuoteMeta(k))
+		}
+	}
+	if len(quoted) == 0 {
+		return nil
+	}
+	sort.SliceStable(quoted, func(i, j int) bool { return len(quoted[i]) > len(quoted[j]) })
+	return regexp.MustCompile(`(?i)` + strings.Join(quoted, "|"))
+}
+
+func protectKeywords(text string, pattern *regexp.Regexp) (string, []string) {
+	if pattern == nil {
+		return text, nil
+	}
+	var replacements []string
+	result := pattern.ReplaceAllStringFunc(text, func(match string) string {
+		placeholder := fmt.Sprintf("__KW%d__", len(replacements))
+		replacements = append(replacements, match)
+		return placeholder
+	})
+	return result, replacements
+}
+
+func restoreKeywords(text string, replacements []string) string {
+	for i, replacement := range replacements {
+		placeholder := fmt.Sprintf("__KW%d__", i)
+		text = strings.ReplaceAll(text, placeholder, replacement)
+	}
+	return text
+}
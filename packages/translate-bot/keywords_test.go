@@ -0,0 +1,53 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// 키워드를 번역해 버리는 가짜 번역 함수 (자리표시자는 그대로 둔다)
+func manglingTranslate(chunks []string, targetLang string) ([]string, error) {
+	r := strings.NewReplacer("배포", "デプロイ", "SAZO", "サゾ", "Sazo", "サゾ", "장애", "障害")
+	out := make([]string, len(chunks))
+	for i, c := range chunks {
+		out[i] = r.Replace(c)
+	}
+	return out, nil
+}
+
+func TestHighlightKeywordsSurviveTranslation(t *testing.T) {
+	tests := []struct {
+		name     string
+		keywords []string
+		input    string
+		want     string
+	}{
+		{"no keywords", nil, "배포 끝났어요", "デプロイ 끝났어요"},
+		{"keyword kept", []string{"배포"}, "배포 끝났어요", "배포 끝났어요"},
+		{"case-insensitive keeps original form", []string{"sazo"}, "SAZO 배포", "SAZO デプロイ"},
+		{"multiple keywords", []string{"배포", "장애"}, "배포 중 장애 발생, 배포 중단", "배포 중 장애 발생, 배포 중단"},
+		{"longer keyword first", []string{"Sazo", "Sazo 배포"}, "Sazo 배포 완료", "Sazo 배포 완료"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &App{cfg: &Config{HighlightKeywords: tt.keywords}, translate: manglingTranslate}
+			got, err := app.translateText(tt.input, "ja")
+			if err != nil {
+				t.Fatalf("translateText: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("translateText(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompileKeywordPatternIgnoresBlank(t *testing.T) {
+	if re := compileKeywordPattern([]string{"", "  "}); re != nil {
+		t.Errorf("빈 키워드만 있으면 nil이어야 함: %v", re)
+	}
+	re := compileKeywordPattern([]string{"a.b"})
+	if re.MatchString("axb") {
+		t.Error("키워드의 정규식 특수문자가 이스케이프되지 않음")
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+// ─────────────────────────────────────
+// 국기 이모지 원문 언어 힌트 (LANG_HINT_FLAGS)
+// 한국어와 일본어가 섞여 판별이 애매한 메시지도, 작성자가 맨 앞에 국기(🇰🇷/🇯🇵)를 붙이면 그 언어를 원문으로 보고 번역한다.
+// Slack 클라이언트가 보내는 :kr:/:flag-kr: 형태와 붙여넣은 유니코드 국기를 모두 국가 코드로 읽고,
+// 국가 코드 → 언어 매핑(예: {"kr": "ko", "jp": "ja"})에 있는 국기만 힌트로 쓴다. 힌트로 쓴 국기는 번역문에서 뺀다.
+
+var flagShortcodeRegex = regexp.MustCompile(`^:(?:flag-)?([a-z]{2}):`)
+
+// 텍스트 맨 앞의 국기 이모지를 국가 코드(소문자 2자)로 읽고 나머지 텍스트를 반환 (국기가 없으면 "")
+func parseLeadingFlag(text string) (string, string) {
+	trimmed := strings.TrimLeft(text, " \t")
+
+	if m := flagShortcodeRegex.FindStringSubmatch(trimmed); m != nil {
+		return m[1], strings.TrimLeft(trimmed[len(m[0]):], " \t")
+	}
+
+	// 유니코드 국기: 지역 표시 문자(U+1F1E6~U+1F1FF) 두 개
+	runes := []rune(trimmed)
+	if len(runes) >= 2 && isRegionalIndicator(runes[0]) && isRegionalIndicator(runes[1]) {
+		code := string([]rune{'a' + runes[0] - 0x1F1E6, 'a' + runes[1] - 0x1F1E6})
+		return code, strings.TrimLeft(string(runes[2:]), " \t")
+	}
+	return "", text
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// 설정된 국기로 시작하면 힌트 언어와 국기를 뺀 텍스트를 반환 (힌트가 없으면 "", 원문 그대로)
+func (app *App) langHint(text string) (string, string) {
+	if len(app.cfg.LangHintFlags) == 0 {
+		return "", text
+	}
+	code, rest := parseLeadingFlag(text)
+	lang, ok := app.cfg.LangHintFlags[code]
+	if code == "" || !ok || strings.TrimSpace(rest) == "" {
+		return "", text
+	}
+	return lang, rest
+}
+
+// 메시지(리치 텍스트면 첫 일반 텍스트 세그먼트) 앞의 국기 힌트를 떼어내고 힌트 언어를 반환
+func (app *App) takeLangHint(ev *slackevents.MessageEvent, segs []richTextSegment) string {
+	var hint string
+	if segs != nil {
+		if segs[0].Kind == richTextPlain {
+			hint, segs[0].Text = app.langHint(segs[0].Text)
+		}
+		return hint
+	}
+	hint, ev.Text = app.langHint(ev.Text)
+	return hint
+}
+
+// 힌트 언어 기준 번역 대상 언어 (채널 언어 쌍이 있으면 쌍 안에서, 없으면 한↔일)
+func (app *App) targetLangFromHint(channelID, hint string) string {
+	return app.supportedTarget(hint, app.hintPairTarget(channelID, hint))
+}
+
+func (app *App) hintPairTarget(channelID, hint string) string {
+	if pair, ok := app.channelLangPair(channelID); ok {
+		switch hint {
+		case pair.First:
+			return pair.Second
+		case pair.Second:
+			return pair.First
+		default:
+			return ""
+		}
+	}
+	switch hint {
+	case "ko":
+		return "ja"
+	case "ja":
+		return "ko"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+func TestParseLeadingFlag(t *testing.T) {
+	tests := []struct {
+		text     string
+		wantCode string
+		wantRest string
+	}{
+		{":kr: 안녕하세요", "kr", "안녕하세요"},
+		{":flag-jp: こんにちは", "jp", "こんにちは"},
+		{"🇯🇵 東京駅 앞에서 만나요", "jp", "東京駅 앞에서 만나요"},
+		{"  🇰🇷안녕", "kr", "안녕"},
+		{":smile: 안녕하세요", "", ":smile: 안녕하세요"},
+		{"안녕하세요 :kr:", "", "안녕하세요 :kr:"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			code, rest := parseLeadingFlag(tt.text)
+			if code != tt.wantCode || (code != "" && rest != tt.wantRest) {
+				t.Errorf("parseLeadingFlag(%q) = (%q, %q), want (%q, %q)", tt.text, code, rest, tt.wantCode, tt.wantRest)
+			}
+		})
+	}
+}
+
+func TestProcessMessageLangHint(t *testing.T) {
+	hints := map[string]string{"kr": "ko", "jp": "ja"}
+	tests := []struct {
+		name     string
+		hints    map[string]string
+		text     string
+		wantPost bool
+		wantText string
+	}{
+		// 한국어/일본어가 섞여 기본 판별로는 건너뛰는 메시지
+		{"flag resolves mixed text", hints, ":jp: 東京駅で会いましょう 카페", true, "[ko] 東京駅で会いましょう 카페"},
+		{"unicode flag", hints, "🇰🇷 카페에서 会いましょう", true, "[ja] 카페에서 会いましょう"},
+		{"normal message", hints, "안녕하세요", true, "[ja] 안녕하세요"},
+		{"mixed without flag", hints, "東京駅で会いましょう 카페", false, ""},
+		{"unmapped flag", hints, ":us: 東京駅で会いましょう 카페", false, ""},
+		{"disabled", nil, ":jp: 東京駅で会いましょう 카페", false, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			translate := func(chunks []string, lang string) ([]string, error) {
+				return fakeTranslate("["+lang+"] ")(chunks, lang)
+			}
+			app := &App{cfg: &Config{LangHintFlags: tt.hints}, slack: client, translate: translate}
+
+			ev := &slackevents.MessageEvent{Channel: "C1", User: "U1", Text: tt.text, TimeStamp: "1.0"}
+			if err := app.processMessage(ev); err != nil {
+				t.Fatalf("processMessage: %v", err)
+			}
+
+			posts := fs.callsTo("chat.postMessage")
+			if !tt.wantPost {
+				if len(posts) != 0 {
+					t.Errorf("번역하지 않아야 함: %q", posts[0].Form.Get("text"))
+				}
+				return
+			}
+			if len(posts) != 1 {
+				t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+			}
+			if got := posts[0].Form.Get("text"); got != tt.wantText {
+				t.Errorf("번역문 = %q, want %q", got, tt.wantText)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"log"
+	"strings"
+)
+
+// ─────────────────────────────────────
+// 지원 언어 쌍 확인 (SUPPORTED_LANG_PAIRS)
+// 채널 이름 언어 쌍, 국기 힌트, 읽는 사람의 Slack 언어로 정한 방향을 번역 모델이 지원하지 않으면
+// API가 알아보기 어려운 오류를 돌려준다. 설정한 목록("ko-ja", "en-ko" 등)에 없는 방향은 API를 부르기 전에 건너뛴다.
+// "*-en"처럼 원문 자리에 "*"를 쓰면 원문 언어와 관계없이 허용하고, 원문 언어를 알 수 없으면 대상 언어가 목록에 있을 때 번역한다.
+// 목록이 비어 있으면 확인하지 않는다.
+
+// 원문 → 대상 방향을 지원하는지 (설정이 없으면 항상 true)
+func (app *App) langPairSupported(source, target string) bool {
+	if len(app.cfg.SupportedLangPairs) == 0 || target == "" {
+		return true
+	}
+	for _, pair := range app.cfg.SupportedLangPairs {
+		from, to, ok := strings.Cut(strings.ToLower(strings.TrimSpace(pair)), "-")
+		if !ok || to != target {
+			continue
+		}
+		if from == "*" || source == "" || from == source {
+			return true
+		}
+	}
+	return false
+}
+
+// 지원하지 않는 방향이면 로그를 남기고 "" (번역하지 않음)
+func (app *App) supportedTarget(source, target string) string {
+	if app.langPairSupported(source, target) {
+		return target
+	}
+	log.Printf("[스킵] 지원하지 않는 언어 쌍 (%s→%s)", source, target)
+	return ""
+}
+
+// 요청한 사람에게 보여줄 안내 문구 (lang 언어로)
+func (app *App) unsupportedPairNotice(source, target, lang string) string {
+	if source == "" {
+		source = app.botText(msgAutoDetect, lang)
+	}
+	return app.botText(msgUnsupportedPair, lang, source, target)
+}
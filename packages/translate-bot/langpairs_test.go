@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+func TestLangPairSupported(t *testing.T) {
+	app := &App{cfg: &Config{SupportedLangPairs: []string{"ko-ja", " JA-KO ", "*-en", "broken"}}}
+	tests := []struct {
+		source, target string
+		want           bool
+	}{
+		{"ko", "ja", true},
+		{"ja", "ko", true},
+		{"ko", "en", true},
+		{"ja", "en", true},
+		{"en", "ko", false},
+		{"en", "ja", false},
+		{"", "ko", true},  // 원문 언어를 모르면 대상 언어만 확인
+		{"", "zh", false}, // 대상 언어가 목록에 없음
+	}
+	for _, tt := range tests {
+		if got := app.langPairSupported(tt.source, tt.target); got != tt.want {
+			t.Errorf("langPairSupported(%q, %q) = %v, want %v", tt.source, tt.target, got, tt.want)
+		}
+	}
+
+	empty := &App{cfg: &Config{}}
+	if !empty.langPairSupported("en", "zh") {
+		t.Error("목록이 비어 있으면 모두 허용해야 함")
+	}
+}
+
+func TestUnsupportedPairSkipped(t *testing.T) {
+	tests := []struct {
+		name      string
+		pairs     []string
+		wantPosts int
+	}{
+		{"supported", []string{"ko-ja"}, 1},
+		{"unsupported", []string{"ja-ko"}, 0},
+		{"no list", nil, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			calls := 0
+			translate := func(chunks []string, lang string) ([]string, error) {
+				calls++
+				return fakeTranslate("[번역]")(chunks, lang)
+			}
+			app := &App{cfg: &Config{SupportedLangPairs: tt.pairs}, slack: client, translate: translate}
+
+			ev := &slackevents.MessageEvent{Channel: "C1", User: "U1", Text: "오늘 점심 뭐 먹을까요", TimeStamp: "1.0"}
+			if err := app.processMessage(ev); err != nil {
+				t.Fatalf("processMessage: %v", err)
+			}
+			if got := len(fs.callsTo("chat.postMessage")); got != tt.wantPosts {
+				t.Errorf("chat.postMessage 호출 수 = %d, want %d", got, tt.wantPosts)
+			}
+			if calls != tt.wantPosts {
+				t.Errorf("번역 API 호출 수 = %d, want %d", calls, tt.wantPosts)
+			}
+		})
+	}
+}
+
+func TestUnsupportedPairShortcutNotice(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	fs.responses["users.info"] = `{"ok":true,"user":{"id":"U2","locale":"ko-KR"}}`
+	app := &App{cfg: &Config{SupportedLangPairs: []string{"ko-ja"}}, slack: client, translate: fakeTranslate("[번역]")}
+
+	payload, _ := json.Marshal(map[string]interface{}{
+		"type":        "message_action",
+		"callback_id": messageShortcutCallbackID,
+		"user":        map[string]string{"id": "U2"},
+		"channel":     map[string]string{"id": "C1"},
+		"message":     map[string]string{"ts": "1.0", "text": "明日のリリースどうしますか"},
+	})
+	app.handleInteraction("payload=" + url.QueryEscape(string(payload)))
+
+	eph := fs.callsTo("chat.postEphemeral")
+	if len(eph) != 1 {
+		t.Fatalf("chat.postEphemeral 호출 수 = %d, want 1", len(eph))
+	}
+	if got, want := eph[0].Form.Get("text"), app.unsupportedPairNotice("ja", "ko", "ko"); got != want {
+		t.Errorf("text = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ─────────────────────────────────────
+// 링크 위주 메시지 건너뛰기 (SKIP_HIGH_LINK_RATIO, opt-in) + URL 보호
+// "이거요 https://..."처럼 URL을 붙여넣고 한두 마디만 덧붙인 메시지는 번역할 내용이 없다.
+// 링크가 차지하는 글자 비율이 기준을 넘으면 번역하지 않는다.
+// 번역할 때는 URL을 자리표시자로 바꿔 두어 번역 API가 경로나 쿼리를 고치지 못하게 한다.
+// Slack 링크(<url|라벨>)는 라벨까지 통째로 링크로 다룬다.
+
+var urlRegex = regexp.MustCompile(`<https?://[^<>\s]+>|https?://[^\s<>]+`)
+
+// 링크 글자 수 / 전체 글자 수 (공백 제외, 멘션 같은 다른 Slack 토큰은 세지 않음)
+func linkRatio(text string) float64 {
+	links := 0
+	rest := urlRegex.ReplaceAllStringFunc(text, func(m string) string {
+		links += countNonSpace(m)
+		return " "
+	})
+	rest = slackTokenRegex.ReplaceAllString(rest, " ")
+
+	total := links + countNonSpace(rest)
+	if total == 0 {
+		return 0
+	}
+	return float64(links) / float64(total)
+}
+
+// 링크 비중이 기준을 넘는지 (기준 미설정이면 false)
+func (app *App) isLinkHeavy(text string) bool {
+	threshold := app.cfg.SkipHighLinkRatio
+	return threshold > 0 && linkRatio(text) > threshold
+}
+
+func protectURLs(text string) (string, []string) {
+	var replacements []string
+	result := urlRegex.ReplaceAllStringFunc(text, func(match string) string {
+		placeholder := fmt.Sprintf("__URL%d__", len(replacements))
+		replacements = append(replacements, match)
+		return placeholder
+	})
+	return result, replacements
+}
+
+func restoreURLs(text string, replacements []string) string {
+	for i, replacement := range replacements {
+		placeholder := fmt.Sprintf("__URL%d__", i)
+		text = strings.ReplaceAll(text, placeholder, replacement)
+	}
+	return text
+}
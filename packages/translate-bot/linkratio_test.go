@@ -0,0 +1,79 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+func TestLinkRatio(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		heavy bool
+	}{
+		{"url only", "https://example.com/docs/2024/roadmap?tab=q3", true},
+		{"slack link with a word", "이거요 <https://example.com/docs/2024/roadmap|로드맵>", true},
+		{"url with sentence", "내일 회의 전에 로드맵 문서 한 번씩 읽어봐 주세요. 질문은 스레드에 남겨주시면 됩니다 https://example.com/roadmap", false},
+		{"normal message", "<@U123> 내일 오전 10시에 회의실에서 배포 일정 논의해요", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ratio := linkRatio(tt.text)
+			if got := ratio > 0.8; got != tt.heavy {
+				t.Errorf("linkRatio = %.2f, heavy = %t, want %t", ratio, got, tt.heavy)
+			}
+		})
+	}
+}
+
+func TestProcessMessageSkipsLinkHeavy(t *testing.T) {
+	tests := []struct {
+		name      string
+		threshold float64
+		text      string
+		wantPosts int
+	}{
+		{"url only skipped", 0.8, "https://example.com/docs/2024/roadmap?tab=q3 확인", 0},
+		{"url with sentence translated", 0.8, "내일 회의 전에 로드맵 문서 한 번씩 읽어봐 주세요. 질문은 스레드에 남겨주시면 됩니다 https://example.com/roadmap", 1},
+		{"normal translated", 0.8, "내일 회의 일정 공유드려요", 1},
+		{"check disabled", 0, "https://example.com/docs/2024/roadmap?tab=q3 확인", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: &Config{SkipHighLinkRatio: tt.threshold}, slack: client, translate: fakeTranslate("[ja]")}
+
+			ev := &slackevents.MessageEvent{Channel: "C1", User: "U1", Text: tt.text, TimeStamp: "1.0"}
+			if err := app.processMessage(ev); err != nil {
+				t.Fatalf("processMessage: %v", err)
+			}
+			if n := len(fs.callsTo("chat.postMessage")); n != tt.wantPosts {
+				t.Errorf("chat.postMessage 호출 수 = %d, want %d", n, tt.wantPosts)
+			}
+		})
+	}
+}
+
+func TestTranslateTextProtectsURLs(t *testing.T) {
+	var sent string
+	app := &App{cfg: &Config{}, translate: func(chunks []string, lang string) ([]string, error) {
+		sent = strings.Join(chunks, "\n")
+		return fakeTranslate("[ja]")(chunks, lang)
+	}}
+
+	text := "문서 링크예요 <https://example.com/aaaaaa/b?x=1|문서> 참고해주세요 https://example.com/ㅋㅋㅋㅋㅋ"
+	got, err := app.translateText(text, "ja")
+	if err != nil {
+		t.Fatalf("translateText: %v", err)
+	}
+	if strings.Contains(sent, "example.com") {
+		t.Errorf("URL이 번역 API로 전달됨: %q", sent)
+	}
+	for _, url := range []string{"<https://example.com/aaaaaa/b?x=1|문서>", "https://example.com/ㅋㅋㅋㅋㅋ"} {
+		if !strings.Contains(got, url) {
+			t.Errorf("URL %q 복원 안 됨: %q", url, got)
+		}
+	}
+}
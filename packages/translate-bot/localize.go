@@ -0,0 +1,199 @@
+package main
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ─────────────────────────────────────
+// 숫자/날짜 표기 현지화 (FORMAT_RULES의 localize_numbers, localize_dates)
+// 번역문에 남은 다른 지역식 표기를 대상 언어의 표기로 바꾼다.
+// 숫자: "1.234.567", "1.234,5", "1 234"(줄바꿈 없는 공백), "1'234" → 대상 언어의 자릿수/소수점 기호.
+// 날짜: "2026/10/16", "2026. 10. 16.", "2026年10月16日", "16/10/2026" → 대상 언어의 날짜 표기.
+// "1.000"(천인지 1인지), "05/06/2026"(5월 6일인지 6월 5일인지), IP 주소처럼 뜻이 갈리는 표기는 바꾸지 않는다.
+// ISO 날짜(2026-10-16)는 로그나 파일 이름에 자주 쓰이므로 그대로 둔다.
+
+type localeFormat struct {
+	thousands  string
+	decimal    string
+	dateLayout string // time.Format 레이아웃
+}
+
+var localeFormats = map[string]localeFormat{
+	"ko": {thousands: ",", decimal: ".", dateLayout: "2006년 1월 2일"},
+	"ja": {thousands: ",", decimal: ".", dateLayout: "2006年1月2日"},
+	"en": {thousands: ",", decimal: ".", dateLayout: "Jan 2, 2006"},
+}
+
+var (
+	// 자릿수 구분 기호가 "."/줄바꿈 없는 공백/"'"인 숫자 (소수점은 ",")
+	foreignNumberRegex = regexp.MustCompile(`\d{1,3}(?:[.\x{00A0}\x{202F}'’]\d{3})+(?:,\d+)?`)
+	// 연-월-일 숫자 표기 (2026/10/16, 2026.10.16, 2026. 10. 16.)
+	ymdDateRegex = regexp.MustCompile(`(\d{4})(/|\. ?)(\d{1,2})(/|\. ?)(\d{1,2})(\.?)`)
+	// 연월일 단위 표기 (2026年10月16日, 2026년 10월 16일)
+	unitDateRegex = regexp.MustCompile(`(\d{4})\s*[年년]\s*(\d{1,2})\s*[月월]\s*(\d{1,2})\s*[日일]`)
+	// 일/월/연 또는 월/일/연 (16/10/2026, 10.16.2026)
+	dmyDateRegex = regexp.MustCompile(`(\d{1,2})([/.])(\d{1,2})([/.])(\d{4})`)
+)
+
+// 매칭 앞뒤가 숫자나 숫자 표기 기호로 이어지지 않는 경우에만 바꿈 (긴 숫자열, 버전 번호, 경로 일부는 제외)
+func replaceStandalone(re *regexp.Regexp, text string, convert func(m []string) (string, bool)) string {
+	var b strings.Builder
+	prev := 0
+	for _, loc := range re.FindAllStringSubmatchIndex(text, -1) {
+		start, end := loc[0], loc[1]
+		if start < prev || !standaloneAt(text, start, end) {
+			continue
+		}
+		m := make([]string, len(loc)/2)
+		for i := range m {
+			if loc[2*i] >= 0 {
+				m[i] = text[loc[2*i]:loc[2*i+1]]
+			}
+		}
+		out, ok := convert(m)
+		if !ok {
+			continue
+		}
+		b.WriteString(text[prev:start])
+		b.WriteString(out)
+		prev = end
+	}
+	b.WriteString(text[prev:])
+	return b.String()
+}
+
+func standaloneAt(text string, start, end int) bool {
+	if start > 0 {
+		r, _ := utf8.DecodeLastRuneInString(text[:start])
+		if unicode.IsDigit(r) || unicode.IsLetter(r) && r < 0x80 || strings.ContainsRune(".,/-_'’", r) {
+			return false
+		}
+	}
+	if end < len(text) {
+		r, size := utf8.DecodeRuneInString(text[end:])
+		if unicode.IsDigit(r) || unicode.IsLetter(r) && r < 0x80 || r == '_' {
+			return false
+		}
+		// "1.234.567.8"처럼 구분 기호 뒤에 숫자가 더 이어지면 다른 표기
+		if strings.ContainsRune(".,/-'’", r) {
+			next, _ := utf8.DecodeRuneInString(text[end+size:])
+			if unicode.IsDigit(next) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// 정수부 자릿수 구분과 소수부를 대상 언어 기호로 다시 씀
+func formatLocalNumber(intDigits, fraction string, f localeFormat) string {
+	var b strings.Builder
+	for i, r := range intDigits {
+		if i > 0 && (len(intDigits)-i)%3 == 0 {
+			b.WriteString(f.thousands)
+		}
+		b.WriteRune(r)
+	}
+	if fraction != "" {
+		b.WriteString(f.decimal)
+		b.WriteString(fraction)
+	}
+	return b.String()
+}
+
+func localizeNumbers(text string, f localeFormat) string {
+	return replaceStandalone(foreignNumberRegex, text, func(m []string) (string, bool) {
+		number, fraction, _ := strings.Cut(m[0], ",")
+		groups := strings.FieldsFunc(number, func(r rune) bool { return !unicode.IsDigit(r) })
+		seps := map[rune]bool{}
+		for _, r := range number {
+			if !unicode.IsDigit(r) {
+				seps[r] = true
+			}
+		}
+		if len(seps) != 1 {
+			return "", false
+		}
+		if seps['.'] {
+			// "1.000"은 천일 수도 소수일 수도 있으므로 구분 기호가 두 번 이상이거나 쉼표 소수부가 있을 때만
+			if len(groups) < 3 && fraction == "" {
+				return "", false
+			}
+			// IPv4 주소 모양은 건드리지 않음
+			if len(groups) == 4 && fraction == "" && isIPv4Shape(groups) {
+				return "", false
+			}
+		}
+		// "1.234,567"은 소수인지 나열인지 알 수 없음
+		if len(fraction) == 3 {
+			return "", false
+		}
+		return formatLocalNumber(strings.Join(groups, ""), fraction, f), true
+	})
+}
+
+func isIPv4Shape(groups []string) bool {
+	for _, g := range groups {
+		if n, err := strconv.Atoi(g); err != nil || n > 255 {
+			return false
+		}
+	}
+	return true
+}
+
+// 실제로 있는 날짜면 대상 언어 표기로
+func formatLocalDate(year, month, day string, f localeFormat) (string, bool) {
+	y, _ := strconv.Atoi(year)
+	m, _ := strconv.Atoi(month)
+	d, _ := strconv.Atoi(day)
+	t := time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC)
+	if t.Year() != y || int(t.Month()) != m || t.Day() != d {
+		return "", false
+	}
+	return t.Format(f.dateLayout), true
+}
+
+func localizeDates(text string, f localeFormat) string {
+	text = replaceStandalone(unitDateRegex, text, func(m []string) (string, bool) {
+		return formatLocalDate(m[1], m[2], m[3], f)
+	})
+	text = replaceStandalone(ymdDateRegex, text, func(m []string) (string, bool) {
+		if m[2] != m[4] {
+			return "", false
+		}
+		out, ok := formatLocalDate(m[1], m[3], m[5], f)
+		// "2026. 10. 16."의 마지막 점은 날짜의 일부, "2026.10.16."의 마지막 점은 문장 끝으로 남긴다
+		if ok && m[6] != "" && m[2] != ". " {
+			out += m[6]
+		}
+		return out, ok
+	})
+	return replaceStandalone(dmyDateRegex, text, func(m []string) (string, bool) {
+		if m[2] != m[4] {
+			return "", false
+		}
+		a, _ := strconv.Atoi(m[1])
+		b, _ := strconv.Atoi(m[3])
+		switch {
+		case a > 12 && b <= 12:
+			return formatLocalDate(m[5], m[3], m[1], f)
+		case b > 12 && a <= 12:
+			return formatLocalDate(m[5], m[1], m[3], f)
+		}
+		// 둘 다 12 이하면 일/월 순서를 알 수 없음
+		return "", false
+	})
+}
+
+func localizeNumbersRule(lang string) func(string) string {
+	return func(s string) string { return localizeNumbers(s, localeFormats[lang]) }
+}
+
+func localizeDatesRule(lang string) func(string) string {
+	return func(s string) string { return localizeDates(s, localeFormats[lang]) }
+}
@@ -0,0 +1,60 @@
+package main
+
+import "testing"
+
+func TestLocalizeNumbers(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		lang  string
+		want  string
+	}{
+		{"dot thousands", "売上は1.234.567円です", "ja", "売上は1,234,567円です"},
+		{"dot thousands comma decimal", "총 1.234,5 유로", "ko", "총 1,234.5 유로"},
+		{"single group with decimal", "costs 1.234,56 EUR", "en", "costs 1,234.56 EUR"},
+		{"nbsp thousands", "about 12 345 users", "en", "about 12,345 users"},
+		{"apostrophe thousands", "CHF 1'250'000", "en", "CHF 1,250,000"},
+		{"already local", "1,234,567.89 units", "en", "1,234,567.89 units"},
+		{"ambiguous single dot group", "1.000 items", "en", "1.000 items"},
+		{"ambiguous three digit fraction", "1.234,567", "en", "1.234,567"},
+		{"ip address", "server 192.168.100.200", "en", "server 192.168.100.200"},
+		{"version number", "v1.234.567", "en", "v1.234.567"},
+		{"longer dotted sequence", "1.234.567.8", "en", "1.234.567.8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := applyFormatRules(tt.input, tt.lang, []string{"localize_numbers"}); got != tt.want {
+				t.Errorf("localize_numbers(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalizeDates(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		lang  string
+		want  string
+	}{
+		{"ja slash to ko", "회의는 2026/10/16에 합니다", "ko", "회의는 2026년 10월 16일에 합니다"},
+		{"ko dotted to ja", "締切は2026. 3. 5.です", "ja", "締切は2026年3月5日です"},
+		{"dotted keeps sentence period", "Due 2026.10.16.", "en", "Due Oct 16, 2026."},
+		{"ja units to en", "Release on 2026年10月16日", "en", "Release on Oct 16, 2026"},
+		{"ko units to ja", "2026년 1월 2일に", "ja", "2026年1月2日に"},
+		{"day first", "마감 16/10/2026", "ko", "마감 2026년 10월 16일"},
+		{"month first", "due 10/16/2026", "en", "due Oct 16, 2026"},
+		{"ambiguous day month", "due 05/06/2026", "en", "due 05/06/2026"},
+		{"invalid date", "2026/02/30", "ko", "2026/02/30"},
+		{"iso kept", "2026-10-16 배포", "ko", "2026-10-16 배포"},
+		{"path kept", "/logs/2026/10/16/app.log", "en", "/logs/2026/10/16/app.log"},
+		{"mixed separators", "2026/10.16", "en", "2026/10.16"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := applyFormatRules(tt.input, tt.lang, []string{"localize_dates"}); got != tt.want {
+				t.Errorf("localize_dates(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -3,23 +3,29 @@ package main
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 	"unicode/utf8"
 
 	"github.com/aws/aws-lambda-go/events"
 	"github.com/aws/aws-lambda-go/lambda"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/slack-go/slack"
 	"github.com/slack-go/slack/slackevents"
+	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 )
 
@@ -39,6 +45,12 @@ var (
 
 const noTranslateEmoji = "no_translate"
 
+// 번역 모델 (기본 / 재번역용 기본값)
+const (
+	defaultTranslateModel  = "general/translation-llm"
+	fallbackTranslateModel = "general/nmt"
+)
+
 // 통화 단위 매핑 (한→일)
 var wonToJapanese = map[string]string{
 	"만원": "万ウォン",
@@ -64,7 +76,77 @@ type Config struct {
 	SlackSigningSecret string          `json:"SLACK_SIGNING_SECRET"`
 	GoogleCloudProject string          `json:"GOOGLE_CLOUD_PROJECT_ID"`
 	GoogleTranslateLoc string          `json:"GOOGLE_TRANSLATE_API_LOCATION"`
-	GoogleCreds        json.RawMessage `json:"GOOGLE_CREDS"` // GCP 서비스 계정 JSON (중첩 객체)
+	GoogleCreds        json.RawMessage `json:"GOOGLE_CREDS"`    // GCP 서비스 계정 JSON (중첩 객체)
+	EdgeEmojiMode      string          `json:"EDGE_EMOJI_MODE"` // 앞뒤 이모지 처리 (preserve|inline, 기본: preserve)
+	// 링크 미리보기 제목 번역 (links:read, links:write 스코프 필요)
+	TranslateLinkUnfurls bool `json:"TRANSLATE_LINK_UNFURLS"`
+	// 번역 후처리 (중복 공백, 문장부호 간격 정리)
+	PostProcess bool `json:"POST_PROCESS"`
+	// 대상 언어별 표기 규칙 (예: {"ko": ["number_unit_spacing"], "ja": ["fullwidth_punct"]})
+	FormatRules map[string][]string `json:"FORMAT_RULES"`
+	// 🔁 재번역에 사용할 모델 (기본: general/nmt)
+	RetranslateModel string `json:"RETRANSLATE_MODEL"`
+	// 구분선/서명 아래를 번역하지 않고 원문 유지 (패턴 미지정 시 ---, -- , ___ 줄)
+	TrimSignatures    bool     `json:"TRIM_SIGNATURES"`
+	SignaturePatterns []string `json:"SIGNATURE_PATTERNS"`
+	// 여러 메시지를 한 번에 처리할 때 동시에 번역할 최대 메시지 수 (기본: 4, 같은 채널은 항상 순차)
+	TranslateConcurrency int `json:"TRANSLATE_CONCURRENCY"`
+	// 채널 이름으로 언어 쌍 추론 (캡처 그룹 2개, 예: ^([a-z]{2})-([a-z]{2})(?:-|$))
+	ChannelLangPattern string `json:"CHANNEL_LANG_PATTERN"`
+	// 원문 언어 감지 신뢰도(0~1)가 이 값보다 낮으면 경고 문구를 붙이거나(note) 게시하지 않음(skip). 0이면 검사 안 함
+	ConfidenceThreshold float64 `json:"CONFIDENCE_THRESHOLD"`
+	LowConfidenceAction string  `json:"LOW_CONFIDENCE_ACTION"`
+	// 번역하지 않고 원문 그대로 둘 키워드 (Slack 키워드 알림용, 대소문자 무시)
+	HighlightKeywords []string `json:"HIGHLIGHT_KEYWORDS"`
+	// 코드/로그로 보이는 글자 비율(0~1)이 이 값을 넘으면 번역하지 않음 (예: 0.6, 0이면 검사 안 함)
+	SkipHighCodeRatio float64 `json:"SKIP_HIGH_CODE_RATIO"`
+	// 링크가 차지하는 글자 비율(0~1)이 이 값을 넘으면 번역하지 않음 (예: 0.9, 0이면 검사 안 함)
+	SkipHighLinkRatio float64 `json:"SKIP_HIGH_LINK_RATIO"`
+	// 번역 게시 실패 시 재시도 횟수 (기본: 2, 5xx/rate limit/네트워크 오류만 재시도)
+	PostRetries int `json:"POST_RETRIES"`
+	// 채널별 최근 메시지 N개의 언어를 세어, 주 언어로 쓴 메시지는 번역하지 않음 (예: 20, 0이면 사용 안 함)
+	ChannelNormWindow int `json:"CHANNEL_NORM_WINDOW"`
+	// 수정된 메시지를 본문이 실제로 바뀐 경우에만 스레드에 다시 번역
+	TranslateEdits bool `json:"TRANSLATE_EDITS"`
+	// 번역이 실패하면 스레드에 "⚠️ 자동 번역 실패 — 원문" 경고와 함께 원문을 게시
+	PostOriginalOnFailure bool `json:"POST_ORIGINAL_ON_FAILURE"`
+	// 무시하지 않고 번역할 봇의 bot_id (CI/모니터링 알림 등)
+	TranslateBotAllowlist []string `json:"TRANSLATE_BOT_ALLOWLIST"`
+	// 수신 웹훅 첨부의 필드 제목/값을 번역해 스레드에 번역 첨부로 게시
+	TranslateAttachmentFields bool `json:"TRANSLATE_ATTACHMENT_FIELDS"`
+	// 국가 코드 → 원문 언어 (예: {"kr": "ko", "jp": "ja"}). 메시지 맨 앞 국기 이모지를 원문 언어 힌트로 사용
+	LangHintFlags map[string]string `json:"LANG_HINT_FLAGS"`
+	// 채널 ID → 번역 모델 (예: {"C0123": "general/nmt"}), 없는 채널은 기본 모델
+	ChannelModelOverrides map[string]string `json:"CHANNEL_MODEL_OVERRIDES"`
+	// 검수된 번역 메모리 CSV 내용 (source,target,lang)과 메모리를 내보낼 수 있는 관리자 사용자 ID
+	TranslationMemory       string   `json:"TRANSLATION_MEMORY"`
+	TranslationMemoryAdmins []string `json:"TRANSLATION_MEMORY_ADMINS"`
+	// 이 줄 수(예: 30)보다 긴 메시지는 앞 N줄만 번역하고 "...(이하 생략)" 표시 (0이면 전체 번역)
+	TranslateFirstLines int `json:"TRANSLATE_FIRST_LINES"`
+	// 개인정보로 보이는 값(주민등록번호, 카드 번호 등)이 있으면 가려서 번역(mask)하거나 번역하지 않음(skip). 비어있으면 검사 안 함
+	PIIAction   string   `json:"PII_ACTION"`
+	PIIPatterns []string `json:"PII_PATTERNS"`
+	// 채널 주제/설명 변경 시스템 메시지의 새 내용을 번역해 스레드에 안내 (기본: 시스템 메시지는 번역 안 함)
+	TranslateSystemMessages bool `json:"TRANSLATE_SYSTEM_MESSAGES"`
+	// 번역 결과 한 메시지의 최대 글자 수 (기본·최대: Slack 한도 40000). 넘으면 여러 메시지로 나눠 게시
+	MaxPostChars int `json:"MAX_POST_CHARS"`
+	// 이 이모지(예: "globe_with_meridians") 반응을 단 사람에게만 번역을 보여줌. 이 글자 수(기본: 100) 이하의 짧은 문구는 원문과 나란히 표시
+	TranslateReactionEmoji string `json:"TRANSLATE_REACTION_EMOJI"`
+	QuickPhraseMaxChars    int    `json:"QUICK_PHRASE_MAX_CHARS"`
+	// 오른쪽에서 왼쪽으로 쓰는 글(아랍어 등)이 섞인 번역의 방향 보정, 세로쓰기 문장부호를 가로로
+	BidiIsolation bool `json:"BIDI_ISOLATION"`
+	// 번역 모델이 지원하는 "원문-대상" 언어 쌍 (예: ["ko-ja", "ja-ko", "*-en"]). 목록에 없는 방향은 번역하지 않음 (비어있으면 확인 안 함)
+	SupportedLangPairs []string `json:"SUPPORTED_LANG_PAIRS"`
+	// 받는 사람의 언어를 알 수 없을 때 봇 안내 문구에 쓸 언어 (ko|ja|en, 기본: ko)
+	BotLocale string `json:"BOT_LOCALE"`
+	// 같은 채널에서 이 시간(초) 안에 직전과 똑같은 번역이 나오면 게시하지 않음 (0이면 사용 안 함)
+	DedupWindowSeconds int `json:"DEDUP_WINDOW_SECONDS"`
+	// 메시지를 번역하지 않을 이중 언어 사용자 ID (USER_PREFS_TABLE이 있으면 본인이 `/translate-bilingual on`으로도 표시 가능)
+	BilingualUsers []string `json:"BILINGUAL_USERS"`
+	// 두 언어 이상으로 각각 이 횟수만큼 쓴 사람에게 `/translate-bilingual on`을 한 번 권함 (0이거나 USER_PREFS_TABLE이 없으면 사용 안 함)
+	BilingualNudgeAfter int `json:"BILINGUAL_NUDGE_AFTER"`
+	// 사용자가 명령으로 고른 설정(`/translate-lang` 읽기 언어, `/translate-bilingual` 표시)을 저장할 DynamoDB 테이블 (파티션 키 user_hash, 비어있으면 명령 사용 불가)
+	UserPrefsTable string `json:"USER_PREFS_TABLE"`
 }
 
 // AWS Secrets Manager에서 설정 로드
@@ -74,11 +156,43 @@ func LoadConfigFromSecrets(ctx context.Context) (*Config, error) {
 		// 로컬 개발용: 환경변수에서 직접 로드
 		log.Println("[디버그] SECRET_NAME 없음, 환경변수에서 직접 로드")
 		return &Config{
-			SlackBotToken:      os.Getenv("SLACK_BOT_TOKEN"),
-			SlackSigningSecret: os.Getenv("SLACK_SIGNING_SECRET"),
-			GoogleCloudProject: os.Getenv("GOOGLE_CLOUD_PROJECT_ID"),
-			GoogleTranslateLoc: os.Getenv("GOOGLE_TRANSLATE_API_LOCATION"),
-			GoogleCreds:        json.RawMessage(os.Getenv("GOOGLE_CREDS")),
+			SlackBotToken:             os.Getenv("SLACK_BOT_TOKEN"),
+			SlackSigningSecret:        os.Getenv("SLACK_SIGNING_SECRET"),
+			GoogleCloudProject:        os.Getenv("GOOGLE_CLOUD_PROJECT_ID"),
+			GoogleTranslateLoc:        os.Getenv("GOOGLE_TRANSLATE_API_LOCATION"),
+			GoogleCreds:               json.RawMessage(os.Getenv("GOOGLE_CREDS")),
+			EdgeEmojiMode:             os.Getenv("EDGE_EMOJI_MODE"),
+			TranslateLinkUnfurls:      os.Getenv("TRANSLATE_LINK_UNFURLS") == "true",
+			PostProcess:               os.Getenv("POST_PROCESS") == "true",
+			RetranslateModel:          os.Getenv("RETRANSLATE_MODEL"),
+			TrimSignatures:            os.Getenv("TRIM_SIGNATURES") == "true",
+			TranslateConcurrency:      envInt("TRANSLATE_CONCURRENCY"),
+			ChannelLangPattern:        os.Getenv("CHANNEL_LANG_PATTERN"),
+			ConfidenceThreshold:       envFloat("CONFIDENCE_THRESHOLD"),
+			LowConfidenceAction:       os.Getenv("LOW_CONFIDENCE_ACTION"),
+			HighlightKeywords:         envList("HIGHLIGHT_KEYWORDS"),
+			SkipHighCodeRatio:         envFloat("SKIP_HIGH_CODE_RATIO"),
+			SkipHighLinkRatio:         envFloat("SKIP_HIGH_LINK_RATIO"),
+			PostRetries:               envInt("POST_RETRIES"),
+			ChannelNormWindow:         envInt("CHANNEL_NORM_WINDOW"),
+			TranslateEdits:            os.Getenv("TRANSLATE_EDITS") == "true",
+			PostOriginalOnFailure:     os.Getenv("POST_ORIGINAL_ON_FAILURE") == "true",
+			TranslateBotAllowlist:     envList("TRANSLATE_BOT_ALLOWLIST"),
+			TranslateAttachmentFields: os.Getenv("TRANSLATE_ATTACHMENT_FIELDS") == "true",
+			TranslationMemory:         os.Getenv("TRANSLATION_MEMORY"),
+			TranslateFirstLines:       envInt("TRANSLATE_FIRST_LINES"),
+			PIIAction:                 os.Getenv("PII_ACTION"),
+			TranslateSystemMessages:   os.Getenv("TRANSLATE_SYSTEM_MESSAGES") == "true",
+			MaxPostChars:              envInt("MAX_POST_CHARS"),
+			TranslateReactionEmoji:    os.Getenv("TRANSLATE_REACTION_EMOJI"),
+			QuickPhraseMaxChars:       envInt("QUICK_PHRASE_MAX_CHARS"),
+			BidiIsolation:             os.Getenv("BIDI_ISOLATION") == "true",
+			SupportedLangPairs:        envList("SUPPORTED_LANG_PAIRS"),
+			BotLocale:                 os.Getenv("BOT_LOCALE"),
+			DedupWindowSeconds:        envInt("DEDUP_WINDOW_SECONDS"),
+			BilingualUsers:            envList("BILINGUAL_USERS"),
+			BilingualNudgeAfter:       envInt("BILINGUAL_NUDGE_AFTER"),
+			UserPrefsTable:            os.Getenv("USER_PREFS_TABLE"),
 		}, nil
 	}
 
@@ -117,16 +231,107 @@ func LoadConfigFromSecrets(ctx context.Context) (*Config, error) {
 	} else {
 		log.Println("[경고] GOOGLE_CREDS가 비어있음!")
 	}
+	log.Printf("[디버그] EDGE_EMOJI_MODE: %s", cfg.EdgeEmojiMode)
+	log.Printf("[디버그] TRANSLATE_LINK_UNFURLS: %t", cfg.TranslateLinkUnfurls)
+	log.Printf("[디버그] POST_PROCESS: %t", cfg.PostProcess)
+	log.Printf("[디버그] FORMAT_RULES: %v", cfg.FormatRules)
+	log.Printf("[디버그] RETRANSLATE_MODEL: %s", cfg.RetranslateModel)
+	log.Printf("[디버그] TRIM_SIGNATURES: %t (패턴 %d개)", cfg.TrimSignatures, len(cfg.SignaturePatterns))
+	log.Printf("[디버그] TRANSLATE_CONCURRENCY: %d", cfg.TranslateConcurrency)
+	log.Printf("[디버그] CHANNEL_LANG_PATTERN: %s", cfg.ChannelLangPattern)
+	log.Printf("[디버그] HIGHLIGHT_KEYWORDS: %d개", len(cfg.HighlightKeywords))
+	log.Printf("[디버그] SKIP_HIGH_CODE_RATIO: %.2f", cfg.SkipHighCodeRatio)
+	log.Printf("[디버그] SKIP_HIGH_LINK_RATIO: %.2f", cfg.SkipHighLinkRatio)
+	log.Printf("[디버그] POST_RETRIES: %d", cfg.PostRetries)
+	log.Printf("[디버그] CHANNEL_NORM_WINDOW: %d", cfg.ChannelNormWindow)
+	log.Printf("[디버그] TRANSLATE_EDITS: %t", cfg.TranslateEdits)
+	log.Printf("[디버그] POST_ORIGINAL_ON_FAILURE: %t", cfg.PostOriginalOnFailure)
+	log.Printf("[디버그] TRANSLATE_BOT_ALLOWLIST: %d개", len(cfg.TranslateBotAllowlist))
+	log.Printf("[디버그] TRANSLATE_ATTACHMENT_FIELDS: %t", cfg.TranslateAttachmentFields)
+	log.Printf("[디버그] LANG_HINT_FLAGS: %d개 국기", len(cfg.LangHintFlags))
+	log.Printf("[디버그] CHANNEL_MODEL_OVERRIDES: %d개 채널", len(cfg.ChannelModelOverrides))
+	log.Printf("[디버그] TRANSLATION_MEMORY: %t (관리자 %d명)", cfg.TranslationMemory != "", len(cfg.TranslationMemoryAdmins))
+	log.Printf("[디버그] TRANSLATE_FIRST_LINES: %d", cfg.TranslateFirstLines)
+	log.Printf("[디버그] PII_ACTION: %s (패턴 %d개)", cfg.PIIAction, len(cfg.PIIPatterns))
+	log.Printf("[디버그] TRANSLATE_SYSTEM_MESSAGES: %t", cfg.TranslateSystemMessages)
+	log.Printf("[디버그] MAX_POST_CHARS: %d", cfg.MaxPostChars)
+	log.Printf("[디버그] TRANSLATE_REACTION_EMOJI: %s", cfg.TranslateReactionEmoji)
+	log.Printf("[디버그] QUICK_PHRASE_MAX_CHARS: %d", cfg.QuickPhraseMaxChars)
+	log.Printf("[디버그] BIDI_ISOLATION: %t", cfg.BidiIsolation)
+	log.Printf("[디버그] SUPPORTED_LANG_PAIRS: %v", cfg.SupportedLangPairs)
+	log.Printf("[디버그] BOT_LOCALE: %s", cfg.BotLocale)
+	log.Printf("[디버그] DEDUP_WINDOW_SECONDS: %d", cfg.DedupWindowSeconds)
+	log.Printf("[디버그] BILINGUAL_USERS: %d명 (권유 기준 %d회)", len(cfg.BilingualUsers), cfg.BilingualNudgeAfter)
+	log.Printf("[디버그] USER_PREFS_TABLE: %s", cfg.UserPrefsTable)
+	log.Printf("[디버그] CONFIDENCE_THRESHOLD: %.2f (%s)", cfg.ConfidenceThreshold, cfg.LowConfidenceAction)
 
 	return &cfg, nil
 }
 
+// 정수 환경변수 (없거나 잘못된 값이면 0)
+func envInt(key string) int {
+	n, _ := strconv.Atoi(os.Getenv(key))
+	return n
+}
+
+// 쉼표로 구분된 목록 환경변수 (빈 항목 제외)
+func envList(key string) []string {
+	var out []string
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// 실수 환경변수 (없거나 잘못된 값이면 0)
+func envFloat(key string) float64 {
+	f, _ := strconv.ParseFloat(os.Getenv(key), 64)
+	return f
+}
+
 // ─────────────────────────────────────
 // App 구조체
 type App struct {
 	cfg       *Config
 	slack     *slack.Client
 	botUserID string
+	// 번역 함수 (기본: translateChunks, 테스트에서 교체)
+	translate func(chunks []string, targetLang string) ([]string, error)
+	// 모델을 지정한 번역 함수 (기본: translateChunksWithModel, 채널별 모델에 사용, 테스트에서 교체)
+	translateModel func(chunks []string, targetLang, model string) ([]string, error)
+	// 🔁 재번역 함수 (기본: RETRANSLATE_MODEL로 번역, 테스트에서 교체)
+	retranslate func(chunks []string, targetLang string) ([]string, error)
+	// 원문 언어 감지 함수 (기본: detectLanguage, 테스트에서 교체)
+	detect func(text string) (lang string, confidence float64, err error)
+	// 채널별 언어 쌍 캐시 (CHANNEL_LANG_PATTERN)
+	channelLangs   map[string]channelLangEntry
+	channelLangsMu sync.Mutex
+	// 채널별 최근 메시지 언어 집계 (CHANNEL_NORM_WINDOW)
+	channelNorms   map[string]*channelLangWindow
+	channelNormsMu sync.Mutex
+	// 번역 메모리 (TRANSLATION_MEMORY 설정 시)
+	memory *translationMemory
+	// 메시지별 마지막 번역 원문 해시 (TRANSLATE_EDITS)
+	translated   translatedSources
+	translatedMu sync.Mutex
+	// 채널별 마지막 게시 번역 (DEDUP_WINDOW_SECONDS)
+	lastTranslations   map[string]lastTranslation
+	lastTranslationsMu sync.Mutex
+	// 이중 언어 사용자 권유용 언어 집계 (BILINGUAL_NUDGE_AFTER)
+	bilingual bilingualUsers
+	// 개인정보 패턴 (PII_ACTION 설정 시, 한 번만 컴파일)
+	pii     []*regexp.Regexp
+	piiOnce sync.Once
+	// 게시에 끝내 실패한 번역 기록 (기본: logDeadLetter, 테스트에서 교체)
+	deadLetter func(deadLetterRecord)
+	// 사용자 설정 저장소 (USER_PREFS_TABLE 설정 시, `/translate-lang`, `/translate-bilingual`)
+	prefs userPrefStore
+	// GCP 인증 정보 로드 함수 (기본: loadGoogleCredentials, 테스트에서 교체)와 웜 인보케이션 간 재사용할 토큰 소스
+	loadCredentials func(ctx context.Context) (*google.Credentials, error)
+	tokenSource     oauth2.TokenSource
+	tokenSourceMu   sync.Mutex
 }
 
 func NewApp(cfg *Config) (*App, error) {
@@ -141,7 +346,34 @@ func NewApp(cfg *Config) (*App, error) {
 	}
 	log.Printf("[디버그] 봇 유저 ID: %s", resp.UserID)
 
-	return &App{cfg: cfg, slack: client, botUserID: resp.UserID}, nil
+	app := &App{cfg: cfg, slack: client, botUserID: resp.UserID}
+	app.translate = app.translateChunks
+	app.translateModel = app.translateChunksWithModel
+	app.detect = app.detectLanguage
+	app.deadLetter = logDeadLetter
+	app.loadCredentials = app.loadGoogleCredentials
+	app.retranslate = func(chunks []string, targetLang string) ([]string, error) {
+		return app.translateChunksWithModel(chunks, targetLang, app.retranslateModel())
+	}
+	warnUnknownFormatRules(cfg.FormatRules)
+	app.piiPatterns()
+	if cfg.TranslationMemory != "" {
+		memory, err := parseTranslationMemory(strings.NewReader(cfg.TranslationMemory))
+		if err != nil {
+			return nil, err
+		}
+		app.memory = memory
+		log.Printf("[성공] 번역 메모리 로드 (항목 %d개)", memory.len())
+	}
+	if cfg.UserPrefsTable != "" {
+		awsCfg, err := config.LoadDefaultConfig(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("AWS 설정 로드 실패: %w", err)
+		}
+		app.prefs = dynamoPrefStore{client: dynamodb.NewFromConfig(awsCfg), table: cfg.UserPrefsTable}
+		log.Printf("[성공] 사용자 설정 저장소 사용 (table=%s)", cfg.UserPrefsTable)
+	}
+	return app, nil
 }
 
 // ─────────────────────────────────────
@@ -310,57 +542,93 @@ func determineLang(s string) string {
 
 // ─────────────────────────────────────
 // Google Translate API 호출
-func (app *App) translateChunks(chunks []string, targetLang string) ([]string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
-	defer cancel()
-
-	proj := app.cfg.GoogleCloudProject
-	loc := app.cfg.GoogleTranslateLoc
-	if loc == "" {
-		loc = "global"
-	}
-
+// GCP 인증 정보 로드 (서비스 계정 JSON, 없으면 ADC)
+func (app *App) loadGoogleCredentials(ctx context.Context) (*google.Credentials, error) {
 	// 서비스 계정 JSON으로 인증
-	log.Printf("[디버그] 번역 요청 시작 (target=%s, chunks=%d개)", targetLang, len(chunks))
 	log.Printf("[디버그] GoogleCreds 길이: %d바이트", len(app.cfg.GoogleCreds))
 
-	var creds *google.Credentials
-	var err error
 	if len(app.cfg.GoogleCreds) > 0 {
 		log.Println("[디버그] 서비스 계정 JSON으로 인증 시도")
-		creds, err = google.CredentialsFromJSON(ctx, app.cfg.GoogleCreds, "https://www.googleapis.com/auth/cloud-translation")
+		creds, err := google.CredentialsFromJSON(ctx, app.cfg.GoogleCreds, "https://www.googleapis.com/auth/cloud-translation")
 		if err != nil {
 			log.Printf("[에러] 서비스 계정 JSON 파싱 실패: %v", err)
 			return nil, fmt.Errorf("GCP 인증 실패: %w", err)
 		}
 		log.Println("[디버그] 서비스 계정 JSON 인증 성공")
-	} else {
-		// 로컬 개발용: 기본 인증 (gcloud auth application-default login)
-		log.Println("[디버그] 기본 인증(ADC) 시도 - GoogleCreds가 비어있음")
-		creds, err = google.FindDefaultCredentials(ctx, "https://www.googleapis.com/auth/cloud-translation")
+		return creds, nil
+	}
+	// 로컬 개발용: 기본 인증 (gcloud auth application-default login)
+	log.Println("[디버그] 기본 인증(ADC) 시도 - GoogleCreds가 비어있음")
+	return google.FindDefaultCredentials(ctx, "https://www.googleapis.com/auth/cloud-translation")
+}
+
+// GCP 토큰 소스 (처음 한 번만 인증 정보를 읽고 웜 인보케이션에서는 재사용)
+// 토큰 소스는 만료 전까지 토큰을 캐시하고 만료되면 알아서 다시 발급받는다.
+// 갱신 요청에 로드할 때의 컨텍스트가 쓰이므로, 요청별 타임아웃 컨텍스트 대신 Background로 로드한다.
+func (app *App) googleTokenSource() (oauth2.TokenSource, error) {
+	app.tokenSourceMu.Lock()
+	defer app.tokenSourceMu.Unlock()
+
+	if app.tokenSource != nil {
+		return app.tokenSource, nil
 	}
+	creds, err := app.loadCredentials(context.Background())
 	if err != nil {
+		// 실패는 캐시하지 않음 (다음 요청에서 다시 시도)
 		return nil, err
 	}
-	token, err := creds.TokenSource.Token()
+	app.tokenSource = creds.TokenSource
+	return app.tokenSource, nil
+}
+
+// GCP 액세스 토큰 발급
+func (app *App) googleAccessToken() (string, error) {
+	ts, err := app.googleTokenSource()
+	if err != nil {
+		return "", err
+	}
+	token, err := ts.Token()
 	if err != nil {
 		log.Printf("[에러] 토큰 획득 실패: %v", err)
-		return nil, err
+		return "", err
 	}
 	log.Println("[디버그] GCP 토큰 획득 성공")
+	return token.AccessToken, nil
+}
+
+func (app *App) translateChunks(chunks []string, targetLang string) ([]string, error) {
+	return app.translateChunksWithModel(chunks, targetLang, defaultTranslateModel)
+}
+
+// model: "general/translation-llm", "general/nmt" 등 Translation API 모델 경로
+func (app *App) translateChunksWithModel(chunks []string, targetLang, model string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	proj := app.cfg.GoogleCloudProject
+	loc := app.cfg.GoogleTranslateLoc
+	if loc == "" {
+		loc = "global"
+	}
+
+	log.Printf("[디버그] 번역 요청 시작 (target=%s, model=%s, chunks=%d개)", targetLang, model, len(chunks))
+	token, err := app.googleAccessToken()
+	if err != nil {
+		return nil, err
+	}
 
 	payload := map[string]interface{}{
 		"contents":           chunks,
 		"targetLanguageCode": targetLang,
 		"mimeType":           "text/plain",
-		"model":              fmt.Sprintf("projects/%s/locations/%s/models/general/translation-llm", proj, loc),
+		"model":              fmt.Sprintf("projects/%s/locations/%s/models/%s", proj, loc, model),
 	}
 	body, _ := json.Marshal(payload)
 
 	url := fmt.Sprintf("https://translation.googleapis.com/v3/projects/%s/locations/%s:translateText", proj, loc)
 	req, _ := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	req.Header.Set("Authorization", "Bearer "+token)
 
 	log.Printf("[디버그] 번역 API 호출: %s", url)
 	resp, err := http.DefaultClient.Do(req)
@@ -433,14 +701,128 @@ func (app *App) removeNoTranslateEmoji(channel, ts string) {
 	}
 }
 
+// ─────────────────────────────────────
+// 텍스트 번역 (전처리 → 번역 → 후처리)
+func (app *App) translateText(text, lang string) (string, error) {
+	return app.translateTextWith(app.translate, text, lang)
+}
+
+func (app *App) translateTextWith(translate func([]string, string) ([]string, error), text, lang string) (string, error) {
+	// 번역 메모리에 같은 원문이 있으면 그대로 사용
+	if app.memory != nil {
+		if target, ok := app.memory.lookup(text, lang); ok {
+			log.Printf("[정보] 번역 메모리 사용 (lang=%s)", lang)
+			return target, nil
+		}
+	}
+	source := text
+
+	// 아주 긴 메시지는 앞 N줄만 번역 (잘린 뒷부분에 있던 서명도 함께 빠짐)
+	omitted := ""
+	if cut, ok := takeFirstLines(text, app.cfg.TranslateFirstLines); ok {
+		text = cut
+		omitted = "\n" + omittedMarker(lang)
+	}
+
+	// 서명/구분선 이후 분리 (번역하지 않고 끝에 다시 붙임)
+	signature := ""
+	if app.cfg.TrimSignatures {
+		text, signature = splitSignature(text, compileSignaturePatterns(app.cfg.SignaturePatterns))
+	}
+
+	// 앞뒤 이모지 분리 (번역 후 같은 위치에 다시 붙임)
+	emojiPrefix, body, emojiSuffix := "", text, ""
+	if app.cfg.EdgeEmojiMode != EdgeEmojiInline {
+		emojiPrefix, body, emojiSuffix = splitEdgeEmoji(text)
+	}
+
+	// 메시지 분할 (긴 메시지 대응)
+	chunks := splitByNewlineChunk(body, 1600, 1800)
+
+	// 번역 전처리: 개인정보 + 반복 문자 정규화 + 날짜 토큰 + URL + 아스키 아트 + 하이라이트 키워드 + 통화 금액 + 웃음 표현 보호
+	keywordPattern := compileKeywordPattern(app.cfg.HighlightKeywords)
+	piiPatterns := app.piiPatterns()
+	piiRepls := make([][]string, len(chunks))
+	maxRepeats := make([]int, len(chunks))
+	dateRepls := make([][]string, len(chunks))
+	urlRepls := make([][]string, len(chunks))
+	artRepls := make([][]string, len(chunks))
+	keywordRepls := make([][]string, len(chunks))
+	currencyRepls := make([][]string, len(chunks))
+	laughterRepls := make([][]string, len(chunks))
+	for i, chunk := range chunks {
+		// 개인정보는 날짜 토큰 등에 일부가 먼저 잡히지 않도록 가장 먼저 가린다
+		chunks[i], piiRepls[i] = protectPII(chunk, piiPatterns)
+		chunks[i], dateRepls[i] = protectDateTokens(chunks[i])
+		chunks[i], urlRepls[i] = protectURLs(chunks[i])
+		chunks[i], artRepls[i] = protectArt(chunks[i])
+		chunks[i], maxRepeats[i] = normalizeRepetition(chunks[i])
+		chunks[i], keywordRepls[i] = protectKeywords(chunks[i], keywordPattern)
+		chunks[i], currencyRepls[i] = protectCurrency(chunks[i], lang)
+		chunks[i], laughterRepls[i] = protectLaughter(chunks[i], lang)
+	}
+
+	// 번역
+	translated, err := translate(chunks, lang)
+	if err != nil {
+		return "", err
+	}
+
+	// 번역 후처리: 보호된 표현 복원 + 잔여물 정리 + 반복 폭발 캡
+	for i := range translated {
+		translated[i] = restoreLaughter(translated[i], laughterRepls[i])
+		translated[i] = restoreCurrency(translated[i], currencyRepls[i])
+		translated[i] = restoreKeywords(translated[i], keywordRepls[i])
+		if app.cfg.PostProcess {
+			translated[i] = postProcessTranslation(translated[i], lang)
+		}
+		translated[i] = applyFormatRules(translated[i], lang, app.cfg.FormatRules[lang])
+		translated[i] = capRepetition(translated[i], maxRepeats[i])
+		// 날짜 토큰, URL, 아스키 아트는 숫자나 반복 문자가 정리/캡에 걸리지 않도록 마지막에 복원
+		translated[i] = restoreArt(translated[i], artRepls[i])
+		translated[i] = restoreURLs(translated[i], urlRepls[i])
+		translated[i] = restoreDateTokens(translated[i], dateRepls[i])
+		translated[i] = restorePII(translated[i], piiRepls[i])
+		if app.cfg.BidiIsolation {
+			translated[i] = isolateBidi(translated[i])
+		}
+	}
+
+	// 결과 합치기 (분리했던 앞뒤 이모지, 서명 복원, 생략 표시)
+	result := emojiPrefix + strings.Join(translated, "\n\n") + emojiSuffix + signature + omitted
+	// 개인정보를 가린 메시지는 원문과 번역문에 그 값이 남아 있으므로 메모리(내보내기 대상)에 쌓지 않음
+	if app.memory != nil && !hasPII(piiRepls) {
+		app.memory.store(source, lang, result)
+	}
+	return result, nil
+}
+
 // ─────────────────────────────────────
 // 메시지 이벤트 처리
 func (app *App) processMessage(ev *slackevents.MessageEvent) error {
-	// 봇 메시지 무시
+	// 수정된 메시지는 본문이 바뀐 경우에만 다시 번역
+	if ev.SubType == subtypeMessageChanged {
+		return app.processEdit(ev)
+	}
+
+	// 참여/주제 변경 등 시스템 메시지는 설정한 경우에만 내용 부분을 번역
+	if isSystemMessage(ev) {
+		return app.processSystemMessage(ev)
+	}
+
+	// 봇 메시지 무시 (허용한 봇은 블록 텍스트를 번역)
 	if ev.BotID != "" {
-		return nil
+		if !app.isAllowlistedBot(ev) {
+			return nil
+		}
+		if text := botBlocksText(ev.Blocks); text != "" {
+			ev.Text = text
+		}
 	}
 
+	// 수정 이벤트와 비교할 원문 (아래에서 !tt, 국기 힌트를 떼어내기 전)
+	rawText := ev.Text
+
 	// !tt 명령어: 번역 금지 토글 (이모지 추가/제거 + ephemeral 피드백)
 	if strings.Contains(ev.Text, "!tt") {
 		threadTS := ev.ThreadTimeStamp
@@ -449,7 +831,7 @@ func (app *App) processMessage(ev *slackevents.MessageEvent) error {
 		}
 		if app.hasNoTranslateEmoji(ev.Channel, threadTS) {
 			app.removeNoTranslateEmoji(ev.Channel, threadTS)
-			app.slack.PostEphemeral(ev.Channel, ev.User, slack.MsgOptionText("🔊 이 스레드의 번역을 재개했습니다", false), slack.MsgOptionTS(threadTS))
+			app.slack.PostEphemeral(ev.Channel, ev.User, slack.MsgOptionText(app.userBotText(ev.User, msgThreadResumed), false), slack.MsgOptionTS(threadTS))
 			log.Printf("[번역 재개] 이모지 제거 (channel=%s, thread=%s)", ev.Channel, threadTS)
 			ev.Text = strings.ReplaceAll(ev.Text, "!tt", "")
 			ev.Text = strings.TrimSpace(ev.Text)
@@ -459,7 +841,7 @@ func (app *App) processMessage(ev *slackevents.MessageEvent) error {
 			// !tt 제거 후 남은 텍스트를 번역 처리로 계속 진행
 		} else {
 			app.addNoTranslateEmoji(ev.Channel, threadTS)
-			app.slack.PostEphemeral(ev.Channel, ev.User, slack.MsgOptionText("🔇 이 스레드의 번역을 중지했습니다", false), slack.MsgOptionTS(threadTS))
+			app.slack.PostEphemeral(ev.Channel, ev.User, slack.MsgOptionText(app.userBotText(ev.User, msgThreadMuted), false), slack.MsgOptionTS(threadTS))
 			log.Printf("[번역 금지] 이모지 추가 (channel=%s, thread=%s)", ev.Channel, threadTS)
 			return nil
 		}
@@ -471,55 +853,131 @@ func (app *App) processMessage(ev *slackevents.MessageEvent) error {
 		return nil
 	}
 
-	// 언어 판별
-	lang := determineLang(ev.Text)
+	// 스레드 타임스탬프 결정
+	threadTS := ev.ThreadTimeStamp
+	if threadTS == "" {
+		threadTS = ev.TimeStamp
+	}
+
+	// 전달된 메시지(첨부) 번역
+	if err := app.processForwards(ev, threadTS); err != nil {
+		log.Printf("[에러] 전달 메시지 번역 실패: %v", err)
+	}
+
+	// 웹훅 메시지 첨부 필드 번역
+	if err := app.processAttachmentFields(ev, threadTS); err != nil {
+		log.Printf("[에러] 첨부 필드 번역 실패: %v", err)
+	}
+
+	// 리치 텍스트 인용/코드 블록 구분 (코드 블록은 언어 판별과 번역에서 제외)
+	segs := richTextSegments(ev.Blocks)
+
+	// 맨 앞 국기 이모지로 지정한 원문 언어 (국기는 번역문에서 뺀다)
+	hint := app.takeLangHint(ev, segs)
+
+	source := ev.Text
+	if segs != nil {
+		source = richTextTranslatable(segs)
+	}
+
+	// 채널 주 언어 집계 (번역 대상이 아닌 메시지도 센다)
+	sourceLang := detectSourceLang(source)
+	if hint != "" {
+		sourceLang = hint
+	}
+	norm := app.observeChannelLang(ev.Channel, sourceLang)
+
+	// 이중 언어 사용자의 메시지 건너뛰기 (표시하지 않았으면 여러 언어로 자주 쓰는지 보고 한 번 권함)
+	if app.isBilingual(ev.User) {
+		log.Printf("[스킵] 이중 언어 사용자 (channel=%s, ts=%s)", ev.Channel, ev.TimeStamp)
+		return nil
+	}
+	app.nudgeBilingual(ev.Channel, ev.User, sourceLang, ev.ThreadTimeStamp)
+
+	// 언어 판별 (채널 이름 언어 쌍 우선)
+	lang := app.targetLang(ev.Channel, source)
+	if hint != "" {
+		lang = app.targetLangFromHint(ev.Channel, hint)
+	}
 	if lang == "" {
 		log.Printf("[스킵] 번역 불필요 (channel=%s, ts=%s)", ev.Channel, ev.TimeStamp)
 		return nil
 	}
 
-	// 메시지 분할 (긴 메시지 대응)
-	chunks := splitByNewlineChunk(ev.Text, 1600, 1800)
+	// 채널 주 언어로 쓴 메시지 건너뛰기
+	if norm != "" && sourceLang == norm {
+		log.Printf("[스킵] 채널 주 언어와 같음 (channel=%s, ts=%s, lang=%s)", ev.Channel, ev.TimeStamp, norm)
+		return nil
+	}
 
-	// 번역 전처리: 반복 문자 정규화 + 통화 금액 + 웃음 표현 보호
-	maxRepeats := make([]int, len(chunks))
-	currencyRepls := make([][]string, len(chunks))
-	laughterRepls := make([][]string, len(chunks))
-	for i, chunk := range chunks {
-		chunks[i], maxRepeats[i] = normalizeRepetition(chunk)
-		chunks[i], currencyRepls[i] = protectCurrency(chunks[i], lang)
-		chunks[i], laughterRepls[i] = protectLaughter(chunks[i], lang)
+	// 로그/스택 트레이스 위주 메시지 건너뛰기
+	if app.isCodeHeavy(source) {
+		log.Printf("[스킵] 코드 비중 높음 (channel=%s, ts=%s)", ev.Channel, ev.TimeStamp)
+		return nil
 	}
 
-	// 번역
-	translated, err := app.translateChunks(chunks, lang)
-	if err != nil {
-		return err
+	// URL만 붙여넣은 메시지 건너뛰기
+	if app.isLinkHeavy(source) {
+		log.Printf("[스킵] 링크 비중 높음 (channel=%s, ts=%s)", ev.Channel, ev.TimeStamp)
+		return nil
 	}
 
-	// 번역 후처리: 보호된 표현 복원 + 반복 폭발 캡
-	for i := range translated {
-		translated[i] = restoreLaughter(translated[i], laughterRepls[i])
-		translated[i] = restoreCurrency(translated[i], currencyRepls[i])
-		translated[i] = capRepetition(translated[i], maxRepeats[i])
+	// 개인정보로 보이는 값이 있는 메시지 건너뛰기 (PII_ACTION=skip)
+	if app.skipForPII(source) {
+		log.Printf("[스킵] 개인정보 패턴 포함 (channel=%s, ts=%s)", ev.Channel, ev.TimeStamp)
+		return nil
 	}
 
-	// 결과 합치기
-	text := strings.Join(translated, "\n\n")
+	// 원문 언어 감지 신뢰도 검사
+	confidence, low := app.lowConfidence(source)
+	if hint != "" {
+		// 작성자가 직접 지정한 언어는 감지 신뢰도와 관계없이 믿는다
+		low = false
+	}
+	if low && app.cfg.LowConfidenceAction == LowConfidenceSkip {
+		log.Printf("[스킵] 언어 감지 신뢰도 낮음 (channel=%s, ts=%s, confidence=%.2f)", ev.Channel, ev.TimeStamp, confidence)
+		return nil
+	}
 
-	// 스레드 타임스탬프 결정
-	threadTS := ev.ThreadTimeStamp
-	if threadTS == "" {
-		threadTS = ev.TimeStamp
+	// 채널별 모델 설정이 있으면 해당 모델로 번역
+	translate := app.translatorFor(ev.Channel)
+	var text string
+	var err error
+	if segs != nil {
+		text, err = app.translateRichTextWith(translate, segs, lang)
+	} else {
+		text, err = app.translateTextWith(translate, ev.Text, lang)
+	}
+	if err != nil {
+		app.postOriginalOnFailure(ev.Channel, threadTS, ev.TimeStamp, lang, ev.Text)
+		return err
+	}
+	if low {
+		text += "\n\n" + app.botText(msgLowConfidence, lang, confidence*100)
+	}
+
+	// 같은 채널에 직전과 똑같은 번역이 바로 이어지면 건너뛰기
+	if app.isDuplicateTranslation(ev.Channel, text) {
+		log.Printf("[스킵] 직전 번역과 같음 (channel=%s, ts=%s)", ev.Channel, ev.TimeStamp)
+		return nil
 	}
 
-	// 슬랙에 전송
-	_, _, err = app.slack.PostMessage(
-		ev.Channel,
-		slack.MsgOptionText(text, false),
-		slack.MsgOptionTS(threadTS),
-	)
-	return err
+	// 슬랙에 전송 (🔁 재번역 시 원문을 찾을 수 있도록 메타데이터에 원문 위치 기록, 일시적 실패는 재시도)
+	// 메시지 길이 한도를 넘으면 여러 메시지로 나눠 순서대로 게시
+	for _, part := range splitForPost(text, app.maxPostChars()) {
+		err = app.postWithRetry(
+			deadLetterRecord{Channel: ev.Channel, ThreadTS: threadTS, SourceTS: ev.TimeStamp, Lang: lang, Text: part},
+			slack.MsgOptionText(part, false),
+			slack.MsgOptionTS(threadTS),
+			slack.MsgOptionMetadata(translationMetadata(ev.TimeStamp, lang)),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	app.rememberPostedTranslation(ev.Channel, text)
+	app.rememberTranslated(ev.Channel, ev.TimeStamp, rawText)
+	return nil
 }
 
 // ─────────────────────────────────────
@@ -549,7 +1007,16 @@ func (app *App) handler(ctx context.Context, event events.LambdaFunctionURLReque
 		return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
 	}
 
+	// Body 처리 (interactivity 요청은 Base64 인코딩되어 올 수 있음)
 	body := []byte(event.Body)
+	if event.IsBase64Encoded {
+		decoded, err := base64.StdEncoding.DecodeString(event.Body)
+		if err != nil {
+			log.Printf("[에러] Base64 디코딩 실패: %v", err)
+			return events.LambdaFunctionURLResponse{StatusCode: 400}, nil
+		}
+		body = decoded
+	}
 
 	// 서명 검증
 	if err := verifySlackSignature(event.Headers, body, app.cfg.SlackSigningSecret); err != nil {
@@ -557,6 +1024,16 @@ func (app *App) handler(ctx context.Context, event events.LambdaFunctionURLReque
 		return events.LambdaFunctionURLResponse{StatusCode: 401}, nil
 	}
 
+	// 메시지 단축키 (스레드 번역)
+	if strings.HasPrefix(string(body), "payload=") {
+		return app.handleInteraction(string(body))
+	}
+
+	// 슬래시 커맨드 (읽기 언어 설정)
+	if values, err := url.ParseQuery(string(body)); err == nil && values.Get("command") != "" {
+		return app.handleCommand(values)
+	}
+
 	// 이벤트 파싱
 	evt, err := slackevents.ParseEvent(json.RawMessage(body), slackevents.OptionNoVerifyToken())
 	if err != nil {
@@ -577,9 +1054,20 @@ func (app *App) handler(ctx context.Context, event events.LambdaFunctionURLReque
 
 	// 콜백 이벤트 처리
 	if evt.Type == slackevents.CallbackEvent {
-		if ev, ok := evt.InnerEvent.Data.(*slackevents.MessageEvent); ok {
-			if err := app.processMessage(ev); err != nil {
-				log.Printf("[에러] 메시지 처리 실패: %v", err)
+		switch ev := evt.InnerEvent.Data.(type) {
+		case *slackevents.MessageEvent:
+			for _, err := range app.processMessageBatch([]*slackevents.MessageEvent{ev}) {
+				if err != nil {
+					log.Printf("[에러] 메시지 처리 실패: %v", err)
+				}
+			}
+		case *slackevents.ReactionAddedEvent:
+			if err := app.processReaction(ev); err != nil {
+				log.Printf("[에러] 반응 처리 실패: %v", err)
+			}
+		case *slackevents.LinkSharedEvent:
+			if err := app.processLinkShared(ev); err != nil {
+				log.Printf("[에러] 링크 미리보기 처리 실패: %v", err)
 			}
 		}
 	}
@@ -588,9 +1076,10 @@ func (app *App) handler(ctx context.Context, event events.LambdaFunctionURLReque
 }
 
 // 전역 앱 인스턴스 (Lambda cold start 최적화)
+// 초기화는 main에서 수행 (테스트 바이너리에서 시크릿 로드가 실행되지 않도록)
 var app *App
 
-func init() {
+func main() {
 	ctx := context.Background()
 	cfg, err := LoadConfigFromSecrets(ctx)
 	if err != nil {
@@ -600,8 +1089,5 @@ func init() {
 	if err != nil {
 		log.Fatalf("[치명적] 앱 초기화 실패: %v", err)
 	}
-}
-
-func main() {
 	lambda.Start(app.handler)
 }
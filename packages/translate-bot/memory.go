@@ -0,0 +1,158 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 번역 메모리 (TRANSLATION_MEMORY)
+// 팀이 검수한 번역을 CSV(source,target,lang)로 넣어두면 원문이 정확히 같을 때 API 대신 그 번역을 쓴다.
+// 실행 중 번역한 결과도 같은 메모리에 쌓이며(검수된 항목은 덮어쓰지 않음),
+// 관리자는 글로벌 단축키 "export_translation_memory"로 현재 메모리를 CSV로 받아 다시 검수할 수 있다.
+
+const (
+	exportMemoryCallbackID = "export_translation_memory"
+	maxMemoryEntries       = 5000 // 실행 중 쌓이는 항목 상한 (Lambda 메모리 보호)
+)
+
+var memoryLangRegex = regexp.MustCompile(`^[a-z]{2}(-[A-Z]{2})?$`)
+
+type memoryKey struct {
+	Source string
+	Lang   string
+}
+
+type translationMemory struct {
+	mu      sync.Mutex
+	entries map[memoryKey]string
+	order   []memoryKey // 내보내기 순서 유지
+}
+
+func newTranslationMemory() *translationMemory {
+	return &translationMemory{entries: map[memoryKey]string{}}
+}
+
+// CSV(source,target,lang) 읽기. 첫 줄이 헤더면 건너뛰고, 같은 원문·언어가 다시 나오면 나중 값을 쓴다
+func parseTranslationMemory(r io.Reader) (*translationMemory, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	tm := newTranslationMemory()
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("번역 메모리 CSV 형식 오류: %w", err)
+		}
+		if line == 1 && strings.EqualFold(record[0], "source") && strings.EqualFold(record[1], "target") && strings.EqualFold(record[2], "lang") {
+			continue
+		}
+
+		source, target, lang := strings.TrimSpace(record[0]), strings.TrimSpace(record[1]), strings.TrimSpace(record[2])
+		if source == "" || target == "" {
+			return nil, fmt.Errorf("번역 메모리 %d번째 줄: 원문과 번역은 비워둘 수 없음", line)
+		}
+		if !memoryLangRegex.MatchString(lang) {
+			return nil, fmt.Errorf("번역 메모리 %d번째 줄: 잘못된 언어 코드 %q", line, lang)
+		}
+
+		key := memoryKey{Source: source, Lang: lang}
+		if prev, ok := tm.entries[key]; ok {
+			if prev != target {
+				log.Printf("[경고] 번역 메모리 중복 항목, 나중 값 사용 (line=%d, lang=%s)", line, lang)
+			}
+			tm.entries[key] = target
+			continue
+		}
+		tm.entries[key] = target
+		tm.order = append(tm.order, key)
+	}
+	return tm, nil
+}
+
+// 원문과 정확히 같은 항목 조회 (앞뒤 공백 무시)
+func (tm *translationMemory) lookup(source, lang string) (string, bool) {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	target, ok := tm.entries[memoryKey{Source: strings.TrimSpace(source), Lang: lang}]
+	return target, ok
+}
+
+// 번역 결과 추가 (이미 있는 항목은 유지, 상한을 넘으면 더 쌓지 않음)
+func (tm *translationMemory) store(source, lang, target string) {
+	key := memoryKey{Source: strings.TrimSpace(source), Lang: lang}
+	if key.Source == "" || target == "" {
+		return
+	}
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	if _, ok := tm.entries[key]; ok || len(tm.order) >= maxMemoryEntries {
+		return
+	}
+	tm.entries[key] = target
+	tm.order = append(tm.order, key)
+}
+
+// 가져오기와 같은 형식(헤더 포함)으로 내보내기
+func (tm *translationMemory) writeCSV(w io.Writer) error {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"source", "target", "lang"})
+	for _, key := range tm.order {
+		writer.Write([]string{key.Source, tm.entries[key], key.Lang})
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func (tm *translationMemory) len() int {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	return len(tm.order)
+}
+
+// 관리자에게 번역 메모리를 CSV로 DM 전송 (메시지 길이 제한에 맞춰 나눠 보냄)
+func (app *App) exportMemory(userID string) error {
+	if !contains(app.cfg.TranslationMemoryAdmins, userID) {
+		log.Printf("[무시] 번역 메모리 내보내기 권한 없음 (user=%s)", userID)
+		_, _, err := app.slack.PostMessage(userID, slack.MsgOptionText(app.userBotText(userID, msgMemoryForbidden), false))
+		return err
+	}
+	if app.memory == nil {
+		_, _, err := app.slack.PostMessage(userID, slack.MsgOptionText(app.userBotText(userID, msgMemoryDisabled), false))
+		return err
+	}
+
+	var buf strings.Builder
+	if err := app.memory.writeCSV(&buf); err != nil {
+		return fmt.Errorf("번역 메모리 CSV 생성 실패: %w", err)
+	}
+	for _, chunk := range splitByNewlineChunk(buf.String(), threadSummaryChunkMin, threadSummaryChunkMax) {
+		if _, _, err := app.slack.PostMessage(userID, slack.MsgOptionText("```\n"+strings.TrimRight(chunk, "\n")+"\n```", false)); err != nil {
+			return err
+		}
+	}
+	log.Printf("[성공] 번역 메모리 내보내기 (user=%s, 항목 %d개)", userID, app.memory.len())
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+const sampleMemoryCSV = `source,target,lang
+배포 완료했습니다,デプロイ完了しました,ja
+"회의록, 공유드립니다",議事録、共有します,ja
+了解です,알겠습니다,ko
+`
+
+func TestTranslationMemoryRoundTrip(t *testing.T) {
+	tm, err := parseTranslationMemory(strings.NewReader(sampleMemoryCSV))
+	if err != nil {
+		t.Fatalf("parseTranslationMemory: %v", err)
+	}
+	if tm.len() != 3 {
+		t.Fatalf("항목 수 = %d, want 3", tm.len())
+	}
+
+	var buf strings.Builder
+	if err := tm.writeCSV(&buf); err != nil {
+		t.Fatalf("writeCSV: %v", err)
+	}
+	if buf.String() != sampleMemoryCSV {
+		t.Errorf("내보낸 CSV가 원본과 다름:\n%s", buf.String())
+	}
+
+	again, err := parseTranslationMemory(strings.NewReader(buf.String()))
+	if err != nil {
+		t.Fatalf("다시 가져오기 실패: %v", err)
+	}
+	if got, ok := again.lookup("회의록, 공유드립니다", "ja"); !ok || got != "議事録、共有します" {
+		t.Errorf("lookup = (%q, %t)", got, ok)
+	}
+}
+
+func TestParseTranslationMemory(t *testing.T) {
+	tests := []struct {
+		name    string
+		csv     string
+		wantErr bool
+		wantLen int
+		lookup  string
+		want    string
+	}{
+		{"no header", "안녕하세요,こんにちは,ja\n", false, 1, "안녕하세요", "こんにちは"},
+		{"duplicate keeps last", "안녕하세요,こんにちは,ja\n안녕하세요,こんにちは！,ja\n", false, 1, "안녕하세요", "こんにちは！"},
+		{"missing column", "안녕하세요,こんにちは\n", true, 0, "", ""},
+		{"empty target", "안녕하세요,,ja\n", true, 0, "", ""},
+		{"bad lang", "안녕하세요,こんにちは,Japanese\n", true, 0, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm, err := parseTranslationMemory(strings.NewReader(tt.csv))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %t", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if tm.len() != tt.wantLen {
+				t.Errorf("항목 수 = %d, want %d", tm.len(), tt.wantLen)
+			}
+			if got, _ := tm.lookup(tt.lookup, "ja"); got != tt.want {
+				t.Errorf("lookup = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTranslateTextUsesMemory(t *testing.T) {
+	tm, _ := parseTranslationMemory(strings.NewReader(sampleMemoryCSV))
+	calls := 0
+	translate := fakeTranslate("[ja]")
+	app := &App{cfg: &Config{}, memory: tm, translate: func(chunks []string, lang string) ([]string, error) {
+		calls++
+		return translate(chunks, lang)
+	}}
+
+	if got, _ := app.translateText("배포 완료했습니다", "ja"); got != "デプロイ完了しました" {
+		t.Errorf("메모리 항목 번역 = %q", got)
+	}
+	if calls != 0 {
+		t.Errorf("메모리에 있는 원문인데 번역 API 호출 %d번", calls)
+	}
+
+	// 새로 번역한 결과는 메모리에 쌓이고 다음부터 재사용된다
+	app.translateText("점심 먹었어요?", "ja")
+	app.translateText("점심 먹었어요?", "ja")
+	if calls != 1 {
+		t.Errorf("번역 API 호출 수 = %d, want 1", calls)
+	}
+	if got, ok := tm.lookup("점심 먹었어요?", "ja"); !ok || got != "[ja]점심 먹었어요?" {
+		t.Errorf("쌓인 항목 = (%q, %t)", got, ok)
+	}
+}
+
+func TestExportMemoryShortcut(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     string
+		wantText string
+	}{
+		{"admin", "UADMIN", "배포 완료했습니다,デプロイ完了しました,ja"},
+		{"not admin", "U1", "권한이 없습니다"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			tm, _ := parseTranslationMemory(strings.NewReader(sampleMemoryCSV))
+			app := &App{cfg: &Config{TranslationMemoryAdmins: []string{"UADMIN"}}, slack: client, memory: tm}
+
+			payload, _ := json.Marshal(map[string]interface{}{
+				"type":        "shortcut",
+				"callback_id": exportMemoryCallbackID,
+				"user":        map[string]string{"id": tt.user},
+			})
+			if resp, err := app.handleInteraction("payload=" + url.QueryEscape(string(payload))); err != nil || resp.StatusCode != 200 {
+				t.Fatalf("handleInteraction: status=%d err=%v", resp.StatusCode, err)
+			}
+
+			posts := fs.callsTo("chat.postMessage")
+			if len(posts) != 1 {
+				t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+			}
+			if got := posts[0].Form.Get("channel"); got != tt.user {
+				t.Errorf("channel = %q, want %q", got, tt.user)
+			}
+			if text := posts[0].Form.Get("text"); !strings.Contains(text, tt.wantText) {
+				t.Errorf("text = %q, want %q 포함", text, tt.wantText)
+			}
+		})
+	}
+}
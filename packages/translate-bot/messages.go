@@ -0,0 +1,201 @@
+package main
+
+import (
+	"fmt"
+	"log"
+)
+
+// ─────────────────────────────────────
+// 봇 안내 문구 (BOT_LOCALE)
+// 번역 실패, 번역 중지/재개, 권한 없음 같은 봇이 직접 쓰는 문구를 받는 사람의 언어로 보낸다.
+// 한 사람에게만 보내는 안내는 그 사람의 언어(/translate-lang 설정 또는 Slack 언어)를,
+// 번역과 함께 스레드에 올리는 안내는 번역 언어를 따른다. 언어를 알 수 없거나 문구가 없으면 BOT_LOCALE(기본: ko)을 쓴다.
+
+const defaultBotLocale = "ko"
+
+const (
+	msgTranslationFailed    = "translation_failed"
+	msgLowConfidence        = "low_confidence"
+	msgTranslationLabel     = "translation_label"
+	msgOriginalLabel        = "original_label"
+	msgThreadResumed        = "thread_resumed"
+	msgThreadMuted          = "thread_muted"
+	msgMessageFailed        = "message_failed"
+	msgThreadFailed         = "thread_failed"
+	msgNothingToTranslate   = "nothing_to_translate"
+	msgNoThreadMessages     = "no_thread_messages"
+	msgAlreadyInLanguage    = "already_in_language"
+	msgUnsupportedPair      = "unsupported_pair"
+	msgAutoDetect           = "auto_detect"
+	msgThreadSummary        = "thread_summary"
+	msgMemoryForbidden      = "memory_forbidden"
+	msgMemoryDisabled       = "memory_disabled"
+	msgBilingualNudge       = "bilingual_nudge"
+	msgReaderLangCurrent    = "reader_lang_current"
+	msgReaderLangDefault    = "reader_lang_default"
+	msgReaderLangCleared    = "reader_lang_cleared"
+	msgReaderLangInvalid    = "reader_lang_invalid"
+	msgReaderLangSet        = "reader_lang_set"
+	msgBilingualOn          = "bilingual_on"
+	msgBilingualOff         = "bilingual_off"
+	msgBilingualMarked      = "bilingual_marked"
+	msgBilingualUnmarked    = "bilingual_unmarked"
+	msgBilingualAdminSet    = "bilingual_admin_set"
+	msgBilingualUsage       = "bilingual_usage"
+	msgUserPrefsUnavailable = "user_prefs_unavailable"
+	msgUserPrefsFailed      = "user_prefs_failed"
+)
+
+var botMessages = map[string]map[string]string{
+	msgTranslationFailed: {
+		"ko": "⚠️ 자동 번역 실패 — 원문",
+		"ja": "⚠️ 自動翻訳に失敗しました — 原文",
+		"en": "⚠️ Automatic translation failed — original",
+	},
+	msgLowConfidence: {
+		"ko": "⚠️ 번역 품질 낮음 (언어 감지 신뢰도 %.0f%%)",
+		"ja": "⚠️ 翻訳品質が低い可能性があります (言語検出の信頼度 %.0f%%)",
+		"en": "⚠️ Translation may be inaccurate (language detection confidence %.0f%%)",
+	},
+	msgTranslationLabel: {"ko": "🌐 번역", "ja": "🌐 翻訳", "en": "🌐 Translation"},
+	msgOriginalLabel:    {"ko": "원문", "ja": "原文", "en": "Original"},
+	msgThreadResumed: {
+		"ko": "🔊 이 스레드의 번역을 재개했습니다",
+		"ja": "🔊 このスレッドの翻訳を再開しました",
+		"en": "🔊 Resumed translation for this thread",
+	},
+	msgThreadMuted: {
+		"ko": "🔇 이 스레드의 번역을 중지했습니다",
+		"ja": "🔇 このスレッドの翻訳を停止しました",
+		"en": "🔇 Stopped translation for this thread",
+	},
+	msgMessageFailed: {
+		"ko": "⚠️ 메시지를 번역하지 못했습니다. 잠시 후 다시 시도해주세요.",
+		"ja": "⚠️ メッセージを翻訳できませんでした。しばらくしてからもう一度お試しください。",
+		"en": "⚠️ Couldn't translate the message. Please try again later.",
+	},
+	msgThreadFailed: {
+		"ko": "⚠️ 스레드를 번역하지 못했습니다. 잠시 후 다시 시도해주세요.",
+		"ja": "⚠️ スレッドを翻訳できませんでした。しばらくしてからもう一度お試しください。",
+		"en": "⚠️ Couldn't translate the thread. Please try again later.",
+	},
+	msgNothingToTranslate: {"ko": "번역할 내용이 없습니다.", "ja": "翻訳する内容がありません。", "en": "There is nothing to translate."},
+	msgNoThreadMessages:   {"ko": "번역할 메시지가 없습니다.", "ja": "翻訳するメッセージがありません。", "en": "There are no messages to translate."},
+	msgAlreadyInLanguage: {
+		"ko": "이미 내 언어로 쓰인 메시지입니다.",
+		"ja": "すでに自分の言語で書かれたメッセージです。",
+		"en": "This message is already in your language.",
+	},
+	msgUnsupportedPair: {
+		"ko": "이 언어 조합(%s→%s)은 번역을 지원하지 않습니다.",
+		"ja": "この言語の組み合わせ(%s→%s)は翻訳に対応していません。",
+		"en": "Translation for this language pair (%s→%s) is not supported.",
+	},
+	msgAutoDetect:    {"ko": "자동 감지", "ja": "自動検出", "en": "auto-detect"},
+	msgThreadSummary: {"ko": "🧵 스레드 번역 (%d개 메시지)", "ja": "🧵 スレッド翻訳 (%d件のメッセージ)", "en": "🧵 Thread translation (%d messages)"},
+	msgMemoryForbidden: {
+		"ko": "번역 메모리를 내보낼 권한이 없습니다.",
+		"ja": "翻訳メモリをエクスポートする権限がありません。",
+		"en": "You don't have permission to export the translation memory.",
+	},
+	msgMemoryDisabled: {"ko": "번역 메모리가 설정되지 않았습니다.", "ja": "翻訳メモリが設定されていません。", "en": "Translation memory is not configured."},
+	msgBilingualNudge: {
+		"ko": "💡 여러 언어로 자주 글을 쓰시네요. 내 메시지를 번역하지 않으려면 `/translate-bilingual on`을 입력하세요.",
+		"ja": "💡 複数の言語でよく投稿されていますね。自分のメッセージを翻訳しないようにするには `/translate-bilingual on` と入力してください。",
+		"en": "💡 You often write in more than one language. To stop translating your messages, type `/translate-bilingual on`.",
+	},
+	msgReaderLangCurrent: {
+		"ko": "현재 읽기 언어: %s (%s)\n`/translate-lang auto`로 Slack 언어 설정을 따르게 할 수 있습니다.",
+		"ja": "現在の閲覧言語: %s (%s)\n`/translate-lang auto` でSlackの言語設定に従うようにできます。",
+		"en": "Current reading language: %s (%s)\nUse `/translate-lang auto` to follow your Slack language setting.",
+	},
+	msgReaderLangDefault: {
+		"ko": "읽기 언어를 따로 정하지 않아 Slack 언어 설정을 따릅니다.\n사용법: `/translate-lang ko|ja|en` 또는 `/translate-lang auto`",
+		"ja": "閲覧言語を指定していないため、Slackの言語設定に従います。\n使い方: `/translate-lang ko|ja|en` または `/translate-lang auto`",
+		"en": "No reading language is set, so your Slack language setting is used.\nUsage: `/translate-lang ko|ja|en` or `/translate-lang auto`",
+	},
+	msgReaderLangCleared: {
+		"ko": "읽기 언어 설정을 지웠습니다. 이제 Slack 언어 설정을 따릅니다.",
+		"ja": "閲覧言語の設定を削除しました。今後はSlackの言語設定に従います。",
+		"en": "Cleared your reading language. Your Slack language setting is used from now on.",
+	},
+	msgReaderLangInvalid: {
+		"ko": "지원하지 않는 언어입니다: %s\n사용법: `/translate-lang ko|ja|en` 또는 `/translate-lang auto`",
+		"ja": "対応していない言語です: %s\n使い方: `/translate-lang ko|ja|en` または `/translate-lang auto`",
+		"en": "Unsupported language: %s\nUsage: `/translate-lang ko|ja|en` or `/translate-lang auto`",
+	},
+	msgReaderLangSet: {
+		"ko": "읽기 언어를 %s(으)로 설정했습니다. 스레드/메시지 번역 단축키가 이 언어로 번역합니다.",
+		"ja": "閲覧言語を%sに設定しました。スレッド/メッセージ翻訳のショートカットはこの言語に翻訳します。",
+		"en": "Set your reading language to %s. The thread and message translation shortcuts will translate into this language.",
+	},
+	msgBilingualOn: {
+		"ko": "이중 언어 사용자로 표시되어 있어 내 메시지는 번역하지 않습니다.\n`/translate-bilingual off`로 다시 번역하게 할 수 있습니다.",
+		"ja": "バイリンガルとして登録されているため、自分のメッセージは翻訳しません。\n`/translate-bilingual off` で再び翻訳するようにできます。",
+		"en": "You're marked as bilingual, so your messages are not translated.\nUse `/translate-bilingual off` to have them translated again.",
+	},
+	msgBilingualOff: {
+		"ko": "내 메시지도 번역합니다.\n사용법: `/translate-bilingual on` 또는 `/translate-bilingual off`",
+		"ja": "自分のメッセージも翻訳します。\n使い方: `/translate-bilingual on` または `/translate-bilingual off`",
+		"en": "Your messages are translated.\nUsage: `/translate-bilingual on` or `/translate-bilingual off`",
+	},
+	msgBilingualMarked: {
+		"ko": "이중 언어 사용자로 표시했습니다. 이제 내 메시지는 번역하지 않습니다.",
+		"ja": "バイリンガルとして登録しました。今後、自分のメッセージは翻訳しません。",
+		"en": "Marked you as bilingual. Your messages will no longer be translated.",
+	},
+	msgBilingualUnmarked: {
+		"ko": "이중 언어 사용자 표시를 지웠습니다. 이제 내 메시지도 번역합니다.",
+		"ja": "バイリンガルの登録を解除しました。今後は自分のメッセージも翻訳します。",
+		"en": "Removed your bilingual mark. Your messages will be translated again.",
+	},
+	msgBilingualAdminSet: {
+		"ko": "관리자가 설정한 이중 언어 사용자라서 계속 번역하지 않습니다.",
+		"ja": "管理者がバイリンガルとして設定しているため、引き続き翻訳しません。",
+		"en": "An admin has set you as bilingual, so your messages are still not translated.",
+	},
+	msgBilingualUsage: {
+		"ko": "사용법: `/translate-bilingual on` 또는 `/translate-bilingual off`",
+		"ja": "使い方: `/translate-bilingual on` または `/translate-bilingual off`",
+		"en": "Usage: `/translate-bilingual on` or `/translate-bilingual off`",
+	},
+	msgUserPrefsUnavailable: {
+		"ko": "관리자가 사용자 설정 저장소(`USER_PREFS_TABLE`)를 설정하지 않아 이 명령을 쓸 수 없습니다.",
+		"ja": "管理者がユーザー設定の保存先(`USER_PREFS_TABLE`)を設定していないため、このコマンドは使えません。",
+		"en": "This command is unavailable because the admin has not set up user settings storage (`USER_PREFS_TABLE`).",
+	},
+	msgUserPrefsFailed: {
+		"ko": "설정을 저장하지 못했습니다. 잠시 후 다시 시도해주세요.",
+		"ja": "設定を保存できませんでした。しばらくしてから再度お試しください。",
+		"en": "Couldn't save your setting. Please try again in a moment.",
+	},
+}
+
+// 언어를 알 수 없을 때 쓸 안내 언어 (BOT_LOCALE, 문구가 없는 언어면 ko)
+func (app *App) botLocale() string {
+	if _, ok := botMessages[msgTranslationFailed][app.cfg.BotLocale]; ok {
+		return app.cfg.BotLocale
+	}
+	if app.cfg.BotLocale != "" {
+		log.Printf("[경고] BOT_LOCALE 값을 지원하지 않음: %q", app.cfg.BotLocale)
+	}
+	return defaultBotLocale
+}
+
+// lang 언어의 안내 문구 (args가 있으면 형식 문자열로 채움)
+func (app *App) botText(key, lang string, args ...any) string {
+	messages := botMessages[key]
+	text, ok := messages[lang]
+	if !ok {
+		text = messages[app.botLocale()]
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(text, args...)
+	}
+	return text
+}
+
+// 한 사람에게 보내는 안내 문구 (그 사람의 언어)
+func (app *App) userBotText(userID, key string, args ...any) string {
+	return app.botText(key, app.userLang(userID), args...)
+}
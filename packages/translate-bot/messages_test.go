@@ -0,0 +1,90 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+func TestBotText(t *testing.T) {
+	tests := []struct {
+		name      string
+		botLocale string
+		lang      string
+		want      string
+	}{
+		{"korean", "", "ko", "⚠️ 스레드를 번역하지 못했습니다. 잠시 후 다시 시도해주세요."},
+		{"japanese", "", "ja", "⚠️ スレッドを翻訳できませんでした。しばらくしてからもう一度お試しください。"},
+		{"english", "", "en", "⚠️ Couldn't translate the thread. Please try again later."},
+		{"unknown language uses default", "", "fr", "⚠️ 스레드를 번역하지 못했습니다. 잠시 후 다시 시도해주세요."},
+		{"unknown language uses BOT_LOCALE", "en", "", "⚠️ Couldn't translate the thread. Please try again later."},
+		{"unsupported BOT_LOCALE falls back to korean", "fr", "", "⚠️ 스레드를 번역하지 못했습니다. 잠시 후 다시 시도해주세요."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &App{cfg: &Config{BotLocale: tt.botLocale}}
+			if got := app.botText(msgThreadFailed, tt.lang); got != tt.want {
+				t.Errorf("botText = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBotTextFormat(t *testing.T) {
+	app := &App{cfg: &Config{}}
+	tests := []struct {
+		lang string
+		want string
+	}{
+		{"ko", "⚠️ 번역 품질 낮음 (언어 감지 신뢰도 42%)"},
+		{"ja", "⚠️ 翻訳品質が低い可能性があります (言語検出の信頼度 42%)"},
+		{"en", "⚠️ Translation may be inaccurate (language detection confidence 42%)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			if got := app.botText(msgLowConfidence, tt.lang, 42.0); got != tt.want {
+				t.Errorf("botText = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBotMessagesCoverLocales(t *testing.T) {
+	for key, messages := range botMessages {
+		for _, lang := range []string{"ko", "ja", "en"} {
+			if messages[lang] == "" {
+				t.Errorf("%s: %s 문구 없음", key, lang)
+			}
+		}
+	}
+}
+
+// 번역 금지 토글 안내는 누른 사람의 Slack 언어로
+func TestNoTranslateToggleLocalized(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{"ko-KR", "🔇 이 스레드의 번역을 중지했습니다"},
+		{"ja-JP", "🔇 このスレッドの翻訳を停止しました"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			fs.responses["users.info"] = `{"ok":true,"user":{"id":"U1","locale":"` + tt.locale + `"}}`
+			app := &App{cfg: &Config{}, slack: client, translate: fakeTranslate("[번역]")}
+
+			ev := &slackevents.MessageEvent{Channel: "C1", User: "U1", Text: "!tt", TimeStamp: "1.0"}
+			if err := app.processMessage(ev); err != nil {
+				t.Fatalf("processMessage: %v", err)
+			}
+			eph := fs.callsTo("chat.postEphemeral")
+			if len(eph) != 1 {
+				t.Fatalf("chat.postEphemeral 호출 수 = %d, want 1", len(eph))
+			}
+			if got := eph[0].Form.Get("text"); got != tt.want {
+				t.Errorf("text = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 메시지 하나 번역 (메시지 단축키 "translate_message")
+// 자동 번역 설정(번역 금지 스레드, 봇 메시지, 코드/링크 비중, 채널 주 언어 등)과 관계없이
+// 단축키를 누른 메시지를 번역해 누른 사람에게만(ephemeral) 보여준다.
+// 번역 언어는 누른 사람의 Slack 언어를 따르고, 알 수 없으면 자동 번역과 같은 규칙으로 정한다.
+
+const messageShortcutCallbackID = "translate_message"
+
+// 메시지를 읽는 사람의 언어로 번역한 원문, 번역문과 그 언어 (번역할 수 없으면 notice에 그 언어로 쓴 안내 문구)
+func (app *App) translateForReader(channelID, userID string, msg slack.Message) (source, text, lang, notice string, err error) {
+	segs := richTextSegments(msg.Blocks)
+	source = msg.Text
+	if segs != nil {
+		source = richTextTranslatable(segs)
+	} else if msg.BotID != "" {
+		if text := botBlocksText(msg.Blocks); text != "" {
+			source = text
+		}
+	}
+
+	lang = app.pairTargetLang(channelID, source)
+	if reader := app.userLang(userID); reader != "" {
+		lang = reader
+	}
+
+	if strings.TrimSpace(source) == "" || lang == "" {
+		return source, "", lang, app.botText(msgNothingToTranslate, lang), nil
+	}
+	sourceLang := detectSourceLang(source)
+	if lang == sourceLang {
+		return source, "", lang, app.botText(msgAlreadyInLanguage, lang), nil
+	}
+	if app.supportedTarget(sourceLang, lang) == "" {
+		return source, "", lang, app.unsupportedPairNotice(sourceLang, lang, lang), nil
+	}
+
+	translate := app.translatorFor(channelID)
+	if segs != nil {
+		text, err = app.translateRichTextWith(translate, segs, lang)
+	} else {
+		text, err = app.translateTextWith(translate, source, lang)
+	}
+	if err != nil {
+		return source, "", lang, "", fmt.Errorf("번역 실패: %w", err)
+	}
+	return source, text, lang, "", nil
+}
+
+// 메시지 위치(스레드면 스레드 안)에 누른 사람에게만 보이는 메시지 게시
+func (app *App) replyEphemeral(channelID, userID string, msg slack.Message, opts ...slack.MsgOption) error {
+	if msg.ThreadTimestamp != "" {
+		opts = append(opts, slack.MsgOptionTS(msg.ThreadTimestamp))
+	}
+	_, err := app.slack.PostEphemeral(channelID, userID, opts...)
+	return err
+}
+
+// 단축키 대상 메시지를 번역해 요청한 사람에게만 게시
+func (app *App) translateMessageFor(channelID, userID string, msg slack.Message) error {
+	_, text, lang, notice, err := app.translateForReader(channelID, userID, msg)
+	if err != nil {
+		return err
+	}
+	if notice != "" {
+		return app.replyEphemeral(channelID, userID, msg, slack.MsgOptionText(notice, false))
+	}
+
+	log.Printf("[성공] 메시지 단축키 번역 (channel=%s, ts=%s)", channelID, msg.Timestamp)
+	return app.replyEphemeral(channelID, userID, msg, slack.MsgOptionText(app.botText(msgTranslationLabel, lang)+"\n"+text, false))
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestHandleInteractionMessageShortcut(t *testing.T) {
+	tests := []struct {
+		name       string
+		locale     string
+		message    map[string]string
+		wantText   string
+		wantThread string
+	}{
+		{"japanese for korean reader", "ko-KR", map[string]string{"ts": "1.0", "text": "明日のリリースどうしますか"}, "🌐 번역\n[번역]明日のリリースどうしますか", ""},
+		// 봇 메시지처럼 자동 번역이 건너뛰는 메시지도 번역
+		{"bot message in thread", "ko-KR", map[string]string{"ts": "1.2", "thread_ts": "1.0", "bot_id": "BCI", "text": "ビルド失敗"}, "🌐 번역\n[번역]ビルド失敗", "1.0"},
+		{"already reader language", "ja-JP", map[string]string{"ts": "1.0", "text": "明日のリリースどうしますか"}, "すでに自分の言語で書かれたメッセージです。", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			fs.responses["users.info"] = `{"ok":true,"user":{"id":"U2","locale":"` + tt.locale + `"}}`
+			app := &App{cfg: &Config{}, slack: client, translate: fakeTranslate("[번역]")}
+
+			payload, _ := json.Marshal(map[string]interface{}{
+				"type":        "message_action",
+				"callback_id": messageShortcutCallbackID,
+				"user":        map[string]string{"id": "U2"},
+				"channel":     map[string]string{"id": "C1"},
+				"message":     tt.message,
+			})
+			resp, err := app.handleInteraction("payload=" + url.QueryEscape(string(payload)))
+			if err != nil || resp.StatusCode != 200 {
+				t.Fatalf("handleInteraction: status=%d err=%v", resp.StatusCode, err)
+			}
+
+			if n := len(fs.callsTo("chat.postMessage")); n != 0 {
+				t.Errorf("채널에 게시함 (%d회), ephemeral만 보내야 함", n)
+			}
+			eph := fs.callsTo("chat.postEphemeral")
+			if len(eph) != 1 {
+				t.Fatalf("chat.postEphemeral 호출 수 = %d, want 1", len(eph))
+			}
+			form := eph[0].Form
+			if form.Get("user") != "U2" || form.Get("thread_ts") != tt.wantThread {
+				t.Errorf("user=%q thread_ts=%q, want U2/%q", form.Get("user"), form.Get("thread_ts"), tt.wantThread)
+			}
+			if got := form.Get("text"); !strings.HasPrefix(got, tt.wantText) {
+				t.Errorf("text = %q, want %q", got, tt.wantText)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// ─────────────────────────────────────
+// 번역 결과 나눠 게시 (MAX_POST_CHARS)
+// 원문은 번역 API 한도에 맞춰 나눠 번역하지만 결과는 한 문자열로 합쳐지므로, 아주 긴 메시지는
+// Slack 메시지 길이 한도(40,000자)를 넘을 수 있다. 원문 분할과 관계없이 결과를 다시 나눠
+// 빈 줄 → 줄바꿈 → 공백 순으로 자연스러운 경계에서 자르고, 여러 메시지로 순서대로 스레드에 게시한다.
+
+const slackMessageLimit = 40000
+
+func (app *App) maxPostChars() int {
+	if n := app.cfg.MaxPostChars; n > 0 && n < slackMessageLimit {
+		return n
+	}
+	return slackMessageLimit
+}
+
+// 한 메시지에 max자(룬 기준)까지 들어가도록 나눔
+func splitForPost(text string, max int) []string {
+	var parts []string
+	for utf8.RuneCountInString(text) > max {
+		limit := runeOffset(text, max)
+		cut, skip := limit, 0
+		for _, sep := range []string{"\n\n", "\n", " "} {
+			if i := strings.LastIndex(text[:limit], sep); i > 0 {
+				cut, skip = i, len(sep)
+				break
+			}
+		}
+		parts = append(parts, text[:cut])
+		text = text[cut+skip:]
+	}
+	return append(parts, text)
+}
+
+// n번째 룬의 바이트 위치
+func runeOffset(s string, n int) int {
+	count := 0
+	for i := range s {
+		if count == n {
+			return i
+		}
+		count++
+	}
+	return len(s)
+}
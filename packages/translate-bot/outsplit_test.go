@@ -0,0 +1,81 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+func TestSplitForPost(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		max  int
+		want []string
+	}{
+		{"fits", "짧은 번역", 10, []string{"짧은 번역"}},
+		{"paragraph boundary", "첫 문단입니다\n\n둘째 문단입니다", 10, []string{"첫 문단입니다", "둘째 문단입니다"}},
+		{"line boundary", "一行目です\n二行目です", 8, []string{"一行目です", "二行目です"}},
+		{"word boundary", "aaa bbb ccc", 8, []string{"aaa bbb", "ccc"}},
+		{"hard cut keeps runes whole", "가나다라마바사", 3, []string{"가나다", "라마바", "사"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := splitForPost(tt.text, tt.max)
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("splitForPost = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProcessMessageSplitsLongTranslation(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{MaxPostChars: 30}, slack: client, translate: fakeTranslate("")}
+
+	paragraphs := []string{
+		"오늘 배포 일정은 오후 세 시로 변경되었습니다",
+		"리뷰가 끝난 브랜치부터 순서대로 병합해 주세요",
+		"문제가 생기면 이 스레드에 바로 알려 주세요",
+	}
+	ev := &slackevents.MessageEvent{Channel: "C1", User: "U1", Text: strings.Join(paragraphs, "\n\n"), TimeStamp: "1.0"}
+	if err := app.processMessage(ev); err != nil {
+		t.Fatalf("processMessage: %v", err)
+	}
+
+	posts := fs.callsTo("chat.postMessage")
+	if len(posts) != 3 {
+		t.Fatalf("chat.postMessage 호출 수 = %d, want 3", len(posts))
+	}
+	for i, p := range posts {
+		text := p.Form.Get("text")
+		if n := utf8.RuneCountInString(text); n > 30 {
+			t.Errorf("%d번째 메시지 길이 = %d, want <= 30", i+1, n)
+		}
+		if text != paragraphs[i] {
+			t.Errorf("%d번째 메시지 = %q, want %q (순서 유지)", i+1, text, paragraphs[i])
+		}
+		if p.Form.Get("thread_ts") != "1.0" {
+			t.Errorf("%d번째 메시지 thread_ts = %q", i+1, p.Form.Get("thread_ts"))
+		}
+	}
+}
+
+func TestMaxPostChars(t *testing.T) {
+	tests := []struct {
+		configured int
+		want       int
+	}{
+		{0, slackMessageLimit},
+		{3000, 3000},
+		{100000, slackMessageLimit},
+	}
+	for _, tt := range tests {
+		app := &App{cfg: &Config{MaxPostChars: tt.configured}}
+		if got := app.maxPostChars(); got != tt.want {
+			t.Errorf("maxPostChars(%d) = %d, want %d", tt.configured, got, tt.want)
+		}
+	}
+}
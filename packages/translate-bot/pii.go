@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+)
+
+// ─────────────────────────────────────
+// 개인정보 패턴 보호 (PII_ACTION, opt-in)
+// 주민등록번호나 카드 번호처럼 외부 번역 API로 보내면 안 되는 값이 들어 있는 메시지는
+// 아예 번역하지 않거나(skip), 그 부분만 자리표시자로 가려서 보내고 번역 후 되돌린다(mask).
+// skip이어도 번역 경로(스레드/수정/첨부 등)에서는 항상 가려서 보내, 원문 값이 API로 나가지 않게 한다.
+// 언어 감지 신뢰도 검사(CONFIDENCE_THRESHOLD)로 보내는 원문도 같은 방식으로 가린다.
+
+const (
+	PIIActionMask = "mask"
+	PIIActionSkip = "skip"
+)
+
+// 기본 패턴: 카드 번호(16자리), 주민등록번호/외국인등록번호, 일본 마이넘버(12자리)
+// 긴 패턴부터 검사해 카드 번호 앞 12자리가 마이넘버로 잡히지 않게 한다.
+var defaultPIIPatterns = []string{
+	`\b\d{4}[- ]?\d{4}[- ]?\d{4}[- ]?\d{4}\b`,
+	`\b\d{2}(?:0[1-9]|1[0-2])(?:0[1-9]|[12]\d|3[01])-?[1-8]\d{6}\b`,
+	`\b\d{4}[- ]?\d{4}[- ]?\d{4}\b`,
+}
+
+const piiPlaceholderF = "__PII%d__"
+
+// 패턴 목록 컴파일 (설정이 없으면 기본 패턴, 잘못된 항목은 경고 후 무시)
+func compilePIIPatterns(patterns []string) []*regexp.Regexp {
+	if len(patterns) == 0 {
+		patterns = defaultPIIPatterns
+	}
+	var out []*regexp.Regexp
+	for _, p := range patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			log.Printf("[경고] 잘못된 PII_PATTERNS 항목 무시 (%q): %v", p, err)
+			continue
+		}
+		out = append(out, re)
+	}
+	return out
+}
+
+// 설정된 패턴 (처음 한 번만 컴파일, NewApp에서 미리 불러 잘못된 항목 경고도 시작할 때 한 번만 남긴다)
+func (app *App) piiPatterns() []*regexp.Regexp {
+	app.piiOnce.Do(func() {
+		if app.cfg.PIIAction != PIIActionMask && app.cfg.PIIAction != PIIActionSkip {
+			return
+		}
+		app.pii = compilePIIPatterns(app.cfg.PIIPatterns)
+	})
+	return app.pii
+}
+
+// 개인정보로 보이는 값이 있어 번역하지 않을 메시지인지 (PII_ACTION=skip일 때만)
+func (app *App) skipForPII(text string) bool {
+	if app.cfg.PIIAction != PIIActionSkip {
+		return false
+	}
+	for _, re := range app.piiPatterns() {
+		if re.MatchString(text) {
+			return true
+		}
+	}
+	return false
+}
+
+func protectPII(text string, patterns []*regexp.Regexp) (string, []string) {
+	var replacements []string
+	for _, re := range patterns {
+		text = re.ReplaceAllStringFunc(text, func(match string) string {
+			placeholder := fmt.Sprintf(piiPlaceholderF, len(replacements))
+			replacements = append(replacements, match)
+			return placeholder
+		})
+	}
+	return text, replacements
+}
+
+func restorePII(text string, replacements []string) string {
+	for i, replacement := range replacements {
+		text = strings.ReplaceAll(text, fmt.Sprintf(piiPlaceholderF, i), replacement)
+	}
+	return text
+}
+
+// 청크 중 하나라도 개인정보를 가렸는지
+func hasPII(replacements [][]string) bool {
+	for _, r := range replacements {
+		if len(r) > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+func TestProtectPII(t *testing.T) {
+	patterns := compilePIIPatterns(nil)
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{"resident registration number", "주민번호 900101-1234567 입니다", []string{"900101-1234567"}},
+		{"card number", "카드 1234-5678-9012-3456 결제", []string{"1234-5678-9012-3456"}},
+		{"my number", "マイナンバーは1234 5678 9012です", []string{"1234 5678 9012"}},
+		{"ordinary numbers", "회의는 2026-10-16 15시, 3층", nil},
+		{"phone-like short digits", "내선 1234로 연락주세요", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, repls := protectPII(tt.input, patterns)
+			if strings.Join(repls, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("가린 값 = %v, want %v", repls, tt.want)
+			}
+			for _, r := range repls {
+				if strings.Contains(got, r) {
+					t.Errorf("가린 뒤에도 값이 남음: %q", got)
+				}
+			}
+			if back := restorePII(got, repls); back != tt.input {
+				t.Errorf("restorePII = %q, want %q", back, tt.input)
+			}
+		})
+	}
+}
+
+func TestTranslateMasksPII(t *testing.T) {
+	var sent []string
+	translate := func(chunks []string, lang string) ([]string, error) {
+		sent = append(sent, chunks...)
+		return fakeTranslate("[ja] ")(chunks, lang)
+	}
+	app := &App{cfg: &Config{PIIAction: PIIActionMask}}
+
+	got, err := app.translateTextWith(translate, "제 주민번호는 900101-1234567 이에요", "ja")
+	if err != nil {
+		t.Fatalf("translateTextWith: %v", err)
+	}
+	if strings.Contains(strings.Join(sent, "\n"), "1234567") {
+		t.Errorf("번역 API로 개인정보가 전송됨: %q", sent)
+	}
+	if want := "[ja] 제 주민번호는 900101-1234567 이에요"; got != want {
+		t.Errorf("번역 결과 = %q, want %q", got, want)
+	}
+}
+
+func TestTranslateMemorySkipsPII(t *testing.T) {
+	tm, _ := parseTranslationMemory(strings.NewReader(sampleMemoryCSV))
+	app := &App{cfg: &Config{PIIAction: PIIActionMask}, memory: tm}
+	translate := fakeTranslate("[ja] ")
+
+	app.translateTextWith(translate, "제 주민번호는 900101-1234567 이에요", "ja")
+	if _, ok := tm.lookup("제 주민번호는 900101-1234567 이에요", "ja"); ok {
+		t.Error("개인정보를 가린 메시지가 번역 메모리에 쌓임")
+	}
+
+	app.translateTextWith(translate, "점심 먹었어요?", "ja")
+	if _, ok := tm.lookup("점심 먹었어요?", "ja"); !ok {
+		t.Error("개인정보가 없는 메시지는 메모리에 쌓여야 함")
+	}
+}
+
+func TestProcessMessageSkipsPII(t *testing.T) {
+	tests := []struct {
+		name      string
+		action    string
+		patterns  []string
+		text      string
+		wantPosts int
+	}{
+		{"skip with default pattern", PIIActionSkip, nil, "카드번호 1234-5678-9012-3456 확인 부탁드려요", 0},
+		{"skip with custom pattern", PIIActionSkip, []string{`EMP-\d{5}`}, "사번 EMP-12345 입니다", 0},
+		{"no pii translated", PIIActionSkip, nil, "내일 회의 일정 공유드려요", 1},
+		{"mask still posts", PIIActionMask, nil, "카드번호 1234-5678-9012-3456 확인 부탁드려요", 1},
+		{"check disabled", "", nil, "카드번호 1234-5678-9012-3456 확인 부탁드려요", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: &Config{PIIAction: tt.action, PIIPatterns: tt.patterns}, slack: client, translate: fakeTranslate("[ja]")}
+
+			ev := &slackevents.MessageEvent{Channel: "C1", User: "U1", Text: tt.text, TimeStamp: "1.0"}
+			if err := app.processMessage(ev); err != nil {
+				t.Fatalf("processMessage: %v", err)
+			}
+			if n := len(fs.callsTo("chat.postMessage")); n != tt.wantPosts {
+				t.Errorf("chat.postMessage 호출 수 = %d, want %d", n, tt.wantPosts)
+			}
+		})
+	}
+}
+
+func TestLowConfidenceMasksPII(t *testing.T) {
+	var detected []string
+	app := &App{cfg: &Config{PIIAction: PIIActionMask, ConfidenceThreshold: 0.5}}
+	app.detect = func(text string) (string, float64, error) {
+		detected = append(detected, text)
+		return "ko", 0.9, nil
+	}
+
+	app.lowConfidence("카드번호 1234-5678-9012-3456 확인 부탁드려요")
+	if len(detected) != 1 || strings.Contains(detected[0], "1234-5678") {
+		t.Errorf("언어 감지 API로 개인정보가 전송됨: %q", detected)
+	}
+}
+
+func TestPIIPatternsCompiledOnce(t *testing.T) {
+	app := &App{cfg: &Config{PIIAction: PIIActionSkip}}
+	first := app.piiPatterns()
+	if len(first) == 0 || &app.piiPatterns()[0] != &first[0] {
+		t.Errorf("piiPatterns가 호출마다 다시 컴파일됨")
+	}
+}
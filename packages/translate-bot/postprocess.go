@@ -0,0 +1,57 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ─────────────────────────────────────
+// 번역 후처리 (기계번역 잔여물 정리)
+// 보호 토큰 복원 후 생기는 중복 공백, 문장부호 앞 공백, 언어에 맞지 않는 전각/반각 문장부호를 정리한다.
+
+var (
+	// 줄 중간의 2칸 이상 공백 (줄 앞 들여쓰기는 유지)
+	innerSpacesRegex = regexp.MustCompile(`(\S)[ \t]{2,}`)
+	// 반각 문장부호 앞 공백 (":"는 :emoji: 코드와 겹치므로 제외)
+	spaceBeforePunctRegex = regexp.MustCompile(`[ \t]+([.,!?)\]])`)
+	// 일본어 문자/숫자/전각 문장부호 사이에 낀 공백 (한쪽 이상이 일본어일 때)
+	jaSpaceBetweenRegex = regexp.MustCompile(`([\p{Han}\p{Hiragana}\p{Katakana}ー、。！？」』）]) +([\p{Han}\p{Hiragana}\p{Katakana}ー、。！？「『（0-9])|([0-9]) +([\p{Han}\p{Hiragana}\p{Katakana}ー、。！？])`)
+)
+
+// 한국어 출력에 섞인 전각 문장부호 → 반각
+var koFullWidthPunct = strings.NewReplacer(
+	"。", ". ",
+	"、", ", ",
+	"！", "!",
+	"？", "?",
+	"：", ":",
+	"（", "(",
+	"）", ")",
+)
+
+func postProcessTranslation(text, targetLang string) string {
+	switch targetLang {
+	case "ko":
+		text = koFullWidthPunct.Replace(text)
+		text = spaceBeforePunctRegex.ReplaceAllString(text, "$1")
+	case "ja":
+		// 겹치는 매칭(가 나 다)을 모두 처리하기 위해 변화가 없을 때까지 반복
+		for {
+			next := jaSpaceBetweenRegex.ReplaceAllString(text, "$1$2$3$4")
+			if next == text {
+				break
+			}
+			text = next
+		}
+		text = spaceBeforePunctRegex.ReplaceAllString(text, "$1")
+	}
+
+	text = innerSpacesRegex.ReplaceAllString(text, "$1 ")
+
+	// 줄 끝 공백 제거
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return strings.Join(lines, "\n")
+}
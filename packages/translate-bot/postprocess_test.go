@@ -0,0 +1,64 @@
+package main
+
+import "testing"
+
+func TestPostProcessTranslation(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		targetLang string
+		want       string
+	}{
+		{
+			name:       "ko_double_spaces_around_restored_currency",
+			input:      "가격은  10만엔  입니다",
+			targetLang: "ko",
+			want:       "가격은 10만엔 입니다",
+		},
+		{
+			name:       "ko_space_before_punctuation",
+			input:      "좋아요 ! 내일 봐요 .",
+			targetLang: "ko",
+			want:       "좋아요! 내일 봐요.",
+		},
+		{
+			name:       "ko_stray_full_width_punctuation",
+			input:      "알겠습니다。내일 뵙겠습니다！",
+			targetLang: "ko",
+			want:       "알겠습니다. 내일 뵙겠습니다!",
+		},
+		{
+			name:       "ko_keeps_indentation_and_emoji_code",
+			input:      "  들여쓰기 유지 :tada:",
+			targetLang: "ko",
+			want:       "  들여쓰기 유지 :tada:",
+		},
+		{
+			name:       "ja_spaces_around_restored_currency",
+			input:      "価格は 5万ウォン です 。",
+			targetLang: "ja",
+			want:       "価格は5万ウォンです。",
+		},
+		{
+			name:       "ja_keeps_space_next_to_latin",
+			input:      "Slack の 設定",
+			targetLang: "ja",
+			want:       "Slack の設定",
+		},
+		{
+			name:       "ja_trailing_spaces_per_line",
+			input:      "了解です   \nありがとう",
+			targetLang: "ja",
+			want:       "了解です\nありがとう",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := postProcessTranslation(tt.input, tt.targetLang)
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net"
+	"regexp"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 번역 게시 재시도 + dead-letter 기록
+// 번역까지 끝났는데 chat.postMessage가 일시적인 오류(네트워크, 5xx, rate limit)로 실패하면 번역이 사라진다.
+// 지수 백오프로 POST_RETRIES번까지 다시 시도하고, 끝내 실패하면 다시 게시할 수 있도록
+// 게시 내용을 구조화된 로그([DLQ] JSON 한 줄)로 남긴다. CloudWatch Logs 구독 필터로 모아 재처리한다.
+// 기록에는 채널/스레드/번역문만 담고, 에러 문자열에 섞일 수 있는 토큰은 가린다.
+
+const defaultPostRetries = 2
+
+// 첫 재시도 대기 시간 (이후 두 배씩, 테스트에서 줄임)
+var postRetryBaseDelay = time.Second
+
+var slackTokenPattern = regexp.MustCompile(`xox[a-z]-[A-Za-z0-9-]+`)
+
+// 다시 게시하는 데 필요한 정보
+type deadLetterRecord struct {
+	Channel  string `json:"channel"`
+	ThreadTS string `json:"thread_ts"`
+	SourceTS string `json:"source_ts"`
+	Lang     string `json:"lang"`
+	Text     string `json:"text"`
+	Error    string `json:"error"`
+	Attempts int    `json:"attempts"`
+	FailedAt string `json:"failed_at"`
+}
+
+func (app *App) postRetries() int {
+	if app.cfg.PostRetries > 0 {
+		return app.cfg.PostRetries
+	}
+	return defaultPostRetries
+}
+
+// 다시 시도해볼 만한 오류인지 (5xx/rate limit/네트워크 오류)
+func isRetryablePostError(err error) bool {
+	var retryable interface{ Retryable() bool }
+	if errors.As(err, &retryable) {
+		return retryable.Retryable()
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// 재시도하며 게시하고, 끝내 실패하면 dead-letter로 기록
+func (app *App) postWithRetry(record deadLetterRecord, opts ...slack.MsgOption) error {
+	retries := app.postRetries()
+	delay := postRetryBaseDelay
+
+	var err error
+	attempts := 0
+	for attempts <= retries {
+		attempts++
+		if _, _, err = app.slack.PostMessage(record.Channel, opts...); err == nil {
+			return nil
+		}
+		if !isRetryablePostError(err) || attempts > retries {
+			break
+		}
+
+		wait := delay
+		var rateLimited *slack.RateLimitedError
+		if errors.As(err, &rateLimited) {
+			wait = rateLimited.RetryAfter
+		}
+		log.Printf("[경고] 번역 게시 실패, %s 후 재시도 (%d/%d): %v", wait, attempts, retries, err)
+		time.Sleep(wait)
+		delay *= 2
+	}
+
+	record.Error = redactTokens(err.Error())
+	record.Attempts = attempts
+	record.FailedAt = time.Now().UTC().Format(time.RFC3339)
+	if app.deadLetter != nil {
+		app.deadLetter(record)
+	}
+	return err
+}
+
+// 로그에 남기면 안 되는 Slack 토큰 가리기
+func redactTokens(s string) string {
+	return slackTokenPattern.ReplaceAllString(s, "xox*-***")
+}
+
+// 기본 dead-letter: CloudWatch Logs에서 필터링할 수 있도록 JSON 한 줄로 출력
+func logDeadLetter(record deadLetterRecord) {
+	b, err := json.Marshal(record)
+	if err != nil {
+		log.Printf("[에러] dead-letter 직렬화 실패: %v", err)
+		return
+	}
+	log.Printf("[DLQ] %s", b)
+}
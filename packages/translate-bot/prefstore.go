@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// ─────────────────────────────────────
+// 사용자 설정 저장소 (USER_PREFS_TABLE)
+// 사용자가 명령으로 고른 설정은 여러 Lambda 인스턴스가 함께 봐야 하므로 DynamoDB 테이블에 둔다.
+// 테이블: 파티션 키 user_hash(S), 속성 lang(S, 읽기 언어) | bilingual(BOOL, 이중 언어 사용자 표시)
+// 사용자 ID는 해시로만 저장하며, 테이블을 설정하지 않으면 설정 명령은 쓸 수 없다는 안내만 한다.
+
+// 해시한 사용자 ID의 설정
+type userPref struct {
+	Lang      string `dynamodbav:"lang,omitempty"`
+	Bilingual bool   `dynamodbav:"bilingual,omitempty"`
+}
+
+type userPrefStore interface {
+	// 설정 조회 (없으면 빈 값)
+	Get(ctx context.Context, userHash string) (userPref, error)
+	// 읽기 언어 저장 (lang이 ""이면 삭제)
+	SetLang(ctx context.Context, userHash, lang string) error
+	// 이중 언어 사용자 표시 저장 (false면 삭제)
+	SetBilingual(ctx context.Context, userHash string, on bool) error
+}
+
+func userPrefKey(userID string) string {
+	sum := sha256.Sum256([]byte(userID))
+	return hex.EncodeToString(sum[:16])
+}
+
+// 사용자가 고른 읽기 언어 (없거나 조회에 실패하면 "")
+func (app *App) readerLang(userID string) string {
+	if app.prefs == nil {
+		return ""
+	}
+	pref, err := app.prefs.Get(context.Background(), userPrefKey(userID))
+	if err != nil {
+		log.Printf("[경고] 사용자 설정 조회 실패: %v", err)
+		return ""
+	}
+	return pref.Lang
+}
+
+// 본인이 `/translate-bilingual on`으로 표시했는지 (조회에 실패하면 false)
+func (app *App) markedBilingual(userID string) bool {
+	if app.prefs == nil {
+		return false
+	}
+	pref, err := app.prefs.Get(context.Background(), userPrefKey(userID))
+	if err != nil {
+		log.Printf("[경고] 사용자 설정 조회 실패: %v", err)
+		return false
+	}
+	return pref.Bilingual
+}
+
+// ─────────────────────────────────────
+// DynamoDB 구현
+
+type dynamoPrefStore struct {
+	client *dynamodb.Client
+	table  string
+}
+
+func (s dynamoPrefStore) Get(ctx context.Context, userHash string) (userPref, error) {
+	var pref userPref
+	out, err := s.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(s.table),
+		Key:            s.key(userHash),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return pref, err
+	}
+	err = attributevalue.UnmarshalMap(out.Item, &pref)
+	return pref, err
+}
+
+func (s dynamoPrefStore) SetLang(ctx context.Context, userHash, lang string) error {
+	if lang == "" {
+		return s.update(ctx, userHash, "lang", nil)
+	}
+	return s.update(ctx, userHash, "lang", &types.AttributeValueMemberS{Value: lang})
+}
+
+func (s dynamoPrefStore) SetBilingual(ctx context.Context, userHash string, on bool) error {
+	if !on {
+		return s.update(ctx, userHash, "bilingual", nil)
+	}
+	return s.update(ctx, userHash, "bilingual", &types.AttributeValueMemberBOOL{Value: true})
+}
+
+func (s dynamoPrefStore) key(userHash string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{"user_hash": &types.AttributeValueMemberS{Value: userHash}}
+}
+
+// 속성 하나만 고침 (value가 nil이면 삭제), 다른 설정은 그대로 둔다
+func (s dynamoPrefStore) update(ctx context.Context, userHash, attr string, value types.AttributeValue) error {
+	in := &dynamodb.UpdateItemInput{
+		TableName:                aws.String(s.table),
+		Key:                      s.key(userHash),
+		UpdateExpression:         aws.String("REMOVE #a"),
+		ExpressionAttributeNames: map[string]string{"#a": attr},
+	}
+	if value != nil {
+		in.UpdateExpression = aws.String("SET #a = :v")
+		in.ExpressionAttributeValues = map[string]types.AttributeValue{":v": value}
+	}
+	_, err := s.client.UpdateItem(ctx, in)
+	return err
+}
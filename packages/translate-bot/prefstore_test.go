@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+)
+
+// 메모리에 항목을 두는 DynamoDB JSON API 서버 (GetItem, UpdateItem의 SET/REMOVE 한 속성만)
+type fakePrefTable struct {
+	mu    sync.Mutex
+	items map[string]map[string]json.RawMessage // user_hash → 속성
+}
+
+func newFakePrefStore(t *testing.T) (*fakePrefTable, dynamoPrefStore) {
+	t.Helper()
+	ft := &fakePrefTable{items: map[string]map[string]json.RawMessage{}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		op := strings.TrimPrefix(r.Header.Get("X-Amz-Target"), "DynamoDB_20120810.")
+		var in struct {
+			Key                       map[string]struct{ S string }
+			UpdateExpression          string
+			ExpressionAttributeNames  map[string]string
+			ExpressionAttributeValues map[string]json.RawMessage
+		}
+		json.Unmarshal(raw, &in)
+		hash := in.Key["user_hash"].S
+
+		ft.mu.Lock()
+		defer ft.mu.Unlock()
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		switch op {
+		case "GetItem":
+			out := map[string]interface{}{}
+			if item, ok := ft.items[hash]; ok {
+				out["Item"] = item
+			}
+			json.NewEncoder(w).Encode(out)
+		case "UpdateItem":
+			item := ft.items[hash]
+			if item == nil {
+				item = map[string]json.RawMessage{"user_hash": json.RawMessage(`{"S":"` + hash + `"}`)}
+				ft.items[hash] = item
+			}
+			attr := in.ExpressionAttributeNames["#a"]
+			if strings.HasPrefix(in.UpdateExpression, "REMOVE") {
+				delete(item, attr)
+			} else {
+				item[attr] = in.ExpressionAttributeValues[":v"]
+			}
+			io.WriteString(w, `{}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			io.WriteString(w, `{"__type":"UnknownOperationException","message":"`+op+`"}`)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client := dynamodb.NewFromConfig(aws.Config{
+		Region:           "ap-northeast-2",
+		RetryMaxAttempts: 1,
+		Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "AKIDTEST", SecretAccessKey: "secret"}, nil
+		}),
+	}, func(o *dynamodb.Options) {
+		o.BaseEndpoint = aws.String(srv.URL)
+	})
+	return ft, dynamoPrefStore{client: client, table: "prefs"}
+}
+
+func TestDynamoPrefStore(t *testing.T) {
+	ft, store := newFakePrefStore(t)
+	ctx := context.Background()
+
+	if pref, err := store.Get(ctx, "h1"); err != nil || pref.Lang != "" {
+		t.Errorf("설정 전 Get = %+v, %v", pref, err)
+	}
+	if err := store.SetLang(ctx, "h1", "en"); err != nil {
+		t.Fatalf("SetLang: %v", err)
+	}
+	if pref, err := store.Get(ctx, "h1"); err != nil || pref.Lang != "en" {
+		t.Errorf("설정 후 Get = %+v, %v", pref, err)
+	}
+	if err := store.SetLang(ctx, "h1", ""); err != nil {
+		t.Fatalf("SetLang(삭제): %v", err)
+	}
+	if pref, _ := store.Get(ctx, "h1"); pref.Lang != "" {
+		t.Errorf("삭제 후 Lang = %q", pref.Lang)
+	}
+	if _, ok := ft.items["h1"]["lang"]; ok {
+		t.Error("삭제한 lang 속성이 남아 있음")
+	}
+}
+
+// 다른 인스턴스(같은 테이블을 보는 다른 App)에서 고른 언어도 보인다
+func TestReaderLangSharedAcrossInstances(t *testing.T) {
+	_, store := newFakePrefStore(t)
+	first := &App{cfg: &Config{}, prefs: store}
+	second := &App{cfg: &Config{}, prefs: store}
+
+	first.setReaderLang("U1", "ja")
+	if got := second.readerLang("U1"); got != "ja" {
+		t.Errorf("다른 인스턴스의 읽기 언어 = %q, want ja", got)
+	}
+}
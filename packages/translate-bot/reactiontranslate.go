@@ -0,0 +1,76 @@
+package main
+
+import (
+	"log"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+)
+
+// ─────────────────────────────────────
+// 반응으로 번역 보기 (TRANSLATE_REACTION_EMOJI, opt-in)
+// 메시지에 지정한 이모지(예: :globe_with_meridians:) 반응을 달면 메시지 단축키와 같은 방식으로 번역해
+// 반응을 단 사람에게만(ephemeral) 보여준다.
+// 짧은 문구(QUICK_PHRASE_MAX_CHARS 이하)는 원문과 번역을 두 칸으로 나란히 보여줘 한눈에 비교할 수 있게 한다.
+
+const defaultQuickPhraseMaxChars = 100
+
+func (app *App) translateReactionEmoji() string {
+	return strings.Trim(strings.TrimSpace(app.cfg.TranslateReactionEmoji), ":")
+}
+
+func (app *App) quickPhraseMaxChars() int {
+	if app.cfg.QuickPhraseMaxChars > 0 {
+		return app.cfg.QuickPhraseMaxChars
+	}
+	return defaultQuickPhraseMaxChars
+}
+
+// 짧은 문구인지 (앞뒤 공백 제외 글자 수 기준)
+func (app *App) isQuickPhrase(source string) bool {
+	return utf8.RuneCountInString(strings.TrimSpace(source)) <= app.quickPhraseMaxChars()
+}
+
+// 원문과 번역을 나란히 보여주는 블록 (섹션 필드 두 칸)
+func (app *App) sideBySideBlocks(source, translation, lang string) []slack.Block {
+	return []slack.Block{
+		slack.NewSectionBlock(nil, []*slack.TextBlockObject{
+			slack.NewTextBlockObject("mrkdwn", "*"+app.botText(msgOriginalLabel, lang)+"*\n"+source, false, false),
+			slack.NewTextBlockObject("mrkdwn", "*"+app.botText(msgTranslationLabel, lang)+"*\n"+translation, false, false),
+		}, nil),
+	}
+}
+
+// 번역 이모지 반응 처리
+func (app *App) processTranslateReaction(ev *slackevents.ReactionAddedEvent) error {
+	// 봇 메시지(번역 결과 등)와 봇 자신의 반응은 무시
+	if ev.Item.Type != "message" || ev.ItemUser == app.botUserID || ev.User == app.botUserID {
+		return nil
+	}
+
+	channel := ev.Item.Channel
+	msg, err := app.fetchMessage(channel, ev.Item.Timestamp)
+	if err != nil {
+		return err
+	}
+
+	source, text, lang, notice, err := app.translateForReader(channel, ev.User, *msg)
+	if err != nil {
+		return err
+	}
+	if notice != "" {
+		return app.replyEphemeral(channel, ev.User, *msg, slack.MsgOptionText(notice, false))
+	}
+
+	if app.isQuickPhrase(source) {
+		log.Printf("[성공] 반응 번역 (나란히 보기, channel=%s, ts=%s)", channel, msg.Timestamp)
+		return app.replyEphemeral(channel, ev.User, *msg,
+			slack.MsgOptionText("🌐 "+text, false),
+			slack.MsgOptionBlocks(app.sideBySideBlocks(source, text, lang)...),
+		)
+	}
+	log.Printf("[성공] 반응 번역 (channel=%s, ts=%s)", channel, msg.Timestamp)
+	return app.replyEphemeral(channel, ev.User, *msg, slack.MsgOptionText(app.botText(msgTranslationLabel, lang)+"\n"+text, false))
+}
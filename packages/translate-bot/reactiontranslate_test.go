@@ -0,0 +1,105 @@
+package main
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+func TestProcessTranslateReaction(t *testing.T) {
+	long := "明日のリリースは午後三時からです。" + strings.Repeat("変更点はチャンネルの固定メッセージを確認してください。", 6)
+	tests := []struct {
+		name         string
+		text         string
+		wantText     string
+		wantSideBy   bool
+		maxChars     int
+		reactionUser string
+	}{
+		{"short phrase side by side", "了解です", "🌐 [번역]了解です", true, 0, "U2"},
+		{"long message plain", long, "🌐 번역\n[번역]" + long, false, 0, "U2"},
+		{"custom limit", "明日のリリースどうしますか", "🌐 번역\n[번역]明日のリリースどうしますか", false, 5, "U2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			fs.responses["users.info"] = `{"ok":true,"user":{"id":"U2","locale":"ko-KR"}}`
+			fs.respond = func(method string, form url.Values) string {
+				if method != "conversations.replies" {
+					return ""
+				}
+				return `{"ok":true,"messages":[{"type":"message","user":"U1","text":"` + tt.text + `","ts":"1.0"}]}`
+			}
+			app := &App{
+				cfg:       &Config{TranslateReactionEmoji: ":globe_with_meridians:", QuickPhraseMaxChars: tt.maxChars},
+				slack:     client,
+				botUserID: "UBOT",
+				translate: fakeTranslate("[번역]"),
+			}
+
+			err := app.processReaction(&slackevents.ReactionAddedEvent{
+				Reaction: "globe_with_meridians",
+				User:     tt.reactionUser,
+				ItemUser: "U1",
+				Item:     slackevents.Item{Type: "message", Channel: "C1", Timestamp: "1.0"},
+			})
+			if err != nil {
+				t.Fatalf("processReaction: %v", err)
+			}
+
+			if n := len(fs.callsTo("chat.postMessage")); n != 0 {
+				t.Errorf("채널에 게시함 (%d회), ephemeral만 보내야 함", n)
+			}
+			eph := fs.callsTo("chat.postEphemeral")
+			if len(eph) != 1 {
+				t.Fatalf("chat.postEphemeral 호출 수 = %d, want 1", len(eph))
+			}
+			form := eph[0].Form
+			if form.Get("user") != "U2" {
+				t.Errorf("user = %q, want U2", form.Get("user"))
+			}
+			if got := form.Get("text"); got != tt.wantText {
+				t.Errorf("text = %q, want %q", got, tt.wantText)
+			}
+			blocks := form.Get("blocks")
+			if !tt.wantSideBy {
+				if blocks != "" {
+					t.Errorf("긴 메시지에 나란히 보기 블록: %s", blocks)
+				}
+				return
+			}
+			if !strings.Contains(blocks, `"fields"`) || !strings.Contains(blocks, "*원문*\\n"+tt.text) || !strings.Contains(blocks, "*🌐 번역*\\n[번역]"+tt.text) {
+				t.Errorf("나란히 보기 블록 = %s", blocks)
+			}
+		})
+	}
+}
+
+func TestProcessTranslateReactionIgnored(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *Config
+		ev   *slackevents.ReactionAddedEvent
+	}{
+		{"disabled", &Config{}, &slackevents.ReactionAddedEvent{Reaction: "globe_with_meridians", User: "U2", ItemUser: "U1"}},
+		{"other emoji", &Config{TranslateReactionEmoji: "globe_with_meridians"}, &slackevents.ReactionAddedEvent{Reaction: "eyes", User: "U2", ItemUser: "U1"}},
+		{"bot message", &Config{TranslateReactionEmoji: "globe_with_meridians"}, &slackevents.ReactionAddedEvent{Reaction: "globe_with_meridians", User: "U2", ItemUser: "UBOT"}},
+		{"bot reaction", &Config{TranslateReactionEmoji: "globe_with_meridians"}, &slackevents.ReactionAddedEvent{Reaction: "globe_with_meridians", User: "UBOT", ItemUser: "U1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: tt.cfg, slack: client, botUserID: "UBOT", translate: fakeTranslate("[번역]")}
+			tt.ev.Item = slackevents.Item{Type: "message", Channel: "C1", Timestamp: "1.0"}
+
+			if err := app.processReaction(tt.ev); err != nil {
+				t.Fatalf("processReaction: %v", err)
+			}
+			if len(fs.calls) != 0 {
+				t.Errorf("Slack API 호출됨: %v", fs.calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+)
+
+// ─────────────────────────────────────
+// 🔁 재번역
+// 봇의 번역 메시지에 🔁(:repeat:) 반응을 달면 원문을 다른 모델(RETRANSLATE_MODEL)로 다시 번역해 메시지를 수정한다.
+// 번역 메시지의 메타데이터에 원문 ts와 대상 언어를 기록해두고, 재번역 시 이를 따라가 원문을 조회한다.
+// reaction_added 이벤트 구독이 필요하다.
+
+const (
+	retranslateEmoji        = "repeat"
+	translationMetadataType = "translation"
+)
+
+func translationMetadata(sourceTS, lang string) slack.SlackMetadata {
+	return slack.SlackMetadata{
+		EventType: translationMetadataType,
+		EventPayload: map[string]interface{}{
+			"source_ts":   sourceTS,
+			"target_lang": lang,
+		},
+	}
+}
+
+func (app *App) retranslateModel() string {
+	if app.cfg.RetranslateModel != "" {
+		return app.cfg.RetranslateModel
+	}
+	return fallbackTranslateModel
+}
+
+// 채널/스레드 어디에 있든 ts로 메시지 한 건 조회
+func (app *App) fetchMessage(channel, ts string) (*slack.Message, error) {
+	msgs, _, _, err := app.slack.GetConversationReplies(&slack.GetConversationRepliesParameters{
+		ChannelID:          channel,
+		Timestamp:          ts,
+		Oldest:             ts,
+		Latest:             ts,
+		Inclusive:          true,
+		IncludeAllMetadata: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range msgs {
+		if msgs[i].Timestamp == ts {
+			return &msgs[i], nil
+		}
+	}
+	return nil, fmt.Errorf("메시지를 찾을 수 없음 (channel=%s, ts=%s)", channel, ts)
+}
+
+// reaction_added 이벤트 처리
+func (app *App) processReaction(ev *slackevents.ReactionAddedEvent) error {
+	if emoji := app.translateReactionEmoji(); emoji != "" && ev.Reaction == emoji {
+		return app.processTranslateReaction(ev)
+	}
+	if ev.Reaction != retranslateEmoji || ev.Item.Type != "message" {
+		return nil
+	}
+	// 봇의 번역 메시지에 단 반응만 처리 (봇 자신의 반응은 무시)
+	if ev.ItemUser != app.botUserID || ev.User == app.botUserID {
+		return nil
+	}
+
+	channel, ts := ev.Item.Channel, ev.Item.Timestamp
+	translation, err := app.fetchMessage(channel, ts)
+	if err != nil {
+		return err
+	}
+	if translation.Metadata.EventType != translationMetadataType {
+		log.Printf("[스킵] 번역 메시지가 아님 (channel=%s, ts=%s)", channel, ts)
+		return nil
+	}
+	sourceTS, _ := translation.Metadata.EventPayload["source_ts"].(string)
+	lang, _ := translation.Metadata.EventPayload["target_lang"].(string)
+	if sourceTS == "" || lang == "" {
+		return fmt.Errorf("번역 메타데이터 누락 (channel=%s, ts=%s)", channel, ts)
+	}
+
+	source, err := app.fetchMessage(channel, sourceTS)
+	if err != nil {
+		return err
+	}
+
+	// 처음 번역할 때와 같은 방식으로 원문을 다시 번역
+	var text string
+	if segs := richTextSegments(source.Blocks); segs != nil {
+		text, err = app.translateRichTextWith(app.retranslate, segs, lang)
+	} else {
+		// !tt 재개 명령과 함께 쓴 메시지는 명령어를 빼고 번역했으므로 동일하게 처리
+		sourceText := strings.TrimSpace(strings.ReplaceAll(source.Text, "!tt", ""))
+		text, err = app.translateTextWith(app.retranslate, sourceText, lang)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, _, _, err = app.slack.UpdateMessage(
+		channel,
+		ts,
+		slack.MsgOptionText(text, false),
+		slack.MsgOptionMetadata(translationMetadata(sourceTS, lang)),
+	)
+	if err != nil {
+		return err
+	}
+	log.Printf("[성공] 재번역 완료 (channel=%s, ts=%s, model=%s)", channel, ts, app.retranslateModel())
+	return nil
+}
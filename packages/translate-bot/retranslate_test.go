@@ -0,0 +1,87 @@
+package main
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+// 원문(부모 메시지)과 봇의 번역(스레드 답글)이 있는 스레드를 흉내내는 conversations.replies 응답
+func threadReplies(method string, form url.Values) string {
+	if method != "conversations.replies" {
+		return ""
+	}
+	switch form.Get("oldest") {
+	case "1.0":
+		return `{"ok":true,"messages":[{"type":"message","user":"U1","text":"明日は雨です","ts":"1.0"}]}`
+	case "1.5":
+		return `{"ok":true,"messages":[
+			{"type":"message","user":"U1","text":"明日は雨です","ts":"1.0"},
+			{"type":"message","user":"UBOT","bot_id":"B1","text":"내일은 비입니다","ts":"1.5","thread_ts":"1.0",
+			 "metadata":{"event_type":"translation","event_payload":{"source_ts":"1.0","target_lang":"ko"}}}
+		]}`
+	}
+	return ""
+}
+
+func TestProcessReactionRetranslates(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	fs.respond = threadReplies
+
+	app := &App{cfg: &Config{}, slack: client, botUserID: "UBOT"}
+	app.translate = fakeTranslate("[기본]")
+	app.retranslate = fakeTranslate("[재번역]")
+
+	err := app.processReaction(&slackevents.ReactionAddedEvent{
+		Reaction: "repeat",
+		User:     "U2",
+		ItemUser: "UBOT",
+		Item:     slackevents.Item{Type: "message", Channel: "C1", Timestamp: "1.5"},
+	})
+	if err != nil {
+		t.Fatalf("processReaction: %v", err)
+	}
+
+	updates := fs.callsTo("chat.update")
+	if len(updates) != 1 {
+		t.Fatalf("chat.update 호출 수 = %d, want 1", len(updates))
+	}
+	form := updates[0].Form
+	if form.Get("ts") != "1.5" {
+		t.Errorf("ts = %q, want 1.5", form.Get("ts"))
+	}
+	if got := form.Get("text"); got != "[재번역]明日は雨です" {
+		t.Errorf("text = %q", got)
+	}
+	if !strings.Contains(form.Get("metadata"), `"source_ts":"1.0"`) {
+		t.Errorf("재번역 후 메타데이터 유지 안 됨: %s", form.Get("metadata"))
+	}
+}
+
+func TestProcessReactionIgnoresOthers(t *testing.T) {
+	tests := []struct {
+		name string
+		ev   slackevents.ReactionAddedEvent
+	}{
+		{"other_emoji", slackevents.ReactionAddedEvent{Reaction: "thumbsup", User: "U2", ItemUser: "UBOT", Item: slackevents.Item{Type: "message", Channel: "C1", Timestamp: "1.5"}}},
+		{"not_bot_message", slackevents.ReactionAddedEvent{Reaction: "repeat", User: "U2", ItemUser: "U1", Item: slackevents.Item{Type: "message", Channel: "C1", Timestamp: "1.0"}}},
+		{"bot_own_reaction", slackevents.ReactionAddedEvent{Reaction: "repeat", User: "UBOT", ItemUser: "UBOT", Item: slackevents.Item{Type: "message", Channel: "C1", Timestamp: "1.5"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			fs.respond = threadReplies
+			app := &App{cfg: &Config{}, slack: client, botUserID: "UBOT", retranslate: fakeTranslate("[재번역]")}
+
+			if err := app.processReaction(&tt.ev); err != nil {
+				t.Fatalf("processReaction: %v", err)
+			}
+			if n := len(fs.calls); n != 0 {
+				t.Errorf("Slack API 호출 수 = %d, want 0", n)
+			}
+		})
+	}
+}
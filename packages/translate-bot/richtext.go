@@ -0,0 +1,151 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 리치 텍스트 인용/코드 블록 구분 번역
+// 리치 텍스트 편집기로 작성한 메시지에 인용(rich_text_quote)이나 코드 블록(rich_text_preformatted)이 있으면
+// 블록 구조를 따라 본문/인용은 번역하고 코드 블록은 원문 그대로 둔 채 다시 조립한다.
+
+type richTextKind int
+
+const (
+	richTextPlain richTextKind = iota
+	richTextQuote
+	richTextCode
+)
+
+type richTextSegment struct {
+	Kind richTextKind
+	Text string
+}
+
+// 섹션 요소를 Slack mrkdwn 텍스트로 변환 (멘션/링크/이모지는 번역 API가 건드리지 않는 형태 유지)
+func richTextSectionText(elements []slack.RichTextSectionElement) string {
+	var sb strings.Builder
+	for _, el := range elements {
+		switch e := el.(type) {
+		case *slack.RichTextSectionTextElement:
+			sb.WriteString(e.Text)
+		case *slack.RichTextSectionLinkElement:
+			if e.Text != "" {
+				sb.WriteString("<" + e.URL + "|" + e.Text + ">")
+			} else {
+				sb.WriteString("<" + e.URL + ">")
+			}
+		case *slack.RichTextSectionUserElement:
+			sb.WriteString("<@" + e.UserID + ">")
+		case *slack.RichTextSectionChannelElement:
+			sb.WriteString("<#" + e.ChannelID + ">")
+		case *slack.RichTextSectionUserGroupElement:
+			sb.WriteString("<!subteam^" + e.UsergroupID + ">")
+		case *slack.RichTextSectionBroadcastElement:
+			sb.WriteString("<!" + e.Range + ">")
+		case *slack.RichTextSectionEmojiElement:
+			sb.WriteString(":" + e.Name + ":")
+		case *slack.RichTextSectionDateElement:
+			if e.Fallback != nil {
+				sb.WriteString(*e.Fallback)
+			}
+		}
+	}
+	return sb.String()
+}
+
+// 메시지 블록에서 인용/코드 블록을 구분한 세그먼트 목록을 만든다.
+// 인용이나 코드 블록이 하나도 없으면 nil (기존처럼 ev.Text를 그대로 번역)
+func richTextSegments(blocks slack.Blocks) []richTextSegment {
+	var segs []richTextSegment
+	special := false
+
+	add := func(kind richTextKind, text string) {
+		if strings.TrimSpace(text) == "" {
+			return
+		}
+		// 연속된 일반 텍스트는 하나로 합쳐 번역 호출 수를 줄인다
+		if kind == richTextPlain && len(segs) > 0 && segs[len(segs)-1].Kind == richTextPlain {
+			segs[len(segs)-1].Text += "\n" + strings.TrimRight(text, "\n")
+			return
+		}
+		segs = append(segs, richTextSegment{Kind: kind, Text: strings.TrimRight(text, "\n")})
+	}
+
+	for _, block := range blocks.BlockSet {
+		rt, ok := block.(*slack.RichTextBlock)
+		if !ok {
+			continue
+		}
+		for _, el := range rt.Elements {
+			switch e := el.(type) {
+			case *slack.RichTextSection:
+				add(richTextPlain, richTextSectionText(e.Elements))
+			case *slack.RichTextQuote:
+				special = true
+				add(richTextQuote, richTextSectionText(e.Elements))
+			case *slack.RichTextPreformatted:
+				special = true
+				add(richTextCode, richTextSectionText(e.Elements))
+			case *slack.RichTextList:
+				var lines []string
+				for _, item := range e.Elements {
+					if s, ok := item.(*slack.RichTextSection); ok {
+						lines = append(lines, strings.Repeat("  ", e.Indent)+"• "+richTextSectionText(s.Elements))
+					}
+				}
+				add(richTextPlain, strings.Join(lines, "\n"))
+			}
+		}
+	}
+
+	if !special {
+		return nil
+	}
+	return segs
+}
+
+// 번역 대상(코드 블록 제외) 텍스트 (언어 판별용)
+func richTextTranslatable(segs []richTextSegment) string {
+	var parts []string
+	for _, s := range segs {
+		if s.Kind != richTextCode {
+			parts = append(parts, s.Text)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// 세그먼트별 번역 후 인용은 "> ", 코드는 ``` 로 감싸 다시 조립
+func (app *App) translateRichText(segs []richTextSegment, lang string) (string, error) {
+	return app.translateRichTextWith(app.translate, segs, lang)
+}
+
+func (app *App) translateRichTextWith(translate func([]string, string) ([]string, error), segs []richTextSegment, lang string) (string, error) {
+	parts := make([]string, 0, len(segs))
+	for _, s := range segs {
+		switch s.Kind {
+		case richTextCode:
+			parts = append(parts, "```\n"+s.Text+"\n```")
+		case richTextQuote:
+			translated, err := app.translateTextWith(translate, s.Text, lang)
+			if err != nil {
+				return "", err
+			}
+			lines := strings.Split(translated, "\n")
+			for i, line := range lines {
+				lines[i] = "> " + line
+			}
+			parts = append(parts, strings.Join(lines, "\n"))
+		default:
+			translated, err := app.translateTextWith(translate, s.Text, lang)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, translated)
+		}
+	}
+	return strings.Join(parts, "\n"), nil
+}
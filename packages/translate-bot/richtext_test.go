@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+// 리치 텍스트 편집기로 작성한 "본문 + 인용 + 코드 블록" 메시지
+const richTextMessageEvent = `{
+	"type": "message",
+	"channel": "C1",
+	"user": "U1",
+	"ts": "1700000000.000300",
+	"text": "이 에러 보셨나요?\n&gt; 어제 배포 후 발생\n` + "```" + `// 재시도 필요\nretry(3)` + "```" + `",
+	"blocks": [{
+		"type": "rich_text",
+		"block_id": "b1",
+		"elements": [
+			{"type": "rich_text_section", "elements": [
+				{"type": "user", "user_id": "U2"},
+				{"type": "text", "text": " 이 에러 보셨나요?\n"}
+			]},
+			{"type": "rich_text_quote", "elements": [
+				{"type": "text", "text": "어제 배포 후 발생\n두 번째 줄"}
+			]},
+			{"type": "rich_text_preformatted", "border": 0, "elements": [
+				{"type": "text", "text": "// 재시도 필요\nretry(3)"}
+			]}
+		]
+	}]
+}`
+
+func TestRichTextSegments(t *testing.T) {
+	var ev slackevents.MessageEvent
+	if err := json.Unmarshal([]byte(richTextMessageEvent), &ev); err != nil {
+		t.Fatalf("이벤트 파싱 실패: %v", err)
+	}
+
+	segs := richTextSegments(ev.Blocks)
+	want := []richTextSegment{
+		{richTextPlain, "<@U2> 이 에러 보셨나요?"},
+		{richTextQuote, "어제 배포 후 발생\n두 번째 줄"},
+		{richTextCode, "// 재시도 필요\nretry(3)"},
+	}
+	if len(segs) != len(want) {
+		t.Fatalf("세그먼트 수 = %d, want %d (%+v)", len(segs), len(want), segs)
+	}
+	for i := range want {
+		if segs[i] != want[i] {
+			t.Errorf("segs[%d] = %+v, want %+v", i, segs[i], want[i])
+		}
+	}
+}
+
+func TestRichTextSegmentsPlainOnly(t *testing.T) {
+	var ev slackevents.MessageEvent
+	raw := `{"blocks":[{"type":"rich_text","elements":[{"type":"rich_text_section","elements":[{"type":"text","text":"안녕하세요"}]}]}]}`
+	if err := json.Unmarshal([]byte(raw), &ev); err != nil {
+		t.Fatal(err)
+	}
+	if segs := richTextSegments(ev.Blocks); segs != nil {
+		t.Errorf("인용/코드 없는 메시지는 nil이어야 함: %+v", segs)
+	}
+}
+
+func TestProcessMessageRichTextQuoteAndCode(t *testing.T) {
+	var ev slackevents.MessageEvent
+	if err := json.Unmarshal([]byte(richTextMessageEvent), &ev); err != nil {
+		t.Fatalf("이벤트 파싱 실패: %v", err)
+	}
+
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{}, slack: client, translate: fakeTranslate("[ja]")}
+
+	if err := app.processMessage(&ev); err != nil {
+		t.Fatalf("processMessage: %v", err)
+	}
+
+	posts := fs.callsTo("chat.postMessage")
+	if len(posts) != 1 {
+		t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+	}
+	want := "[ja]<@U2> 이 에러 보셨나요?\n" +
+		"> [ja]어제 배포 후 발생\n> 두 번째 줄\n" +
+		"```\n// 재시도 필요\nretry(3)\n```"
+	if got := posts[0].Form.Get("text"); got != want {
+		t.Errorf("text =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"log"
+	"regexp"
+	"strings"
+)
+
+// ─────────────────────────────────────
+// 서명/구분선 이후 잘라내기 (TRIM_SIGNATURES, opt-in)
+// `---` 구분선이나 메일 서명(`-- `) 아래는 번역하지 않고 원문 그대로 뒤에 다시 붙인다.
+// 줄 단위로 검사하며 SIGNATURE_PATTERNS로 패턴(정규식)을 바꿀 수 있다.
+
+var defaultSignaturePatterns = []string{
+	`^-{3,}\s*$`, // --- 구분선
+	`^--\s*$`,    // 메일 서명 구분자 ("-- ")
+	`^_{3,}\s*$`, // ___ 구분선
+}
+
+// 잘못된 정규식은 경고만 남기고 건너뛴다
+func compileSignaturePatterns(patterns []string) []*regexp.Regexp {
+	if len(patterns) == 0 {
+		patterns = defaultSignaturePatterns
+	}
+	var out []*regexp.Regexp
+	for _, p := range patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			log.Printf("[경고] 잘못된 SIGNATURE_PATTERNS 항목 무시 (%q): %v", p, err)
+			continue
+		}
+		out = append(out, re)
+	}
+	return out
+}
+
+// 처음으로 패턴에 맞는 줄부터 끝까지를 서명으로 분리한다.
+// 앞쪽 본문이 비어있으면(메시지 전체가 서명) 분리하지 않는다.
+func splitSignature(text string, patterns []*regexp.Regexp) (body, signature string) {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if i == 0 {
+			continue
+		}
+		for _, re := range patterns {
+			if !re.MatchString(line) {
+				continue
+			}
+			body = strings.Join(lines[:i], "\n")
+			if strings.TrimSpace(body) == "" {
+				return text, ""
+			}
+			trimmed := strings.TrimRight(body, " \t\n")
+			return trimmed, body[len(trimmed):] + "\n" + strings.Join(lines[i:], "\n")
+		}
+	}
+	return text, ""
+}
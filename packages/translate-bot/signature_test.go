@@ -0,0 +1,70 @@
+package main
+
+import "testing"
+
+func TestSplitSignature(t *testing.T) {
+	patterns := compileSignaturePatterns(nil)
+	tests := []struct {
+		name          string
+		input         string
+		wantBody      string
+		wantSignature string
+	}{
+		{
+			name:          "dash_separator",
+			input:         "내일 회의는 10시입니다\n---\n김철수 | 개발팀\n010-1234-5678",
+			wantBody:      "내일 회의는 10시입니다",
+			wantSignature: "\n---\n김철수 | 개발팀\n010-1234-5678",
+		},
+		{
+			name:          "email_signature_with_blank_line",
+			input:         "よろしくお願いします\n\n-- \n田中太郎\n営業部",
+			wantBody:      "よろしくお願いします",
+			wantSignature: "\n\n-- \n田中太郎\n営業部",
+		},
+		{
+			name:     "no_separator",
+			input:    "첫 줄\n둘째 줄",
+			wantBody: "첫 줄\n둘째 줄",
+		},
+		{
+			name:     "separator_only_at_top",
+			input:    "---\n서명만 있음",
+			wantBody: "---\n서명만 있음",
+		},
+		{
+			name:     "inline_dashes_not_separator",
+			input:    "A---B 구간 확인\n끝",
+			wantBody: "A---B 구간 확인\n끝",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, sig := splitSignature(tt.input, patterns)
+			if body != tt.wantBody || sig != tt.wantSignature {
+				t.Errorf("splitSignature(%q) = (%q, %q), want (%q, %q)", tt.input, body, sig, tt.wantBody, tt.wantSignature)
+			}
+		})
+	}
+}
+
+func TestCompileSignaturePatternsSkipsInvalid(t *testing.T) {
+	got := compileSignaturePatterns([]string{`^==+$`, `([`})
+	if len(got) != 1 {
+		t.Errorf("패턴 수 = %d, want 1", len(got))
+	}
+}
+
+func TestTranslateTextKeepsSignatureUntranslated(t *testing.T) {
+	app := &App{cfg: &Config{TrimSignatures: true}, translate: fakeTranslate("[ja]")}
+
+	got, err := app.translateText("내일 회의는 10시입니다\n---\n김철수 | 개발팀", "ja")
+	if err != nil {
+		t.Fatalf("translateText: %v", err)
+	}
+	want := "[ja]내일 회의는 10시입니다\n---\n김철수 | 개발팀"
+	if got != want {
+		t.Errorf("translateText = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"log"
+	"strings"
+
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+)
+
+// ─────────────────────────────────────
+// 채널 시스템 메시지 번역 (TRANSLATE_SYSTEM_MESSAGES, opt-in)
+// 참여/나감, 주제·설명 변경 같은 시스템 메시지는 기본적으로 번역하지 않는다.
+// 켜면 주제(channel_topic)와 설명(channel_purpose) 변경 메시지에서 사람이 쓴 새 내용만 떼어내 번역하고,
+// "📌 채널 주제 변경: ..." 형태의 안내를 스레드에 게시한다. 참여/나감처럼 번역할 내용이 없는 메시지는 계속 건너뛴다.
+
+const (
+	subtypeChannelTopic   = "channel_topic"
+	subtypeChannelPurpose = "channel_purpose"
+)
+
+var systemSubtypes = map[string]bool{
+	"channel_join":        true,
+	"channel_leave":       true,
+	"channel_name":        true,
+	"channel_archive":     true,
+	"channel_unarchive":   true,
+	subtypeChannelTopic:   true,
+	subtypeChannelPurpose: true,
+}
+
+// Slack이 만드는 시스템 메시지 본문의 고정 부분 ("<@U123> set the channel topic: 새 주제")
+var systemTextMarkers = map[string]string{
+	subtypeChannelTopic:   "set the channel topic:",
+	subtypeChannelPurpose: "set the channel purpose:",
+}
+
+// 번역 언어별 안내 머리말
+var systemNoteLabels = map[string]map[string]string{
+	subtypeChannelTopic:   {"ko": "📌 채널 주제 변경", "ja": "📌 チャンネルトピック変更", "en": "📌 Channel topic changed"},
+	subtypeChannelPurpose: {"ko": "📌 채널 설명 변경", "ja": "📌 チャンネル説明変更", "en": "📌 Channel purpose changed"},
+}
+
+func isSystemMessage(ev *slackevents.MessageEvent) bool {
+	return systemSubtypes[ev.SubType]
+}
+
+// 시스템 메시지에서 번역할 사람이 쓴 부분 (없으면 "")
+func systemMessageContent(subtype, text string) string {
+	marker, ok := systemTextMarkers[subtype]
+	if !ok {
+		return ""
+	}
+	i := strings.Index(text, marker)
+	if i < 0 {
+		return ""
+	}
+	return strings.TrimSpace(text[i+len(marker):])
+}
+
+func systemNoteLabel(subtype, lang string) string {
+	labels := systemNoteLabels[subtype]
+	if label, ok := labels[lang]; ok {
+		return label
+	}
+	return labels["en"]
+}
+
+// 시스템 메시지 처리 (설정이 꺼져 있거나 번역할 내용이 없으면 건너뜀)
+func (app *App) processSystemMessage(ev *slackevents.MessageEvent) error {
+	content := systemMessageContent(ev.SubType, ev.Text)
+	if !app.cfg.TranslateSystemMessages || content == "" {
+		log.Printf("[스킵] 시스템 메시지 (channel=%s, subtype=%s)", ev.Channel, ev.SubType)
+		return nil
+	}
+
+	lang := app.targetLang(ev.Channel, content)
+	if lang == "" {
+		log.Printf("[스킵] 번역 불필요 (channel=%s, ts=%s)", ev.Channel, ev.TimeStamp)
+		return nil
+	}
+	translated, err := app.translateTextWith(app.translatorFor(ev.Channel), content, lang)
+	if err != nil {
+		return err
+	}
+
+	text := systemNoteLabel(ev.SubType, lang) + ": " + translated
+	_, _, err = app.slack.PostMessage(ev.Channel, slack.MsgOptionText(text, false), slack.MsgOptionTS(ev.TimeStamp))
+	if err == nil {
+		log.Printf("[성공] 시스템 메시지 번역 (channel=%s, subtype=%s, lang=%s)", ev.Channel, ev.SubType, lang)
+	}
+	return err
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+func TestSystemMessageContent(t *testing.T) {
+	tests := []struct {
+		subtype string
+		text    string
+		want    string
+	}{
+		{subtypeChannelTopic, "<@U1> set the channel topic: 이번 주 배포 일정 공유", "이번 주 배포 일정 공유"},
+		{subtypeChannelPurpose, "<@U1> set the channel purpose: 한일 협업 채널", "한일 협업 채널"},
+		{subtypeChannelTopic, "<@U1> set the channel topic: ", ""},
+		{"channel_join", "<@U1> has joined the channel", ""},
+	}
+	for _, tt := range tests {
+		if got := systemMessageContent(tt.subtype, tt.text); got != tt.want {
+			t.Errorf("systemMessageContent(%q, %q) = %q, want %q", tt.subtype, tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestProcessMessageSystemMessages(t *testing.T) {
+	tests := []struct {
+		name     string
+		enabled  bool
+		subtype  string
+		text     string
+		wantText string
+	}{
+		{"topic translated when enabled", true, subtypeChannelTopic, "<@U1> set the channel topic: 이번 주 배포 일정 공유", "📌 チャンネルトピック変更: [ja] 이번 주 배포 일정 공유"},
+		{"purpose translated when enabled", true, subtypeChannelPurpose, "<@U1> set the channel purpose: 한일 협업 채널", "📌 チャンネル説明変更: [ja] 한일 협업 채널"},
+		{"topic skipped by default", false, subtypeChannelTopic, "<@U1> set the channel topic: 이번 주 배포 일정 공유", ""},
+		{"join skipped even when enabled", true, "channel_join", "<@U1> has joined the channel", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			app := &App{cfg: &Config{TranslateSystemMessages: tt.enabled}, slack: client, translate: fakeTranslate("[ja] ")}
+
+			ev := &slackevents.MessageEvent{Channel: "C1", User: "U1", SubType: tt.subtype, Text: tt.text, TimeStamp: "1.0"}
+			if err := app.processMessage(ev); err != nil {
+				t.Fatalf("processMessage: %v", err)
+			}
+			posts := fs.callsTo("chat.postMessage")
+			if tt.wantText == "" {
+				if len(posts) != 0 {
+					t.Errorf("시스템 메시지인데 게시됨: %v", posts[0].Form)
+				}
+				return
+			}
+			if len(posts) != 1 {
+				t.Fatalf("chat.postMessage 호출 수 = %d, want 1", len(posts))
+			}
+			if got := posts[0].Form.Get("text"); got != tt.wantText {
+				t.Errorf("text = %q, want %q", got, tt.wantText)
+			}
+			if got := posts[0].Form.Get("thread_ts"); got != "1.0" {
+				t.Errorf("thread_ts = %q, want 1.0", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/slack-go/slack"
+)
+
+// ─────────────────────────────────────
+// 스레드 전체 번역 (메시지 단축키 "translate_thread")
+// 긴 스레드를 따라잡을 때 쓰는 메시지 단축키. conversations.replies로 답글을 모두 가져와
+// 요청한 사람이 읽을 수 없는 언어의 메시지만 번역해 한데 모은 결과를 본인에게만(ephemeral) 보여준다.
+
+const (
+	threadShortcutCallbackID = "translate_thread"
+	maxThreadMessages        = 200 // Lambda 제한 시간 안에 끝내기 위한 상한
+	maxRateLimitRetries      = 3
+	threadSummaryChunkMin    = 2500
+	threadSummaryChunkMax    = 3500
+)
+
+// interactivity 요청 처리 (form 인코딩된 payload)
+func (app *App) handleInteraction(body string) (events.LambdaFunctionURLResponse, error) {
+	values, err := url.ParseQuery(body)
+	if err != nil {
+		log.Printf("[에러] interaction 요청 파싱 실패: %v", err)
+		return events.LambdaFunctionURLResponse{StatusCode: 400}, nil
+	}
+
+	var payload slack.InteractionCallback
+	if err := json.Unmarshal([]byte(values.Get("payload")), &payload); err != nil {
+		log.Printf("[에러] payload 파싱 실패: %v", err)
+		return events.LambdaFunctionURLResponse{StatusCode: 400}, nil
+	}
+
+	switch {
+	case payload.Type == slack.InteractionTypeMessageAction && payload.CallbackID == threadShortcutCallbackID:
+		// 아래에서 스레드 번역
+	case payload.Type == slack.InteractionTypeMessageAction && payload.CallbackID == messageShortcutCallbackID:
+		if err := app.translateMessageFor(payload.Channel.ID, payload.User.ID, payload.Message); err != nil {
+			log.Printf("[에러] 메시지 번역 실패: %v", err)
+			app.slack.PostEphemeral(payload.Channel.ID, payload.User.ID,
+				slack.MsgOptionText(app.userBotText(payload.User.ID, msgMessageFailed), false))
+		}
+		return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+	case payload.Type == slack.InteractionTypeShortcut && payload.CallbackID == exportMemoryCallbackID:
+		if err := app.exportMemory(payload.User.ID); err != nil {
+			log.Printf("[에러] 번역 메모리 내보내기 실패: %v", err)
+		}
+		return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+	default:
+		log.Printf("[무시] 처리하지 않는 interaction (type=%s, callback=%s)", payload.Type, payload.CallbackID)
+		return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+	}
+
+	threadTS := payload.Message.ThreadTimestamp
+	if threadTS == "" {
+		threadTS = payload.Message.Timestamp
+	}
+	if err := app.translateThread(payload.Channel.ID, threadTS, payload.User.ID); err != nil {
+		log.Printf("[에러] 스레드 번역 실패: %v", err)
+		app.slack.PostEphemeral(payload.Channel.ID, payload.User.ID,
+			slack.MsgOptionText(app.userBotText(payload.User.ID, msgThreadFailed), false),
+			slack.MsgOptionTS(threadTS))
+	}
+	return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+}
+
+// 스레드의 모든 메시지 조회 (페이지네이션, rate limit 시 대기 후 재시도)
+func (app *App) fetchThread(channelID, threadTS string) ([]slack.Message, error) {
+	var all []slack.Message
+	cursor := ""
+	retries := 0
+	for {
+		msgs, hasMore, next, err := app.slack.GetConversationReplies(&slack.GetConversationRepliesParameters{
+			ChannelID: channelID,
+			Timestamp: threadTS,
+			Cursor:    cursor,
+			Limit:     200,
+		})
+		var rateLimited *slack.RateLimitedError
+		if errors.As(err, &rateLimited) && retries < maxRateLimitRetries {
+			retries++
+			log.Printf("[경고] 스레드 조회 rate limit, %s 후 재시도 (%d/%d)", rateLimited.RetryAfter, retries, maxRateLimitRetries)
+			time.Sleep(rateLimited.RetryAfter)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, msgs...)
+		if !hasMore || next == "" || len(all) >= maxThreadMessages {
+			break
+		}
+		cursor = next
+	}
+	if len(all) > maxThreadMessages {
+		all = all[:maxThreadMessages]
+	}
+	return all, nil
+}
+
+// 요청한 사용자의 언어 (`/translate-lang`로 고른 언어, 없으면 Slack 로캘 기준, 알 수 없으면 "")
+func (app *App) userLang(userID string) string {
+	if lang := app.readerLang(userID); lang != "" {
+		return lang
+	}
+	return app.slackLang(userID)
+}
+
+// Slack 로캘 기준 사용자 언어 (알 수 없으면 "")
+func (app *App) slackLang(userID string) string {
+	user, err := app.slack.GetUserInfo(userID)
+	if err != nil {
+		log.Printf("[경고] 사용자 정보 조회 실패 (user=%s): %v", userID, err)
+		return ""
+	}
+	switch {
+	case strings.HasPrefix(user.Locale, "ko"):
+		return "ko"
+	case strings.HasPrefix(user.Locale, "ja"):
+		return "ja"
+	default:
+		return ""
+	}
+}
+
+func (app *App) translateThread(channelID, threadTS, userID string) error {
+	msgs, err := app.fetchThread(channelID, threadTS)
+	if err != nil {
+		return fmt.Errorf("스레드 조회 실패: %w", err)
+	}
+	readerLang := app.readerLang(userID)
+	preferred := readerLang != ""
+	if !preferred {
+		readerLang = app.slackLang(userID)
+	}
+
+	var lines []string
+	for _, m := range msgs {
+		// 봇 메시지(기존 번역 포함)는 건너뛴다
+		if m.BotID != "" || strings.TrimSpace(m.Text) == "" {
+			continue
+		}
+		lang := app.targetLang(channelID, m.Text)
+		if preferred {
+			// 직접 고른 읽기 언어는 한국어/일본어가 아닐 수 있으므로 원문 언어가 다른 메시지를 그 언어로 번역
+			if src := detectSourceLang(m.Text); src == "" || src == readerLang {
+				continue
+			}
+			lang = readerLang
+		}
+		if lang == "" || (readerLang != "" && lang != readerLang) {
+			continue
+		}
+		translated, err := app.translateTextWith(app.translatorFor(channelID), m.Text, lang)
+		if err != nil {
+			return err
+		}
+		lines = append(lines, fmt.Sprintf("*<@%s>*: %s", m.User, translated))
+	}
+
+	if len(lines) == 0 {
+		_, err := app.slack.PostEphemeral(channelID, userID,
+			slack.MsgOptionText(app.botText(msgNoThreadMessages, readerLang), false), slack.MsgOptionTS(threadTS))
+		return err
+	}
+
+	summary := app.botText(msgThreadSummary, readerLang, len(lines)) + "\n\n" + strings.Join(lines, "\n\n")
+	for _, chunk := range splitByNewlineChunk(summary, threadSummaryChunkMin, threadSummaryChunkMax) {
+		if _, err := app.slack.PostEphemeral(channelID, userID,
+			slack.MsgOptionText(chunk, false), slack.MsgOptionTS(threadTS)); err != nil {
+			return err
+		}
+	}
+	log.Printf("[성공] 스레드 번역 (channel=%s, thread=%s, 메시지 %d개)", channelID, threadTS, len(lines))
+	return nil
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// 두 페이지로 나뉜 한국어/일본어 섞인 스레드
+func mixedThread(method string, form url.Values) string {
+	switch method {
+	case "conversations.replies":
+		if form.Get("cursor") == "" {
+			return `{"ok":true,"has_more":true,"response_metadata":{"next_cursor":"page2"},"messages":[
+				{"type":"message","user":"U1","text":"明日のリリースどうしますか","ts":"1.0"},
+				{"type":"message","user":"U2","text":"내일 오전에 배포할게요","ts":"1.1","thread_ts":"1.0"},
+				{"type":"message","user":"UBOT","bot_id":"B1","text":"明日の午前にデプロイします","ts":"1.2","thread_ts":"1.0"}
+			]}`
+		}
+		return `{"ok":true,"has_more":false,"messages":[
+			{"type":"message","user":"U1","text":"了解です","ts":"1.3","thread_ts":"1.0"},
+			{"type":"message","user":"U3","text":"LGTM","ts":"1.4","thread_ts":"1.0"}
+		]}`
+	case "users.info":
+		return `{"ok":true,"user":{"id":"U2","locale":"ko-KR"}}`
+	}
+	return ""
+}
+
+func TestTranslateThreadMixedLanguages(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	fs.respond = mixedThread
+	app := &App{cfg: &Config{}, slack: client, translate: fakeTranslate("[ko]")}
+
+	if err := app.translateThread("C1", "1.0", "U2"); err != nil {
+		t.Fatalf("translateThread: %v", err)
+	}
+
+	if n := len(fs.callsTo("conversations.replies")); n != 2 {
+		t.Errorf("conversations.replies 호출 수 = %d, want 2 (페이지네이션)", n)
+	}
+	eph := fs.callsTo("chat.postEphemeral")
+	if len(eph) != 1 {
+		t.Fatalf("chat.postEphemeral 호출 수 = %d, want 1", len(eph))
+	}
+	form := eph[0].Form
+	if form.Get("user") != "U2" || form.Get("thread_ts") != "1.0" {
+		t.Errorf("user=%q thread_ts=%q", form.Get("user"), form.Get("thread_ts"))
+	}
+	text := form.Get("text")
+	for _, want := range []string{"2개 메시지", "*<@U1>*: [ko]明日のリリースどうしますか", "*<@U1>*: [ko]了解です"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("요약에 %q 없음:\n%s", want, text)
+		}
+	}
+	// 읽는 사람의 언어(한국어) 메시지, 봇 번역, 번역 대상이 아닌 메시지는 빠진다
+	for _, unwanted := range []string{"내일 오전에", "デプロイします", "LGTM"} {
+		if strings.Contains(text, unwanted) {
+			t.Errorf("요약에 %q 포함됨:\n%s", unwanted, text)
+		}
+	}
+}
+
+func TestHandleInteractionThreadShortcut(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	fs.respond = mixedThread
+	app := &App{cfg: &Config{}, slack: client, translate: fakeTranslate("[ko]")}
+
+	payload, _ := json.Marshal(map[string]interface{}{
+		"type":        "message_action",
+		"callback_id": threadShortcutCallbackID,
+		"user":        map[string]string{"id": "U2"},
+		"channel":     map[string]string{"id": "C1"},
+		"message":     map[string]string{"ts": "1.3", "thread_ts": "1.0"},
+	})
+	resp, err := app.handleInteraction("payload=" + url.QueryEscape(string(payload)))
+	if err != nil || resp.StatusCode != 200 {
+		t.Fatalf("handleInteraction: status=%d err=%v", resp.StatusCode, err)
+	}
+
+	replies := fs.callsTo("conversations.replies")
+	if len(replies) == 0 || replies[0].Form.Get("ts") != "1.0" {
+		t.Fatalf("스레드 부모(1.0)로 조회하지 않음: %+v", replies)
+	}
+	if n := len(fs.callsTo("chat.postEphemeral")); n != 1 {
+		t.Errorf("chat.postEphemeral 호출 수 = %d, want 1", n)
+	}
+}
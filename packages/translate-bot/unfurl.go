@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+)
+
+// ─────────────────────────────────────
+// 링크 미리보기 번역 (link_shared 이벤트, opt-in)
+// 외국어 제목의 링크가 공유되면 페이지의 og:title/og:description을 번역해 chat.unfurl로 미리보기를 붙인다.
+// Slack 앱 설정에 App Unfurl Domains 등록과 links:read, links:write 스코프가 필요하다.
+
+const maxLinkPreviewBytes = 512 * 1024
+
+var (
+	metaTagRegex   = regexp.MustCompile(`(?is)<meta\s[^>]*>`)
+	metaAttrRegex  = regexp.MustCompile(`(?is)([a-z:_-]+)\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+	titleTagRegex  = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+	whitespaceRuns = regexp.MustCompile(`\s+`)
+)
+
+type linkPreview struct {
+	Title       string
+	Description string
+}
+
+// HTML에서 미리보기 제목/설명 추출 (og 태그 우선, 없으면 <title>/description)
+func parseLinkPreview(page string) linkPreview {
+	meta := map[string]string{}
+	for _, tag := range metaTagRegex.FindAllString(page, -1) {
+		attrs := map[string]string{}
+		for _, m := range metaAttrRegex.FindAllStringSubmatch(tag, -1) {
+			attrs[strings.ToLower(m[1])] = m[2] + m[3]
+		}
+		key := attrs["property"]
+		if key == "" {
+			key = attrs["name"]
+		}
+		if key != "" && attrs["content"] != "" {
+			if _, exists := meta[strings.ToLower(key)]; !exists {
+				meta[strings.ToLower(key)] = attrs["content"]
+			}
+		}
+	}
+
+	var p linkPreview
+	p.Title = meta["og:title"]
+	if p.Title == "" {
+		if m := titleTagRegex.FindStringSubmatch(page); m != nil {
+			p.Title = m[1]
+		}
+	}
+	p.Description = meta["og:description"]
+	if p.Description == "" {
+		p.Description = meta["description"]
+	}
+
+	clean := func(s string) string {
+		return strings.TrimSpace(whitespaceRuns.ReplaceAllString(html.UnescapeString(s), " "))
+	}
+	p.Title = clean(p.Title)
+	p.Description = clean(p.Description)
+	return p
+}
+
+func fetchLinkPreview(link string) (linkPreview, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", link, nil)
+	if err != nil {
+		return linkPreview{}, err
+	}
+	req.Header.Set("Accept", "text/html")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return linkPreview{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return linkPreview{}, fmt.Errorf("링크 조회 실패 (status=%d)", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "" && !strings.Contains(ct, "html") {
+		return linkPreview{}, fmt.Errorf("HTML이 아닌 링크 (content-type=%s)", ct)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxLinkPreviewBytes))
+	if err != nil {
+		return linkPreview{}, err
+	}
+	return parseLinkPreview(string(body)), nil
+}
+
+// link_shared 이벤트 처리
+func (app *App) processLinkShared(ev *slackevents.LinkSharedEvent) error {
+	if !app.cfg.TranslateLinkUnfurls {
+		return nil
+	}
+
+	unfurls := map[string]slack.Attachment{}
+	for _, link := range ev.Links {
+		preview, err := fetchLinkPreview(link.URL)
+		if err != nil {
+			log.Printf("[경고] 링크 미리보기 조회 실패 (url=%s): %v", link.URL, err)
+			continue
+		}
+		if preview.Title == "" {
+			continue
+		}
+
+		lang := app.targetLang(ev.Channel, preview.Title+"\n"+preview.Description)
+		if lang == "" {
+			log.Printf("[스킵] 링크 미리보기 번역 불필요 (url=%s)", link.URL)
+			continue
+		}
+
+		texts := []string{preview.Title}
+		if preview.Description != "" {
+			texts = append(texts, preview.Description)
+		}
+		translated, err := app.translatorFor(ev.Channel)(texts, lang)
+		if err != nil {
+			log.Printf("[에러] 링크 미리보기 번역 실패 (url=%s): %v", link.URL, err)
+			continue
+		}
+
+		attachment := slack.Attachment{
+			Title:     translated[0],
+			TitleLink: link.URL,
+			Footer:    "🌐 " + preview.Title,
+		}
+		if len(translated) > 1 {
+			attachment.Text = translated[1]
+		}
+		unfurls[link.URL] = attachment
+	}
+
+	if len(unfurls) == 0 {
+		return nil
+	}
+
+	_, _, _, err := app.slack.UnfurlMessage(ev.Channel, ev.MessageTimeStamp, unfurls)
+	if err != nil {
+		if strings.Contains(err.Error(), "missing_scope") || strings.Contains(err.Error(), "not_allowed") {
+			log.Printf("[에러] 링크 미리보기 게시 권한 없음: links:write 스코프와 App Unfurl Domains 설정을 확인하세요 (%v)", err)
+		}
+		return err
+	}
+	log.Printf("[성공] 링크 미리보기 번역 게시 (channel=%s, links=%d개)", ev.Channel, len(unfurls))
+	return nil
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+)
+
+func TestParseLinkPreview(t *testing.T) {
+	tests := []struct {
+		name      string
+		page      string
+		wantTitle string
+		wantDesc  string
+	}{
+		{
+			name:      "og_tags",
+			page:      `<html><head><meta property="og:title" content="신제품 출시 안내"><meta property="og:description" content="자세한 내용은 본문 참고"></head></html>`,
+			wantTitle: "신제품 출시 안내",
+			wantDesc:  "자세한 내용은 본문 참고",
+		},
+		{
+			name:      "content_before_property_and_entities",
+			page:      `<meta content="A &amp; B" property="og:title">`,
+			wantTitle: "A & B",
+		},
+		{
+			name:      "fallback_to_title_and_description",
+			page:      "<title>\n  お知らせ  \n</title><meta name=\"description\" content=\"説明\">",
+			wantTitle: "お知らせ",
+			wantDesc:  "説明",
+		},
+		{
+			name: "no_metadata",
+			page: "<html><body>hello</body></html>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseLinkPreview(tt.page)
+			if got.Title != tt.wantTitle || got.Description != tt.wantDesc {
+				t.Errorf("got %+v, want {%q %q}", got, tt.wantTitle, tt.wantDesc)
+			}
+		})
+	}
+}
+
+func TestProcessLinkShared_KoreanTitle(t *testing.T) {
+	page := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		io.WriteString(w, `<meta property="og:title" content="분기 실적 발표"><meta property="og:description" content="매출이 늘었습니다">`)
+	}))
+	defer page.Close()
+
+	fs, client := newFakeSlack(t)
+	app := &App{
+		cfg:       &Config{TranslateLinkUnfurls: true},
+		slack:     client,
+		translate: fakeTranslate("[ja]"),
+	}
+
+	ev := &slackevents.LinkSharedEvent{
+		Channel:          "C1",
+		MessageTimeStamp: "1700000000.000100",
+		Links:            []slackevents.SharedLinks{{Domain: "example.com", URL: page.URL + "/news"}},
+	}
+	if err := app.processLinkShared(ev); err != nil {
+		t.Fatalf("processLinkShared() error: %v", err)
+	}
+
+	calls := fs.callsTo("chat.unfurl")
+	if len(calls) != 1 {
+		t.Fatalf("chat.unfurl 호출 수 = %d, want 1", len(calls))
+	}
+	var unfurls map[string]slack.Attachment
+	if err := json.Unmarshal([]byte(calls[0].Form.Get("unfurls")), &unfurls); err != nil {
+		t.Fatalf("unfurls 파싱 실패: %v", err)
+	}
+	got := unfurls[page.URL+"/news"]
+	if got.Title != "[ja]분기 실적 발표" || got.Text != "[ja]매출이 늘었습니다" {
+		t.Errorf("unfurl = %+v", got)
+	}
+	if got.Footer != "🌐 분기 실적 발표" {
+		t.Errorf("원문 제목 footer = %q", got.Footer)
+	}
+}
+
+func TestProcessLinkShared_Disabled(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	app := &App{cfg: &Config{}, slack: client, translate: fakeTranslate("")}
+
+	ev := &slackevents.LinkSharedEvent{Links: []slackevents.SharedLinks{{URL: "http://127.0.0.1:1/"}}}
+	if err := app.processLinkShared(ev); err != nil {
+		t.Fatalf("processLinkShared() error: %v", err)
+	}
+	if n := len(fs.callsTo("chat.unfurl")); n != 0 {
+		t.Errorf("비활성화 상태에서 chat.unfurl 호출됨 (%d회)", n)
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// ─────────────────────────────────────
+// 사용자별 읽기 언어 (`/translate-lang en`)
+// 본인에게만 보이는 번역(스레드/메시지 단축키)은 Slack 로캘 대신 사용자가 고른 언어로 번역한다.
+// 채널에 게시하는 자동 번역과 🔁 재번역은 모두가 보므로 적용하지 않는다.
+// 고른 언어는 사용자 설정 저장소(USER_PREFS_TABLE, prefstore.go)에 해시한 사용자 ID로 저장한다.
+
+const langCommand = "/translate-lang"
+
+// 고를 수 있는 읽기 언어
+var readerLangNames = map[string]string{
+	"ko": "한국어",
+	"ja": "日本語",
+	"en": "English",
+}
+
+// 슬래시 커맨드 처리 (form 인코딩된 요청)
+func (app *App) handleCommand(values url.Values) (events.LambdaFunctionURLResponse, error) {
+	switch values.Get("command") {
+	case langCommand:
+		return commandReply(app.setReaderLang(values.Get("user_id"), values.Get("text"))), nil
+	case bilingualCommand:
+		return commandReply(app.setBilingual(values.Get("user_id"), values.Get("text"))), nil
+	}
+	log.Printf("[무시] 처리하지 않는 커맨드 (command=%s)", values.Get("command"))
+	return events.LambdaFunctionURLResponse{StatusCode: 200}, nil
+}
+
+// `/translate-lang [언어|auto]` 처리 후 안내 문구 반환 (요청한 사람의 언어로)
+func (app *App) setReaderLang(userID, arg string) string {
+	if app.prefs == nil {
+		return app.userBotText(userID, msgUserPrefsUnavailable)
+	}
+	arg = strings.ToLower(strings.TrimSpace(arg))
+	switch arg {
+	case "":
+		if lang := app.readerLang(userID); lang != "" {
+			return app.userBotText(userID, msgReaderLangCurrent, readerLangNames[lang], lang)
+		}
+		return app.userBotText(userID, msgReaderLangDefault)
+	case "auto":
+		if err := app.prefs.SetLang(context.Background(), userPrefKey(userID), ""); err != nil {
+			log.Printf("[에러] 읽기 언어 설정 삭제 실패: %v", err)
+			return app.userBotText(userID, msgUserPrefsFailed)
+		}
+		log.Println("[정보] 읽기 언어 설정 삭제")
+		return app.userBotText(userID, msgReaderLangCleared)
+	}
+	name, ok := readerLangNames[arg]
+	if !ok {
+		return app.userBotText(userID, msgReaderLangInvalid, arg)
+	}
+	if err := app.prefs.SetLang(context.Background(), userPrefKey(userID), arg); err != nil {
+		log.Printf("[에러] 읽기 언어 저장 실패: %v", err)
+		return app.userBotText(userID, msgUserPrefsFailed)
+	}
+	log.Printf("[정보] 읽기 언어 설정 (lang=%s)", arg)
+	return app.userBotText(userID, msgReaderLangSet, name)
+}
+
+// 슬래시 커맨드 응답 (요청한 사람에게만 보임)
+func commandReply(text string) events.LambdaFunctionURLResponse {
+	return events.LambdaFunctionURLResponse{
+		StatusCode: 200,
+		Headers:    map[string]string{"Content-Type": "text/plain; charset=utf-8"},
+		Body:       text,
+	}
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+func TestLangCommand(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	fs.responses["users.info"] = `{"ok":true,"user":{"id":"U1","locale":"ko-KR"}}`
+	ft, store := newFakePrefStore(t)
+	app := &App{cfg: &Config{}, slack: client, prefs: store}
+	run := func(text string) string {
+		resp, err := app.handleCommand(url.Values{"command": {langCommand}, "user_id": {"U1"}, "text": {text}})
+		if err != nil || resp.StatusCode != 200 {
+			t.Fatalf("handleCommand(%q): status=%d err=%v", text, resp.StatusCode, err)
+		}
+		return resp.Body
+	}
+
+	if got := run(""); !strings.Contains(got, "Slack 언어 설정을 따릅니다") {
+		t.Errorf("설정 전 조회 = %q", got)
+	}
+	if got := run("EN"); !strings.Contains(got, "English") {
+		t.Errorf("설정 응답 = %q", got)
+	}
+	if got := app.readerLang("U1"); got != "en" {
+		t.Errorf("저장된 언어 = %q, want en", got)
+	}
+	// 설정한 뒤에는 그 언어로 안내
+	if got := run("fr"); !strings.Contains(got, "Unsupported language") || app.readerLang("U1") != "en" {
+		t.Errorf("잘못된 언어 응답 = %q, 저장값 = %q", got, app.readerLang("U1"))
+	}
+	if got := run("auto"); !strings.Contains(got, "Slack 언어 설정을 따릅니다") {
+		t.Errorf("auto 응답 = %q, want Slack 언어(한국어) 안내", got)
+	}
+	if got := app.readerLang("U1"); got != "" {
+		t.Errorf("auto 후 저장된 언어 = %q, want 없음", got)
+	}
+
+	// 사용자 ID는 해시로만 저장
+	run("ja")
+	for key := range ft.items {
+		if strings.Contains(key, "U1") {
+			t.Errorf("사용자 ID가 그대로 저장됨: %q", key)
+		}
+	}
+}
+
+// 저장소가 없으면 설정하지 않고 안내만
+func TestLangCommandWithoutStore(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	fs.responses["users.info"] = `{"ok":true,"user":{"id":"U1","locale":"ko-KR"}}`
+	app := &App{cfg: &Config{}, slack: client}
+
+	if got := app.setReaderLang("U1", "en"); !strings.Contains(got, "USER_PREFS_TABLE") {
+		t.Errorf("저장소 없을 때 응답 = %q", got)
+	}
+	if got := app.userLang("U1"); got != "ko" {
+		t.Errorf("userLang = %q, want Slack 로캘 ko", got)
+	}
+}
+
+func TestMessageShortcutUsesPreferredLang(t *testing.T) {
+	tests := []struct {
+		name     string
+		pref     string
+		wantText string
+	}{
+		{"stored preference", "en", "🌐 Translation\n[en] 明日のリリースどうしますか"},
+		{"default slack locale", "", "🌐 번역\n[ko] 明日のリリースどうしますか"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			fs.responses["users.info"] = `{"ok":true,"user":{"id":"U2","locale":"ko-KR"}}`
+			_, store := newFakePrefStore(t)
+			app := &App{cfg: &Config{}, slack: client, prefs: store}
+			app.translate = func(chunks []string, lang string) ([]string, error) {
+				return fakeTranslate("["+lang+"] ")(chunks, lang)
+			}
+			store.SetLang(context.Background(), userPrefKey("U2"), tt.pref)
+
+			msg := slack.Message{Msg: slack.Msg{Timestamp: "1.0", Text: "明日のリリースどうしますか"}}
+			if err := app.translateMessageFor("C1", "U2", msg); err != nil {
+				t.Fatalf("translateMessageFor: %v", err)
+			}
+			eph := fs.callsTo("chat.postEphemeral")
+			if len(eph) != 1 {
+				t.Fatalf("chat.postEphemeral 호출 수 = %d, want 1", len(eph))
+			}
+			if got := eph[0].Form.Get("text"); got != tt.wantText {
+				t.Errorf("text = %q, want %q", got, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestThreadTranslationUsesPreferredLang(t *testing.T) {
+	fs, client := newFakeSlack(t)
+	fs.responses["conversations.replies"] = `{"ok":true,"messages":[
+		{"user":"U1","ts":"1.0","text":"배포 언제 하나요?"},
+		{"user":"U3","ts":"1.1","text":"明日です"},
+		{"user":"U4","ts":"1.2","text":"sounds good"}]}`
+	_, store := newFakePrefStore(t)
+	app := &App{cfg: &Config{}, slack: client, prefs: store}
+	app.translate = func(chunks []string, lang string) ([]string, error) {
+		return fakeTranslate("["+lang+"] ")(chunks, lang)
+	}
+	store.SetLang(context.Background(), userPrefKey("U2"), "en")
+
+	if err := app.translateThread("C1", "1.0", "U2"); err != nil {
+		t.Fatalf("translateThread: %v", err)
+	}
+	eph := fs.callsTo("chat.postEphemeral")
+	if len(eph) != 1 {
+		t.Fatalf("chat.postEphemeral 호출 수 = %d, want 1", len(eph))
+	}
+	text := eph[0].Form.Get("text")
+	for _, want := range []string{"(2 messages)", "[en] 배포 언제 하나요?", "[en] 明日です"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("스레드 번역에 %q 없음: %q", want, text)
+		}
+	}
+	if strings.Contains(text, "sounds good") {
+		t.Errorf("이미 읽기 언어인 메시지가 번역됨: %q", text)
+	}
+}
+
+func TestCommandRepliesInUserLanguage(t *testing.T) {
+	tests := []struct {
+		name    string
+		command string
+		text    string
+		want    string
+	}{
+		{"lang status", langCommand, "", "Slackの言語設定に従います"},
+		{"lang invalid", langCommand, "fr", "対応していない言語です: fr"},
+		{"bilingual status", bilingualCommand, "", "自分のメッセージも翻訳します"},
+		{"bilingual on", bilingualCommand, "on", "バイリンガルとして登録しました"},
+		{"bilingual usage", bilingualCommand, "maybe", "使い方"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, client := newFakeSlack(t)
+			fs.responses["users.info"] = `{"ok":true,"user":{"id":"U1","locale":"ja-JP"}}`
+			_, store := newFakePrefStore(t)
+			app := &App{cfg: &Config{}, slack: client, prefs: store}
+
+			resp, _ := app.handleCommand(url.Values{"command": {tt.command}, "user_id": {"U1"}, "text": {tt.text}})
+			if !strings.Contains(resp.Body, tt.want) {
+				t.Errorf("응답 = %q, want %q 포함", resp.Body, tt.want)
+			}
+		})
+	}
+}